@@ -23,22 +23,27 @@ const (
 )
 
 type Booking struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	VenueId       string                 `protobuf:"bytes,2,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
-	Table         *common.TableRef       `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
-	Slot          *common.Slot           `protobuf:"bytes,4,opt,name=slot,proto3" json:"slot,omitempty"`
-	PartySize     int32                  `protobuf:"varint,5,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"`
-	CustomerName  string                 `protobuf:"bytes,6,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
-	CustomerPhone string                 `protobuf:"bytes,7,opt,name=customer_phone,json=customerPhone,proto3" json:"customer_phone,omitempty"`
-	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // requested, held, confirmed, seated, finished, cancelled, expired, no_show, rejected
-	Comment       string                 `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
-	AdminId       string                 `protobuf:"bytes,10,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	ExpiresAt     int64                  `protobuf:"varint,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // для held статуса
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VenueId         string                 `protobuf:"bytes,2,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Table           *common.TableRef       `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	Slot            *common.Slot           `protobuf:"bytes,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	PartySize       int32                  `protobuf:"varint,5,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"`
+	CustomerName    string                 `protobuf:"bytes,6,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
+	CustomerPhone   string                 `protobuf:"bytes,7,opt,name=customer_phone,json=customerPhone,proto3" json:"customer_phone,omitempty"`
+	Status          string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // requested, held, confirmed, seated, finished, cancelled, expired, no_show, rejected
+	Comment         string                 `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	AdminId         string                 `protobuf:"bytes,10,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       int64                  `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExpiresAt       int64                  `protobuf:"varint,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                // для held статуса
+	Sequence        int32                  `protobuf:"varint,14,opt,name=sequence,proto3" json:"sequence,omitempty"`                                   // номер ревизии, растет при каждом изменении
+	CombinedTables  []*common.TableRef     `protobuf:"bytes,15,rep,name=combined_tables,json=combinedTables,proto3" json:"combined_tables,omitempty"`  // остальные столы, если бронь занимает комбинацию
+	BufferMinutes   int32                  `protobuf:"varint,16,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"`    // стол занят еще столько минут после окончания
+	NeedsAttention  bool                   `protobuf:"varint,17,opt,name=needs_attention,json=needsAttention,proto3" json:"needs_attention,omitempty"` // стол или часы работы изменились, бронь нужно проверить
+	AttentionReason string                 `protobuf:"bytes,18,opt,name=attention_reason,json=attentionReason,proto3" json:"attention_reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Booking) Reset() {
@@ -162,6 +167,41 @@ func (x *Booking) GetExpiresAt() int64 {
 	return 0
 }
 
+func (x *Booking) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Booking) GetCombinedTables() []*common.TableRef {
+	if x != nil {
+		return x.CombinedTables
+	}
+	return nil
+}
+
+func (x *Booking) GetBufferMinutes() int32 {
+	if x != nil {
+		return x.BufferMinutes
+	}
+	return 0
+}
+
+func (x *Booking) GetNeedsAttention() bool {
+	if x != nil {
+		return x.NeedsAttention
+	}
+	return false
+}
+
+func (x *Booking) GetAttentionReason() string {
+	if x != nil {
+		return x.AttentionReason
+	}
+	return ""
+}
+
 type CreateBookingRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	VenueId        string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
@@ -173,6 +213,10 @@ type CreateBookingRequest struct {
 	Comment        string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
 	AdminId        string                 `protobuf:"bytes,8,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
 	IdempotencyKey string                 `protobuf:"bytes,9,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	PreferredZone  string                 `protobuf:"bytes,10,opt,name=preferred_zone,json=preferredZone,proto3" json:"preferred_zone,omitempty"` // учитывается, если стол не указан и его выбирает venue-svc
+	// Остальные столы комбинации из CheckAvailability (combined_tables без table);
+	// бронируются и удерживаются вместе с table
+	CombinedTables []*common.TableRef `protobuf:"bytes,11,rep,name=combined_tables,json=combinedTables,proto3" json:"combined_tables,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -270,6 +314,20 @@ func (x *CreateBookingRequest) GetIdempotencyKey() string {
 	return ""
 }
 
+func (x *CreateBookingRequest) GetPreferredZone() string {
+	if x != nil {
+		return x.PreferredZone
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetCombinedTables() []*common.TableRef {
+	if x != nil {
+		return x.CombinedTables
+	}
+	return nil
+}
+
 type GetBookingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -322,6 +380,8 @@ type ListBookingsRequest struct {
 	TableId       string                 `protobuf:"bytes,4,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
 	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	DateFrom      string                 `protobuf:"bytes,7,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // YYYY-MM-DD, включительно
+	DateTo        string                 `protobuf:"bytes,8,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // YYYY-MM-DD, включительно
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -398,28 +458,47 @@ func (x *ListBookingsRequest) GetOffset() int32 {
 	return 0
 }
 
-type ConfirmBookingRequest struct {
+func (x *ListBookingsRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+// Те же фильтры, что и в ListBookingsRequest, но без пагинации
+type ExportBookingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	TableId       string                 `protobuf:"bytes,4,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	DateFrom      string                 `protobuf:"bytes,5,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // YYYY-MM-DD, включительно
+	DateTo        string                 `protobuf:"bytes,6,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // YYYY-MM-DD, включительно
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmBookingRequest) Reset() {
-	*x = ConfirmBookingRequest{}
+func (x *ExportBookingsRequest) Reset() {
+	*x = ExportBookingsRequest{}
 	mi := &file_booking_booking_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmBookingRequest) String() string {
+func (x *ExportBookingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmBookingRequest) ProtoMessage() {}
+func (*ExportBookingsRequest) ProtoMessage() {}
 
-func (x *ConfirmBookingRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportBookingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_booking_booking_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -431,48 +510,78 @@ func (x *ConfirmBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmBookingRequest.ProtoReflect.Descriptor instead.
-func (*ConfirmBookingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ExportBookingsRequest) Descriptor() ([]byte, []int) {
 	return file_booking_booking_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ConfirmBookingRequest) GetId() string {
+func (x *ExportBookingsRequest) GetVenueId() string {
 	if x != nil {
-		return x.Id
+		return x.VenueId
 	}
 	return ""
 }
 
-func (x *ConfirmBookingRequest) GetAdminId() string {
+func (x *ExportBookingsRequest) GetDate() string {
 	if x != nil {
-		return x.AdminId
+		return x.Date
 	}
 	return ""
 }
 
-type CancelBookingRequest struct {
+func (x *ExportBookingsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExportBookingsRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ExportBookingsRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *ExportBookingsRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+type SearchBookingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`                       // имя и/или телефон гостя, например "Ivanov, +7 916"
+	DateFrom      string                 `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // YYYY-MM-DD, по умолчанию сегодня
+	DateTo        string                 `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // YYYY-MM-DD, по умолчанию date_from + 30 дней
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelBookingRequest) Reset() {
-	*x = CancelBookingRequest{}
+func (x *SearchBookingsRequest) Reset() {
+	*x = SearchBookingsRequest{}
 	mi := &file_booking_booking_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelBookingRequest) String() string {
+func (x *SearchBookingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBookingRequest) ProtoMessage() {}
+func (*SearchBookingsRequest) ProtoMessage() {}
 
-func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchBookingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_booking_booking_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -484,54 +593,70 @@ func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
-func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchBookingsRequest.ProtoReflect.Descriptor instead.
+func (*SearchBookingsRequest) Descriptor() ([]byte, []int) {
 	return file_booking_booking_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CancelBookingRequest) GetId() string {
+func (x *SearchBookingsRequest) GetVenueId() string {
 	if x != nil {
-		return x.Id
+		return x.VenueId
 	}
 	return ""
 }
 
-func (x *CancelBookingRequest) GetAdminId() string {
+func (x *SearchBookingsRequest) GetQuery() string {
 	if x != nil {
-		return x.AdminId
+		return x.Query
 	}
 	return ""
 }
 
-func (x *CancelBookingRequest) GetReason() string {
+func (x *SearchBookingsRequest) GetDateFrom() string {
 	if x != nil {
-		return x.Reason
+		return x.DateFrom
 	}
 	return ""
 }
 
-type MarkSeatedRequest struct {
+func (x *SearchBookingsRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *SearchBookingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ImportBookingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Rows          []*ImportBookingRow    `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // только проверить строки и конфликты, ничего не сохраняя
+	AdminId       string                 `protobuf:"bytes,4,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkSeatedRequest) Reset() {
-	*x = MarkSeatedRequest{}
+func (x *ImportBookingsRequest) Reset() {
+	*x = ImportBookingsRequest{}
 	mi := &file_booking_booking_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkSeatedRequest) String() string {
+func (x *ImportBookingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkSeatedRequest) ProtoMessage() {}
+func (*ImportBookingsRequest) ProtoMessage() {}
 
-func (x *MarkSeatedRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportBookingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_booking_booking_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -543,47 +668,69 @@ func (x *MarkSeatedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkSeatedRequest.ProtoReflect.Descriptor instead.
-func (*MarkSeatedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ImportBookingsRequest) Descriptor() ([]byte, []int) {
 	return file_booking_booking_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MarkSeatedRequest) GetId() string {
+func (x *ImportBookingsRequest) GetVenueId() string {
 	if x != nil {
-		return x.Id
+		return x.VenueId
 	}
 	return ""
 }
 
-func (x *MarkSeatedRequest) GetAdminId() string {
+func (x *ImportBookingsRequest) GetRows() []*ImportBookingRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportBookingsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportBookingsRequest) GetAdminId() string {
 	if x != nil {
 		return x.AdminId
 	}
 	return ""
 }
 
-type MarkFinishedRequest struct {
+// Строка импорта из старой системы. Стол задается либо table_id, либо table_name
+type ImportBookingRow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`                                 // номер строки в исходном файле, для отчета
+	ExternalRef   string                 `protobuf:"bytes,2,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"` // идентификатор брони в старой системе, ключ идемпотентности
+	TableId       string                 `protobuf:"bytes,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	TableName     string                 `protobuf:"bytes,4,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Slot          *common.Slot           `protobuf:"bytes,5,opt,name=slot,proto3" json:"slot,omitempty"`
+	PartySize     int32                  `protobuf:"varint,6,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"`
+	CustomerName  string                 `protobuf:"bytes,7,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
+	CustomerPhone string                 `protobuf:"bytes,8,opt,name=customer_phone,json=customerPhone,proto3" json:"customer_phone,omitempty"`
+	Comment       string                 `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkFinishedRequest) Reset() {
-	*x = MarkFinishedRequest{}
+func (x *ImportBookingRow) Reset() {
+	*x = ImportBookingRow{}
 	mi := &file_booking_booking_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkFinishedRequest) String() string {
+func (x *ImportBookingRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkFinishedRequest) ProtoMessage() {}
+func (*ImportBookingRow) ProtoMessage() {}
 
-func (x *MarkFinishedRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportBookingRow) ProtoReflect() protoreflect.Message {
 	mi := &file_booking_booking_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -595,47 +742,98 @@ func (x *MarkFinishedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkFinishedRequest.ProtoReflect.Descriptor instead.
-func (*MarkFinishedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportBookingRow.ProtoReflect.Descriptor instead.
+func (*ImportBookingRow) Descriptor() ([]byte, []int) {
 	return file_booking_booking_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MarkFinishedRequest) GetId() string {
+func (x *ImportBookingRow) GetLine() int32 {
 	if x != nil {
-		return x.Id
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportBookingRow) GetExternalRef() string {
+	if x != nil {
+		return x.ExternalRef
 	}
 	return ""
 }
 
-func (x *MarkFinishedRequest) GetAdminId() string {
+func (x *ImportBookingRow) GetTableId() string {
 	if x != nil {
-		return x.AdminId
+		return x.TableId
 	}
 	return ""
 }
 
-type MarkNoShowRequest struct {
+func (x *ImportBookingRow) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *ImportBookingRow) GetSlot() *common.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *ImportBookingRow) GetPartySize() int32 {
+	if x != nil {
+		return x.PartySize
+	}
+	return 0
+}
+
+func (x *ImportBookingRow) GetCustomerName() string {
+	if x != nil {
+		return x.CustomerName
+	}
+	return ""
+}
+
+func (x *ImportBookingRow) GetCustomerPhone() string {
+	if x != nil {
+		return x.CustomerPhone
+	}
+	return ""
+}
+
+func (x *ImportBookingRow) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ImportBookingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Results       []*ImportBookingResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Duplicates    int32                  `protobuf:"varint,3,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Failed        int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkNoShowRequest) Reset() {
-	*x = MarkNoShowRequest{}
+func (x *ImportBookingsResponse) Reset() {
+	*x = ImportBookingsResponse{}
 	mi := &file_booking_booking_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkNoShowRequest) String() string {
+func (x *ImportBookingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkNoShowRequest) ProtoMessage() {}
+func (*ImportBookingsResponse) ProtoMessage() {}
 
-func (x *MarkNoShowRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportBookingsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_booking_booking_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -647,47 +845,64 @@ func (x *MarkNoShowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkNoShowRequest.ProtoReflect.Descriptor instead.
-func (*MarkNoShowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ImportBookingsResponse) Descriptor() ([]byte, []int) {
 	return file_booking_booking_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MarkNoShowRequest) GetId() string {
+func (x *ImportBookingsResponse) GetResults() []*ImportBookingResult {
 	if x != nil {
-		return x.Id
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *MarkNoShowRequest) GetAdminId() string {
+func (x *ImportBookingsResponse) GetCreated() int32 {
 	if x != nil {
-		return x.AdminId
+		return x.Created
 	}
-	return ""
+	return 0
 }
 
-type ListBookingsResponse struct {
+func (x *ImportBookingsResponse) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+func (x *ImportBookingsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type ImportBookingResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	ExternalRef   string                 `protobuf:"bytes,2,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // created, would_create, duplicate, conflict, invalid
+	BookingId     string                 `protobuf:"bytes,4,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBookingsResponse) Reset() {
-	*x = ListBookingsResponse{}
+func (x *ImportBookingResult) Reset() {
+	*x = ImportBookingResult{}
 	mi := &file_booking_booking_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBookingsResponse) String() string {
+func (x *ImportBookingResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBookingsResponse) ProtoMessage() {}
+func (*ImportBookingResult) ProtoMessage() {}
 
-func (x *ListBookingsResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportBookingResult) ProtoReflect() protoreflect.Message {
 	mi := &file_booking_booking_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -699,49 +914,1249 @@ func (x *ListBookingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBookingsResponse.ProtoReflect.Descriptor instead.
-func (*ListBookingsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportBookingResult.ProtoReflect.Descriptor instead.
+func (*ImportBookingResult) Descriptor() ([]byte, []int) {
 	return file_booking_booking_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ListBookingsResponse) GetBookings() []*Booking {
+func (x *ImportBookingResult) GetLine() int32 {
 	if x != nil {
-		return x.Bookings
+		return x.Line
 	}
-	return nil
+	return 0
 }
 
-func (x *ListBookingsResponse) GetTotal() int32 {
+func (x *ImportBookingResult) GetExternalRef() string {
 	if x != nil {
-		return x.Total
+		return x.ExternalRef
 	}
-	return 0
+	return ""
 }
 
-type CheckTableAvailabilityRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
-	TableIds      []string               `protobuf:"bytes,2,rep,name=table_ids,json=tableIds,proto3" json:"table_ids,omitempty"`
+func (x *ImportBookingResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportBookingResult) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ImportBookingResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ConfirmBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmBookingRequest) Reset() {
+	*x = ConfirmBookingRequest{}
+	mi := &file_booking_booking_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmBookingRequest) ProtoMessage() {}
+
+func (x *ConfirmBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmBookingRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ConfirmBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConfirmBookingRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_booking_booking_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelBookingRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *CancelBookingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MarkSeatedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkSeatedRequest) Reset() {
+	*x = MarkSeatedRequest{}
+	mi := &file_booking_booking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkSeatedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkSeatedRequest) ProtoMessage() {}
+
+func (x *MarkSeatedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkSeatedRequest.ProtoReflect.Descriptor instead.
+func (*MarkSeatedRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MarkSeatedRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MarkSeatedRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type MarkFinishedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkFinishedRequest) Reset() {
+	*x = MarkFinishedRequest{}
+	mi := &file_booking_booking_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkFinishedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkFinishedRequest) ProtoMessage() {}
+
+func (x *MarkFinishedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkFinishedRequest.ProtoReflect.Descriptor instead.
+func (*MarkFinishedRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MarkFinishedRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MarkFinishedRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type MarkNoShowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNoShowRequest) Reset() {
+	*x = MarkNoShowRequest{}
+	mi := &file_booking_booking_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNoShowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNoShowRequest) ProtoMessage() {}
+
+func (x *MarkNoShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNoShowRequest.ProtoReflect.Descriptor instead.
+func (*MarkNoShowRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MarkNoShowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MarkNoShowRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type ListBookingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookingsResponse) Reset() {
+	*x = ListBookingsResponse{}
+	mi := &file_booking_booking_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsResponse) ProtoMessage() {}
+
+func (x *ListBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListBookingsResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *ListBookingsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SearchBookingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*BookingSearchHit    `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBookingsResponse) Reset() {
+	*x = SearchBookingsResponse{}
+	mi := &file_booking_booking_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBookingsResponse) ProtoMessage() {}
+
+func (x *SearchBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBookingsResponse.ProtoReflect.Descriptor instead.
+func (*SearchBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchBookingsResponse) GetHits() []*BookingSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+type BookingSearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Score         float32                `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"` // релевантность, чем больше, тем лучше
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingSearchHit) Reset() {
+	*x = BookingSearchHit{}
+	mi := &file_booking_booking_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingSearchHit) ProtoMessage() {}
+
+func (x *BookingSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingSearchHit.ProtoReflect.Descriptor instead.
+func (*BookingSearchHit) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BookingSearchHit) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *BookingSearchHit) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// Активные брони заведения с флагом needs_attention
+type ListAttentionBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttentionBookingsRequest) Reset() {
+	*x = ListAttentionBookingsRequest{}
+	mi := &file_booking_booking_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttentionBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttentionBookingsRequest) ProtoMessage() {}
+
+func (x *ListAttentionBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttentionBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttentionBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListAttentionBookingsRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *ListAttentionBookingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAttentionBookingsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ClearBookingAttentionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearBookingAttentionRequest) Reset() {
+	*x = ClearBookingAttentionRequest{}
+	mi := &file_booking_booking_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearBookingAttentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearBookingAttentionRequest) ProtoMessage() {}
+
+func (x *ClearBookingAttentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearBookingAttentionRequest.ProtoReflect.Descriptor instead.
+func (*ClearBookingAttentionRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ClearBookingAttentionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClearBookingAttentionRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+// Будущие held и confirmed брони заведения, занимающие любой из столов
+type ListUpcomingBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	TableIds      []string               `protobuf:"bytes,2,rep,name=table_ids,json=tableIds,proto3" json:"table_ids,omitempty"` // пусто - все столы заведения
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingBookingsRequest) Reset() {
+	*x = ListUpcomingBookingsRequest{}
+	mi := &file_booking_booking_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingBookingsRequest) ProtoMessage() {}
+
+func (x *ListUpcomingBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListUpcomingBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListUpcomingBookingsRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *ListUpcomingBookingsRequest) GetTableIds() []string {
+	if x != nil {
+		return x.TableIds
+	}
+	return nil
+}
+
+type MoveBookingRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TableId          string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	CombinedTableIds []string               `protobuf:"bytes,3,rep,name=combined_table_ids,json=combinedTableIds,proto3" json:"combined_table_ids,omitempty"`
+	BufferMinutes    int32                  `protobuf:"varint,4,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"` // буфер новых столов
+	AdminId          string                 `protobuf:"bytes,5,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Reason           string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MoveBookingRequest) Reset() {
+	*x = MoveBookingRequest{}
+	mi := &file_booking_booking_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveBookingRequest) ProtoMessage() {}
+
+func (x *MoveBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveBookingRequest.ProtoReflect.Descriptor instead.
+func (*MoveBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MoveBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveBookingRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *MoveBookingRequest) GetCombinedTableIds() []string {
+	if x != nil {
+		return x.CombinedTableIds
+	}
+	return nil
+}
+
+func (x *MoveBookingRequest) GetBufferMinutes() int32 {
+	if x != nil {
+		return x.BufferMinutes
+	}
+	return 0
+}
+
+func (x *MoveBookingRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *MoveBookingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Гость просит изменить бронь: бронь получает needs_attention с текстом просьбы
+type RequestBookingChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	AdminId       string                 `protobuf:"bytes,3,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"` // "guest", если просьба пришла по ссылке гостя
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestBookingChangeRequest) Reset() {
+	*x = RequestBookingChangeRequest{}
+	mi := &file_booking_booking_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestBookingChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestBookingChangeRequest) ProtoMessage() {}
+
+func (x *RequestBookingChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestBookingChangeRequest.ProtoReflect.Descriptor instead.
+func (*RequestBookingChangeRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RequestBookingChangeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RequestBookingChangeRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RequestBookingChangeRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type CheckTableAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	TableIds      []string               `protobuf:"bytes,2,rep,name=table_ids,json=tableIds,proto3" json:"table_ids,omitempty"`
 	Slot          *common.Slot           `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	BufferMinutes map[string]int32       `protobuf:"bytes,4,rep,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // буфер новой брони по столам
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckTableAvailabilityRequest) Reset() {
+	*x = CheckTableAvailabilityRequest{}
+	mi := &file_booking_booking_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckTableAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTableAvailabilityRequest) ProtoMessage() {}
+
+func (x *CheckTableAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTableAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*CheckTableAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CheckTableAvailabilityRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *CheckTableAvailabilityRequest) GetTableIds() []string {
+	if x != nil {
+		return x.TableIds
+	}
+	return nil
+}
+
+func (x *CheckTableAvailabilityRequest) GetSlot() *common.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *CheckTableAvailabilityRequest) GetBufferMinutes() map[string]int32 {
+	if x != nil {
+		return x.BufferMinutes
+	}
+	return nil
+}
+
+type CheckTableAvailabilityResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Tables        []*TableAvailabilityInfo `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckTableAvailabilityResponse) Reset() {
+	*x = CheckTableAvailabilityResponse{}
+	mi := &file_booking_booking_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckTableAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTableAvailabilityResponse) ProtoMessage() {}
+
+func (x *CheckTableAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTableAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*CheckTableAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CheckTableAvailabilityResponse) GetTables() []*TableAvailabilityInfo {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type TableAvailabilityInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Available     bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableAvailabilityInfo) Reset() {
+	*x = TableAvailabilityInfo{}
+	mi := &file_booking_booking_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableAvailabilityInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableAvailabilityInfo) ProtoMessage() {}
+
+func (x *TableAvailabilityInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableAvailabilityInfo.ProtoReflect.Descriptor instead.
+func (*TableAvailabilityInfo) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TableAvailabilityInfo) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *TableAvailabilityInfo) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *TableAvailabilityInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Аренда всего зала под мероприятие. Пока аренда tentative или confirmed,
+// все столы зала заняты на время слота
+type RoomHire struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VenueId       string                 `protobuf:"bytes,2,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	TableIds      []string               `protobuf:"bytes,4,rep,name=table_ids,json=tableIds,proto3" json:"table_ids,omitempty"` // столы зала, занятые арендой
+	Slot          *common.Slot           `protobuf:"bytes,5,opt,name=slot,proto3" json:"slot,omitempty"`
+	EventName     string                 `protobuf:"bytes,6,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	Headcount     int32                  `protobuf:"varint,7,opt,name=headcount,proto3" json:"headcount,omitempty"`
+	ContactName   string                 `protobuf:"bytes,8,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
+	ContactPhone  string                 `protobuf:"bytes,9,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	ContactEmail  string                 `protobuf:"bytes,10,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // tentative, confirmed, completed, cancelled
+	Comment       string                 `protobuf:"bytes,12,opt,name=comment,proto3" json:"comment,omitempty"`
+	AdminId       string                 `protobuf:"bytes,13,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	CancelReason  string                 `protobuf:"bytes,14,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomHire) Reset() {
+	*x = RoomHire{}
+	mi := &file_booking_booking_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomHire) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomHire) ProtoMessage() {}
+
+func (x *RoomHire) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomHire.ProtoReflect.Descriptor instead.
+func (*RoomHire) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RoomHire) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoomHire) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *RoomHire) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RoomHire) GetTableIds() []string {
+	if x != nil {
+		return x.TableIds
+	}
+	return nil
+}
+
+func (x *RoomHire) GetSlot() *common.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *RoomHire) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *RoomHire) GetHeadcount() int32 {
+	if x != nil {
+		return x.Headcount
+	}
+	return 0
+}
+
+func (x *RoomHire) GetContactName() string {
+	if x != nil {
+		return x.ContactName
+	}
+	return ""
+}
+
+func (x *RoomHire) GetContactPhone() string {
+	if x != nil {
+		return x.ContactPhone
+	}
+	return ""
+}
+
+func (x *RoomHire) GetContactEmail() string {
+	if x != nil {
+		return x.ContactEmail
+	}
+	return ""
+}
+
+func (x *RoomHire) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RoomHire) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *RoomHire) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *RoomHire) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+func (x *RoomHire) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *RoomHire) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type CreateRoomHireRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Slot          *common.Slot           `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"` // duration_minutes обязателен
+	EventName     string                 `protobuf:"bytes,4,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	Headcount     int32                  `protobuf:"varint,5,opt,name=headcount,proto3" json:"headcount,omitempty"`
+	ContactName   string                 `protobuf:"bytes,6,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
+	ContactPhone  string                 `protobuf:"bytes,7,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	ContactEmail  string                 `protobuf:"bytes,8,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	Comment       string                 `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	AdminId       string                 `protobuf:"bytes,10,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomHireRequest) Reset() {
+	*x = CreateRoomHireRequest{}
+	mi := &file_booking_booking_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomHireRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomHireRequest) ProtoMessage() {}
+
+func (x *CreateRoomHireRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomHireRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomHireRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateRoomHireRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *CreateRoomHireRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *CreateRoomHireRequest) GetSlot() *common.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *CreateRoomHireRequest) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *CreateRoomHireRequest) GetHeadcount() int32 {
+	if x != nil {
+		return x.Headcount
+	}
+	return 0
+}
+
+func (x *CreateRoomHireRequest) GetContactName() string {
+	if x != nil {
+		return x.ContactName
+	}
+	return ""
+}
+
+func (x *CreateRoomHireRequest) GetContactPhone() string {
+	if x != nil {
+		return x.ContactPhone
+	}
+	return ""
+}
+
+func (x *CreateRoomHireRequest) GetContactEmail() string {
+	if x != nil {
+		return x.ContactEmail
+	}
+	return ""
+}
+
+func (x *CreateRoomHireRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CreateRoomHireRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type GetRoomHireRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckTableAvailabilityRequest) Reset() {
-	*x = CheckTableAvailabilityRequest{}
-	mi := &file_booking_booking_proto_msgTypes[10]
+func (x *GetRoomHireRequest) Reset() {
+	*x = GetRoomHireRequest{}
+	mi := &file_booking_booking_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckTableAvailabilityRequest) String() string {
+func (x *GetRoomHireRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckTableAvailabilityRequest) ProtoMessage() {}
+func (*GetRoomHireRequest) ProtoMessage() {}
 
-func (x *CheckTableAvailabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_booking_proto_msgTypes[10]
+func (x *GetRoomHireRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -752,54 +2167,132 @@ func (x *CheckTableAvailabilityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckTableAvailabilityRequest.ProtoReflect.Descriptor instead.
-func (*CheckTableAvailabilityRequest) Descriptor() ([]byte, []int) {
-	return file_booking_booking_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetRoomHireRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomHireRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CheckTableAvailabilityRequest) GetVenueId() string {
+func (x *GetRoomHireRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListRoomHiresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`                         // YYYY-MM-DD
+	DateFrom      string                 `protobuf:"bytes,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // YYYY-MM-DD, включительно
+	DateTo        string                 `protobuf:"bytes,5,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // YYYY-MM-DD, включительно
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,7,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"` // только tentative и confirmed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomHiresRequest) Reset() {
+	*x = ListRoomHiresRequest{}
+	mi := &file_booking_booking_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomHiresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomHiresRequest) ProtoMessage() {}
+
+func (x *ListRoomHiresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomHiresRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomHiresRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListRoomHiresRequest) GetVenueId() string {
 	if x != nil {
 		return x.VenueId
 	}
 	return ""
 }
 
-func (x *CheckTableAvailabilityRequest) GetTableIds() []string {
+func (x *ListRoomHiresRequest) GetRoomId() string {
 	if x != nil {
-		return x.TableIds
+		return x.RoomId
 	}
-	return nil
+	return ""
 }
 
-func (x *CheckTableAvailabilityRequest) GetSlot() *common.Slot {
+func (x *ListRoomHiresRequest) GetDate() string {
 	if x != nil {
-		return x.Slot
+		return x.Date
 	}
-	return nil
+	return ""
 }
 
-type CheckTableAvailabilityResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Tables        []*TableAvailabilityInfo `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+func (x *ListRoomHiresRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *ListRoomHiresRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *ListRoomHiresRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListRoomHiresRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListRoomHiresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomHires     []*RoomHire            `protobuf:"bytes,1,rep,name=room_hires,json=roomHires,proto3" json:"room_hires,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckTableAvailabilityResponse) Reset() {
-	*x = CheckTableAvailabilityResponse{}
-	mi := &file_booking_booking_proto_msgTypes[11]
+func (x *ListRoomHiresResponse) Reset() {
+	*x = ListRoomHiresResponse{}
+	mi := &file_booking_booking_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckTableAvailabilityResponse) String() string {
+func (x *ListRoomHiresResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckTableAvailabilityResponse) ProtoMessage() {}
+func (*ListRoomHiresResponse) ProtoMessage() {}
 
-func (x *CheckTableAvailabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_booking_proto_msgTypes[11]
+func (x *ListRoomHiresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,42 +2303,94 @@ func (x *CheckTableAvailabilityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckTableAvailabilityResponse.ProtoReflect.Descriptor instead.
-func (*CheckTableAvailabilityResponse) Descriptor() ([]byte, []int) {
-	return file_booking_booking_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListRoomHiresResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomHiresResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CheckTableAvailabilityResponse) GetTables() []*TableAvailabilityInfo {
+func (x *ListRoomHiresResponse) GetRoomHires() []*RoomHire {
 	if x != nil {
-		return x.Tables
+		return x.RoomHires
 	}
 	return nil
 }
 
-type TableAvailabilityInfo struct {
+type ConfirmRoomHireRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	Available     bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmRoomHireRequest) Reset() {
+	*x = ConfirmRoomHireRequest{}
+	mi := &file_booking_booking_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmRoomHireRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmRoomHireRequest) ProtoMessage() {}
+
+func (x *ConfirmRoomHireRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmRoomHireRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmRoomHireRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ConfirmRoomHireRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConfirmRoomHireRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type CancelRoomHireRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
 	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TableAvailabilityInfo) Reset() {
-	*x = TableAvailabilityInfo{}
-	mi := &file_booking_booking_proto_msgTypes[12]
+func (x *CancelRoomHireRequest) Reset() {
+	*x = CancelRoomHireRequest{}
+	mi := &file_booking_booking_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TableAvailabilityInfo) String() string {
+func (x *CancelRoomHireRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TableAvailabilityInfo) ProtoMessage() {}
+func (*CancelRoomHireRequest) ProtoMessage() {}
 
-func (x *TableAvailabilityInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_booking_proto_msgTypes[12]
+func (x *CancelRoomHireRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -856,37 +2401,89 @@ func (x *TableAvailabilityInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TableAvailabilityInfo.ProtoReflect.Descriptor instead.
-func (*TableAvailabilityInfo) Descriptor() ([]byte, []int) {
-	return file_booking_booking_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CancelRoomHireRequest.ProtoReflect.Descriptor instead.
+func (*CancelRoomHireRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *TableAvailabilityInfo) GetTableId() string {
+func (x *CancelRoomHireRequest) GetId() string {
 	if x != nil {
-		return x.TableId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *TableAvailabilityInfo) GetAvailable() bool {
+func (x *CancelRoomHireRequest) GetAdminId() string {
 	if x != nil {
-		return x.Available
+		return x.AdminId
 	}
-	return false
+	return ""
 }
 
-func (x *TableAvailabilityInfo) GetReason() string {
+func (x *CancelRoomHireRequest) GetReason() string {
 	if x != nil {
 		return x.Reason
 	}
 	return ""
 }
 
+type CompleteRoomHireRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteRoomHireRequest) Reset() {
+	*x = CompleteRoomHireRequest{}
+	mi := &file_booking_booking_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteRoomHireRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteRoomHireRequest) ProtoMessage() {}
+
+func (x *CompleteRoomHireRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteRoomHireRequest.ProtoReflect.Descriptor instead.
+func (*CompleteRoomHireRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CompleteRoomHireRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteRoomHireRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
 var File_booking_booking_proto protoreflect.FileDescriptor
 
 const file_booking_booking_proto_rawDesc = "" +
 	"\n" +
-	"\x15booking/booking.proto\x12\abooking\x1a\x13common/events.proto\"\x93\x03\n" +
+	"\x15booking/booking.proto\x12\abooking\x1a\x13common/events.proto\"\xe5\x04\n" +
 	"\aBooking\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bvenue_id\x18\x02 \x01(\tR\avenueId\x12&\n" +
@@ -905,7 +2502,12 @@ const file_booking_booking_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\f \x01(\x03R\tupdatedAt\x12\x1d\n" +
 	"\n" +
-	"expires_at\x18\r \x01(\x03R\texpiresAt\"\xc4\x02\n" +
+	"expires_at\x18\r \x01(\x03R\texpiresAt\x12\x1a\n" +
+	"\bsequence\x18\x0e \x01(\x05R\bsequence\x129\n" +
+	"\x0fcombined_tables\x18\x0f \x03(\v2\x10.common.TableRefR\x0ecombinedTables\x12%\n" +
+	"\x0ebuffer_minutes\x18\x10 \x01(\x05R\rbufferMinutes\x12'\n" +
+	"\x0fneeds_attention\x18\x11 \x01(\bR\x0eneedsAttention\x12)\n" +
+	"\x10attention_reason\x18\x12 \x01(\tR\x0fattentionReason\"\xa6\x03\n" +
 	"\x14CreateBookingRequest\x12\x19\n" +
 	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12&\n" +
 	"\x05table\x18\x02 \x01(\v2\x10.common.TableRefR\x05table\x12 \n" +
@@ -916,16 +2518,65 @@ const file_booking_booking_proto_rawDesc = "" +
 	"\x0ecustomer_phone\x18\x06 \x01(\tR\rcustomerPhone\x12\x18\n" +
 	"\acomment\x18\a \x01(\tR\acomment\x12\x19\n" +
 	"\badmin_id\x18\b \x01(\tR\aadminId\x12'\n" +
-	"\x0fidempotency_key\x18\t \x01(\tR\x0eidempotencyKey\"#\n" +
+	"\x0fidempotency_key\x18\t \x01(\tR\x0eidempotencyKey\x12%\n" +
+	"\x0epreferred_zone\x18\n" +
+	" \x01(\tR\rpreferredZone\x129\n" +
+	"\x0fcombined_tables\x18\v \x03(\v2\x10.common.TableRefR\x0ecombinedTables\"#\n" +
 	"\x11GetBookingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xa5\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xdb\x01\n" +
 	"\x13ListBookingsRequest\x12\x19\n" +
 	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x12\n" +
 	"\x04date\x18\x02 \x01(\tR\x04date\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\tR\x06status\x12\x19\n" +
 	"\btable_id\x18\x04 \x01(\tR\atableId\x12\x14\n" +
 	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x06 \x01(\x05R\x06offset\"B\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\x12\x1b\n" +
+	"\tdate_from\x18\a \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\b \x01(\tR\x06dateTo\"\xaf\x01\n" +
+	"\x15ExportBookingsRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x19\n" +
+	"\btable_id\x18\x04 \x01(\tR\atableId\x12\x1b\n" +
+	"\tdate_from\x18\x05 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x06 \x01(\tR\x06dateTo\"\x94\x01\n" +
+	"\x15SearchBookingsRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1b\n" +
+	"\tdate_from\x18\x03 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x04 \x01(\tR\x06dateTo\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x95\x01\n" +
+	"\x15ImportBookingsRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12-\n" +
+	"\x04rows\x18\x02 \x03(\v2\x19.booking.ImportBookingRowR\x04rows\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x19\n" +
+	"\badmin_id\x18\x04 \x01(\tR\aadminId\"\xaa\x02\n" +
+	"\x10ImportBookingRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12!\n" +
+	"\fexternal_ref\x18\x02 \x01(\tR\vexternalRef\x12\x19\n" +
+	"\btable_id\x18\x03 \x01(\tR\atableId\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x04 \x01(\tR\ttableName\x12 \n" +
+	"\x04slot\x18\x05 \x01(\v2\f.common.SlotR\x04slot\x12\x1d\n" +
+	"\n" +
+	"party_size\x18\x06 \x01(\x05R\tpartySize\x12#\n" +
+	"\rcustomer_name\x18\a \x01(\tR\fcustomerName\x12%\n" +
+	"\x0ecustomer_phone\x18\b \x01(\tR\rcustomerPhone\x12\x18\n" +
+	"\acomment\x18\t \x01(\tR\acomment\"\xa2\x01\n" +
+	"\x16ImportBookingsResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.booking.ImportBookingResultR\aresults\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x03 \x01(\x05R\n" +
+	"duplicates\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\"\x99\x01\n" +
+	"\x13ImportBookingResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12!\n" +
+	"\fexternal_ref\x18\x02 \x01(\tR\vexternalRef\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x04 \x01(\tR\tbookingId\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"B\n" +
 	"\x15ConfirmBookingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\badmin_id\x18\x02 \x01(\tR\aadminId\"Y\n" +
@@ -944,22 +2595,113 @@ const file_booking_booking_proto_rawDesc = "" +
 	"\badmin_id\x18\x02 \x01(\tR\aadminId\"Z\n" +
 	"\x14ListBookingsResponse\x12,\n" +
 	"\bbookings\x18\x01 \x03(\v2\x10.booking.BookingR\bbookings\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"y\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"G\n" +
+	"\x16SearchBookingsResponse\x12-\n" +
+	"\x04hits\x18\x01 \x03(\v2\x19.booking.BookingSearchHitR\x04hits\"T\n" +
+	"\x10BookingSearchHit\x12*\n" +
+	"\abooking\x18\x01 \x01(\v2\x10.booking.BookingR\abooking\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x02R\x05score\"g\n" +
+	"\x1cListAttentionBookingsRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"I\n" +
+	"\x1cClearBookingAttentionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\"U\n" +
+	"\x1bListUpcomingBookingsRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x1b\n" +
+	"\ttable_ids\x18\x02 \x03(\tR\btableIds\"\xc7\x01\n" +
+	"\x12MoveBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12,\n" +
+	"\x12combined_table_ids\x18\x03 \x03(\tR\x10combinedTableIds\x12%\n" +
+	"\x0ebuffer_minutes\x18\x04 \x01(\x05R\rbufferMinutes\x12\x19\n" +
+	"\badmin_id\x18\x05 \x01(\tR\aadminId\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"b\n" +
+	"\x1bRequestBookingChangeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x19\n" +
+	"\badmin_id\x18\x03 \x01(\tR\aadminId\"\x9d\x02\n" +
 	"\x1dCheckTableAvailabilityRequest\x12\x19\n" +
 	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x1b\n" +
 	"\ttable_ids\x18\x02 \x03(\tR\btableIds\x12 \n" +
-	"\x04slot\x18\x03 \x01(\v2\f.common.SlotR\x04slot\"X\n" +
+	"\x04slot\x18\x03 \x01(\v2\f.common.SlotR\x04slot\x12`\n" +
+	"\x0ebuffer_minutes\x18\x04 \x03(\v29.booking.CheckTableAvailabilityRequest.BufferMinutesEntryR\rbufferMinutes\x1a@\n" +
+	"\x12BufferMinutesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"X\n" +
 	"\x1eCheckTableAvailabilityResponse\x126\n" +
 	"\x06tables\x18\x01 \x03(\v2\x1e.booking.TableAvailabilityInfoR\x06tables\"h\n" +
 	"\x15TableAvailabilityInfo\x12\x19\n" +
 	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x1c\n" +
 	"\tavailable\x18\x02 \x01(\bR\tavailable\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason2\x84\x05\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xe7\x03\n" +
+	"\bRoomHire\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bvenue_id\x18\x02 \x01(\tR\avenueId\x12\x17\n" +
+	"\aroom_id\x18\x03 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\ttable_ids\x18\x04 \x03(\tR\btableIds\x12 \n" +
+	"\x04slot\x18\x05 \x01(\v2\f.common.SlotR\x04slot\x12\x1d\n" +
+	"\n" +
+	"event_name\x18\x06 \x01(\tR\teventName\x12\x1c\n" +
+	"\theadcount\x18\a \x01(\x05R\theadcount\x12!\n" +
+	"\fcontact_name\x18\b \x01(\tR\vcontactName\x12#\n" +
+	"\rcontact_phone\x18\t \x01(\tR\fcontactPhone\x12#\n" +
+	"\rcontact_email\x18\n" +
+	" \x01(\tR\fcontactEmail\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x18\n" +
+	"\acomment\x18\f \x01(\tR\acomment\x12\x19\n" +
+	"\badmin_id\x18\r \x01(\tR\aadminId\x12#\n" +
+	"\rcancel_reason\x18\x0e \x01(\tR\fcancelReason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\x03R\tupdatedAt\"\xcc\x02\n" +
+	"\x15CreateRoomHireRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12 \n" +
+	"\x04slot\x18\x03 \x01(\v2\f.common.SlotR\x04slot\x12\x1d\n" +
+	"\n" +
+	"event_name\x18\x04 \x01(\tR\teventName\x12\x1c\n" +
+	"\theadcount\x18\x05 \x01(\x05R\theadcount\x12!\n" +
+	"\fcontact_name\x18\x06 \x01(\tR\vcontactName\x12#\n" +
+	"\rcontact_phone\x18\a \x01(\tR\fcontactPhone\x12#\n" +
+	"\rcontact_email\x18\b \x01(\tR\fcontactEmail\x12\x18\n" +
+	"\acomment\x18\t \x01(\tR\acomment\x12\x19\n" +
+	"\badmin_id\x18\n" +
+	" \x01(\tR\aadminId\"$\n" +
+	"\x12GetRoomHireRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xcd\x01\n" +
+	"\x14ListRoomHiresRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x1b\n" +
+	"\tdate_from\x18\x04 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x05 \x01(\tR\x06dateTo\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1f\n" +
+	"\vactive_only\x18\a \x01(\bR\n" +
+	"activeOnly\"I\n" +
+	"\x15ListRoomHiresResponse\x120\n" +
+	"\n" +
+	"room_hires\x18\x01 \x03(\v2\x11.booking.RoomHireR\troomHires\"C\n" +
+	"\x16ConfirmRoomHireRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\"Z\n" +
+	"\x15CancelRoomHireRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"D\n" +
+	"\x17CompleteRoomHireRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId2\xb5\r\n" +
 	"\x0eBookingService\x12@\n" +
 	"\rCreateBooking\x12\x1d.booking.CreateBookingRequest\x1a\x10.booking.Booking\x12:\n" +
 	"\n" +
 	"GetBooking\x12\x1a.booking.GetBookingRequest\x1a\x10.booking.Booking\x12K\n" +
-	"\fListBookings\x12\x1c.booking.ListBookingsRequest\x1a\x1d.booking.ListBookingsResponse\x12B\n" +
+	"\fListBookings\x12\x1c.booking.ListBookingsRequest\x1a\x1d.booking.ListBookingsResponse\x12Q\n" +
+	"\x0eSearchBookings\x12\x1e.booking.SearchBookingsRequest\x1a\x1f.booking.SearchBookingsResponse\x12D\n" +
+	"\x0eExportBookings\x12\x1e.booking.ExportBookingsRequest\x1a\x10.booking.Booking0\x01\x12Q\n" +
+	"\x0eImportBookings\x12\x1e.booking.ImportBookingsRequest\x1a\x1f.booking.ImportBookingsResponse\x12B\n" +
 	"\x0eConfirmBooking\x12\x1e.booking.ConfirmBookingRequest\x1a\x10.booking.Booking\x12@\n" +
 	"\rCancelBooking\x12\x1d.booking.CancelBookingRequest\x1a\x10.booking.Booking\x12:\n" +
 	"\n" +
@@ -967,7 +2709,18 @@ const file_booking_booking_proto_rawDesc = "" +
 	"\fMarkFinished\x12\x1c.booking.MarkFinishedRequest\x1a\x10.booking.Booking\x12:\n" +
 	"\n" +
 	"MarkNoShow\x12\x1a.booking.MarkNoShowRequest\x1a\x10.booking.Booking\x12i\n" +
-	"\x16CheckTableAvailability\x12&.booking.CheckTableAvailabilityRequest\x1a'.booking.CheckTableAvailabilityResponseB\x1aZ\x18booker/pkg/proto/bookingb\x06proto3"
+	"\x16CheckTableAvailability\x12&.booking.CheckTableAvailabilityRequest\x1a'.booking.CheckTableAvailabilityResponse\x12]\n" +
+	"\x15ListAttentionBookings\x12%.booking.ListAttentionBookingsRequest\x1a\x1d.booking.ListBookingsResponse\x12P\n" +
+	"\x15ClearBookingAttention\x12%.booking.ClearBookingAttentionRequest\x1a\x10.booking.Booking\x12[\n" +
+	"\x14ListUpcomingBookings\x12$.booking.ListUpcomingBookingsRequest\x1a\x1d.booking.ListBookingsResponse\x12<\n" +
+	"\vMoveBooking\x12\x1b.booking.MoveBookingRequest\x1a\x10.booking.Booking\x12N\n" +
+	"\x14RequestBookingChange\x12$.booking.RequestBookingChangeRequest\x1a\x10.booking.Booking\x12C\n" +
+	"\x0eCreateRoomHire\x12\x1e.booking.CreateRoomHireRequest\x1a\x11.booking.RoomHire\x12=\n" +
+	"\vGetRoomHire\x12\x1b.booking.GetRoomHireRequest\x1a\x11.booking.RoomHire\x12N\n" +
+	"\rListRoomHires\x12\x1d.booking.ListRoomHiresRequest\x1a\x1e.booking.ListRoomHiresResponse\x12E\n" +
+	"\x0fConfirmRoomHire\x12\x1f.booking.ConfirmRoomHireRequest\x1a\x11.booking.RoomHire\x12C\n" +
+	"\x0eCancelRoomHire\x12\x1e.booking.CancelRoomHireRequest\x1a\x11.booking.RoomHire\x12G\n" +
+	"\x10CompleteRoomHire\x12 .booking.CompleteRoomHireRequest\x1a\x11.booking.RoomHireB\x1aZ\x18booker/pkg/proto/bookingb\x06proto3"
 
 var (
 	file_booking_booking_proto_rawDescOnce sync.Once
@@ -981,55 +2734,116 @@ func file_booking_booking_proto_rawDescGZIP() []byte {
 	return file_booking_booking_proto_rawDescData
 }
 
-var file_booking_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_booking_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_booking_booking_proto_goTypes = []any{
 	(*Booking)(nil),                        // 0: booking.Booking
 	(*CreateBookingRequest)(nil),           // 1: booking.CreateBookingRequest
 	(*GetBookingRequest)(nil),              // 2: booking.GetBookingRequest
 	(*ListBookingsRequest)(nil),            // 3: booking.ListBookingsRequest
-	(*ConfirmBookingRequest)(nil),          // 4: booking.ConfirmBookingRequest
-	(*CancelBookingRequest)(nil),           // 5: booking.CancelBookingRequest
-	(*MarkSeatedRequest)(nil),              // 6: booking.MarkSeatedRequest
-	(*MarkFinishedRequest)(nil),            // 7: booking.MarkFinishedRequest
-	(*MarkNoShowRequest)(nil),              // 8: booking.MarkNoShowRequest
-	(*ListBookingsResponse)(nil),           // 9: booking.ListBookingsResponse
-	(*CheckTableAvailabilityRequest)(nil),  // 10: booking.CheckTableAvailabilityRequest
-	(*CheckTableAvailabilityResponse)(nil), // 11: booking.CheckTableAvailabilityResponse
-	(*TableAvailabilityInfo)(nil),          // 12: booking.TableAvailabilityInfo
-	(*common.TableRef)(nil),                // 13: common.TableRef
-	(*common.Slot)(nil),                    // 14: common.Slot
+	(*ExportBookingsRequest)(nil),          // 4: booking.ExportBookingsRequest
+	(*SearchBookingsRequest)(nil),          // 5: booking.SearchBookingsRequest
+	(*ImportBookingsRequest)(nil),          // 6: booking.ImportBookingsRequest
+	(*ImportBookingRow)(nil),               // 7: booking.ImportBookingRow
+	(*ImportBookingsResponse)(nil),         // 8: booking.ImportBookingsResponse
+	(*ImportBookingResult)(nil),            // 9: booking.ImportBookingResult
+	(*ConfirmBookingRequest)(nil),          // 10: booking.ConfirmBookingRequest
+	(*CancelBookingRequest)(nil),           // 11: booking.CancelBookingRequest
+	(*MarkSeatedRequest)(nil),              // 12: booking.MarkSeatedRequest
+	(*MarkFinishedRequest)(nil),            // 13: booking.MarkFinishedRequest
+	(*MarkNoShowRequest)(nil),              // 14: booking.MarkNoShowRequest
+	(*ListBookingsResponse)(nil),           // 15: booking.ListBookingsResponse
+	(*SearchBookingsResponse)(nil),         // 16: booking.SearchBookingsResponse
+	(*BookingSearchHit)(nil),               // 17: booking.BookingSearchHit
+	(*ListAttentionBookingsRequest)(nil),   // 18: booking.ListAttentionBookingsRequest
+	(*ClearBookingAttentionRequest)(nil),   // 19: booking.ClearBookingAttentionRequest
+	(*ListUpcomingBookingsRequest)(nil),    // 20: booking.ListUpcomingBookingsRequest
+	(*MoveBookingRequest)(nil),             // 21: booking.MoveBookingRequest
+	(*RequestBookingChangeRequest)(nil),    // 22: booking.RequestBookingChangeRequest
+	(*CheckTableAvailabilityRequest)(nil),  // 23: booking.CheckTableAvailabilityRequest
+	(*CheckTableAvailabilityResponse)(nil), // 24: booking.CheckTableAvailabilityResponse
+	(*TableAvailabilityInfo)(nil),          // 25: booking.TableAvailabilityInfo
+	(*RoomHire)(nil),                       // 26: booking.RoomHire
+	(*CreateRoomHireRequest)(nil),          // 27: booking.CreateRoomHireRequest
+	(*GetRoomHireRequest)(nil),             // 28: booking.GetRoomHireRequest
+	(*ListRoomHiresRequest)(nil),           // 29: booking.ListRoomHiresRequest
+	(*ListRoomHiresResponse)(nil),          // 30: booking.ListRoomHiresResponse
+	(*ConfirmRoomHireRequest)(nil),         // 31: booking.ConfirmRoomHireRequest
+	(*CancelRoomHireRequest)(nil),          // 32: booking.CancelRoomHireRequest
+	(*CompleteRoomHireRequest)(nil),        // 33: booking.CompleteRoomHireRequest
+	nil,                                    // 34: booking.CheckTableAvailabilityRequest.BufferMinutesEntry
+	(*common.TableRef)(nil),                // 35: common.TableRef
+	(*common.Slot)(nil),                    // 36: common.Slot
 }
 var file_booking_booking_proto_depIdxs = []int32{
-	13, // 0: booking.Booking.table:type_name -> common.TableRef
-	14, // 1: booking.Booking.slot:type_name -> common.Slot
-	13, // 2: booking.CreateBookingRequest.table:type_name -> common.TableRef
-	14, // 3: booking.CreateBookingRequest.slot:type_name -> common.Slot
-	0,  // 4: booking.ListBookingsResponse.bookings:type_name -> booking.Booking
-	14, // 5: booking.CheckTableAvailabilityRequest.slot:type_name -> common.Slot
-	12, // 6: booking.CheckTableAvailabilityResponse.tables:type_name -> booking.TableAvailabilityInfo
-	1,  // 7: booking.BookingService.CreateBooking:input_type -> booking.CreateBookingRequest
-	2,  // 8: booking.BookingService.GetBooking:input_type -> booking.GetBookingRequest
-	3,  // 9: booking.BookingService.ListBookings:input_type -> booking.ListBookingsRequest
-	4,  // 10: booking.BookingService.ConfirmBooking:input_type -> booking.ConfirmBookingRequest
-	5,  // 11: booking.BookingService.CancelBooking:input_type -> booking.CancelBookingRequest
-	6,  // 12: booking.BookingService.MarkSeated:input_type -> booking.MarkSeatedRequest
-	7,  // 13: booking.BookingService.MarkFinished:input_type -> booking.MarkFinishedRequest
-	8,  // 14: booking.BookingService.MarkNoShow:input_type -> booking.MarkNoShowRequest
-	10, // 15: booking.BookingService.CheckTableAvailability:input_type -> booking.CheckTableAvailabilityRequest
-	0,  // 16: booking.BookingService.CreateBooking:output_type -> booking.Booking
-	0,  // 17: booking.BookingService.GetBooking:output_type -> booking.Booking
-	9,  // 18: booking.BookingService.ListBookings:output_type -> booking.ListBookingsResponse
-	0,  // 19: booking.BookingService.ConfirmBooking:output_type -> booking.Booking
-	0,  // 20: booking.BookingService.CancelBooking:output_type -> booking.Booking
-	0,  // 21: booking.BookingService.MarkSeated:output_type -> booking.Booking
-	0,  // 22: booking.BookingService.MarkFinished:output_type -> booking.Booking
-	0,  // 23: booking.BookingService.MarkNoShow:output_type -> booking.Booking
-	11, // 24: booking.BookingService.CheckTableAvailability:output_type -> booking.CheckTableAvailabilityResponse
-	16, // [16:25] is the sub-list for method output_type
-	7,  // [7:16] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	35, // 0: booking.Booking.table:type_name -> common.TableRef
+	36, // 1: booking.Booking.slot:type_name -> common.Slot
+	35, // 2: booking.Booking.combined_tables:type_name -> common.TableRef
+	35, // 3: booking.CreateBookingRequest.table:type_name -> common.TableRef
+	36, // 4: booking.CreateBookingRequest.slot:type_name -> common.Slot
+	35, // 5: booking.CreateBookingRequest.combined_tables:type_name -> common.TableRef
+	7,  // 6: booking.ImportBookingsRequest.rows:type_name -> booking.ImportBookingRow
+	36, // 7: booking.ImportBookingRow.slot:type_name -> common.Slot
+	9,  // 8: booking.ImportBookingsResponse.results:type_name -> booking.ImportBookingResult
+	0,  // 9: booking.ListBookingsResponse.bookings:type_name -> booking.Booking
+	17, // 10: booking.SearchBookingsResponse.hits:type_name -> booking.BookingSearchHit
+	0,  // 11: booking.BookingSearchHit.booking:type_name -> booking.Booking
+	36, // 12: booking.CheckTableAvailabilityRequest.slot:type_name -> common.Slot
+	34, // 13: booking.CheckTableAvailabilityRequest.buffer_minutes:type_name -> booking.CheckTableAvailabilityRequest.BufferMinutesEntry
+	25, // 14: booking.CheckTableAvailabilityResponse.tables:type_name -> booking.TableAvailabilityInfo
+	36, // 15: booking.RoomHire.slot:type_name -> common.Slot
+	36, // 16: booking.CreateRoomHireRequest.slot:type_name -> common.Slot
+	26, // 17: booking.ListRoomHiresResponse.room_hires:type_name -> booking.RoomHire
+	1,  // 18: booking.BookingService.CreateBooking:input_type -> booking.CreateBookingRequest
+	2,  // 19: booking.BookingService.GetBooking:input_type -> booking.GetBookingRequest
+	3,  // 20: booking.BookingService.ListBookings:input_type -> booking.ListBookingsRequest
+	5,  // 21: booking.BookingService.SearchBookings:input_type -> booking.SearchBookingsRequest
+	4,  // 22: booking.BookingService.ExportBookings:input_type -> booking.ExportBookingsRequest
+	6,  // 23: booking.BookingService.ImportBookings:input_type -> booking.ImportBookingsRequest
+	10, // 24: booking.BookingService.ConfirmBooking:input_type -> booking.ConfirmBookingRequest
+	11, // 25: booking.BookingService.CancelBooking:input_type -> booking.CancelBookingRequest
+	12, // 26: booking.BookingService.MarkSeated:input_type -> booking.MarkSeatedRequest
+	13, // 27: booking.BookingService.MarkFinished:input_type -> booking.MarkFinishedRequest
+	14, // 28: booking.BookingService.MarkNoShow:input_type -> booking.MarkNoShowRequest
+	23, // 29: booking.BookingService.CheckTableAvailability:input_type -> booking.CheckTableAvailabilityRequest
+	18, // 30: booking.BookingService.ListAttentionBookings:input_type -> booking.ListAttentionBookingsRequest
+	19, // 31: booking.BookingService.ClearBookingAttention:input_type -> booking.ClearBookingAttentionRequest
+	20, // 32: booking.BookingService.ListUpcomingBookings:input_type -> booking.ListUpcomingBookingsRequest
+	21, // 33: booking.BookingService.MoveBooking:input_type -> booking.MoveBookingRequest
+	22, // 34: booking.BookingService.RequestBookingChange:input_type -> booking.RequestBookingChangeRequest
+	27, // 35: booking.BookingService.CreateRoomHire:input_type -> booking.CreateRoomHireRequest
+	28, // 36: booking.BookingService.GetRoomHire:input_type -> booking.GetRoomHireRequest
+	29, // 37: booking.BookingService.ListRoomHires:input_type -> booking.ListRoomHiresRequest
+	31, // 38: booking.BookingService.ConfirmRoomHire:input_type -> booking.ConfirmRoomHireRequest
+	32, // 39: booking.BookingService.CancelRoomHire:input_type -> booking.CancelRoomHireRequest
+	33, // 40: booking.BookingService.CompleteRoomHire:input_type -> booking.CompleteRoomHireRequest
+	0,  // 41: booking.BookingService.CreateBooking:output_type -> booking.Booking
+	0,  // 42: booking.BookingService.GetBooking:output_type -> booking.Booking
+	15, // 43: booking.BookingService.ListBookings:output_type -> booking.ListBookingsResponse
+	16, // 44: booking.BookingService.SearchBookings:output_type -> booking.SearchBookingsResponse
+	0,  // 45: booking.BookingService.ExportBookings:output_type -> booking.Booking
+	8,  // 46: booking.BookingService.ImportBookings:output_type -> booking.ImportBookingsResponse
+	0,  // 47: booking.BookingService.ConfirmBooking:output_type -> booking.Booking
+	0,  // 48: booking.BookingService.CancelBooking:output_type -> booking.Booking
+	0,  // 49: booking.BookingService.MarkSeated:output_type -> booking.Booking
+	0,  // 50: booking.BookingService.MarkFinished:output_type -> booking.Booking
+	0,  // 51: booking.BookingService.MarkNoShow:output_type -> booking.Booking
+	24, // 52: booking.BookingService.CheckTableAvailability:output_type -> booking.CheckTableAvailabilityResponse
+	15, // 53: booking.BookingService.ListAttentionBookings:output_type -> booking.ListBookingsResponse
+	0,  // 54: booking.BookingService.ClearBookingAttention:output_type -> booking.Booking
+	15, // 55: booking.BookingService.ListUpcomingBookings:output_type -> booking.ListBookingsResponse
+	0,  // 56: booking.BookingService.MoveBooking:output_type -> booking.Booking
+	0,  // 57: booking.BookingService.RequestBookingChange:output_type -> booking.Booking
+	26, // 58: booking.BookingService.CreateRoomHire:output_type -> booking.RoomHire
+	26, // 59: booking.BookingService.GetRoomHire:output_type -> booking.RoomHire
+	30, // 60: booking.BookingService.ListRoomHires:output_type -> booking.ListRoomHiresResponse
+	26, // 61: booking.BookingService.ConfirmRoomHire:output_type -> booking.RoomHire
+	26, // 62: booking.BookingService.CancelRoomHire:output_type -> booking.RoomHire
+	26, // 63: booking.BookingService.CompleteRoomHire:output_type -> booking.RoomHire
+	41, // [41:64] is the sub-list for method output_type
+	18, // [18:41] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_booking_booking_proto_init() }
@@ -1043,7 +2857,7 @@ func file_booking_booking_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_booking_proto_rawDesc), len(file_booking_booking_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
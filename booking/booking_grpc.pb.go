@@ -22,12 +22,26 @@ const (
 	BookingService_CreateBooking_FullMethodName          = "/booking.BookingService/CreateBooking"
 	BookingService_GetBooking_FullMethodName             = "/booking.BookingService/GetBooking"
 	BookingService_ListBookings_FullMethodName           = "/booking.BookingService/ListBookings"
+	BookingService_SearchBookings_FullMethodName         = "/booking.BookingService/SearchBookings"
+	BookingService_ExportBookings_FullMethodName         = "/booking.BookingService/ExportBookings"
+	BookingService_ImportBookings_FullMethodName         = "/booking.BookingService/ImportBookings"
 	BookingService_ConfirmBooking_FullMethodName         = "/booking.BookingService/ConfirmBooking"
 	BookingService_CancelBooking_FullMethodName          = "/booking.BookingService/CancelBooking"
 	BookingService_MarkSeated_FullMethodName             = "/booking.BookingService/MarkSeated"
 	BookingService_MarkFinished_FullMethodName           = "/booking.BookingService/MarkFinished"
 	BookingService_MarkNoShow_FullMethodName             = "/booking.BookingService/MarkNoShow"
 	BookingService_CheckTableAvailability_FullMethodName = "/booking.BookingService/CheckTableAvailability"
+	BookingService_ListAttentionBookings_FullMethodName  = "/booking.BookingService/ListAttentionBookings"
+	BookingService_ClearBookingAttention_FullMethodName  = "/booking.BookingService/ClearBookingAttention"
+	BookingService_ListUpcomingBookings_FullMethodName   = "/booking.BookingService/ListUpcomingBookings"
+	BookingService_MoveBooking_FullMethodName            = "/booking.BookingService/MoveBooking"
+	BookingService_RequestBookingChange_FullMethodName   = "/booking.BookingService/RequestBookingChange"
+	BookingService_CreateRoomHire_FullMethodName         = "/booking.BookingService/CreateRoomHire"
+	BookingService_GetRoomHire_FullMethodName            = "/booking.BookingService/GetRoomHire"
+	BookingService_ListRoomHires_FullMethodName          = "/booking.BookingService/ListRoomHires"
+	BookingService_ConfirmRoomHire_FullMethodName        = "/booking.BookingService/ConfirmRoomHire"
+	BookingService_CancelRoomHire_FullMethodName         = "/booking.BookingService/CancelRoomHire"
+	BookingService_CompleteRoomHire_FullMethodName       = "/booking.BookingService/CompleteRoomHire"
 )
 
 // BookingServiceClient is the client API for BookingService service.
@@ -37,12 +51,26 @@ type BookingServiceClient interface {
 	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*Booking, error)
 	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*Booking, error)
 	ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error)
+	SearchBookings(ctx context.Context, in *SearchBookingsRequest, opts ...grpc.CallOption) (*SearchBookingsResponse, error)
+	ExportBookings(ctx context.Context, in *ExportBookingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Booking], error)
+	ImportBookings(ctx context.Context, in *ImportBookingsRequest, opts ...grpc.CallOption) (*ImportBookingsResponse, error)
 	ConfirmBooking(ctx context.Context, in *ConfirmBookingRequest, opts ...grpc.CallOption) (*Booking, error)
 	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*Booking, error)
 	MarkSeated(ctx context.Context, in *MarkSeatedRequest, opts ...grpc.CallOption) (*Booking, error)
 	MarkFinished(ctx context.Context, in *MarkFinishedRequest, opts ...grpc.CallOption) (*Booking, error)
 	MarkNoShow(ctx context.Context, in *MarkNoShowRequest, opts ...grpc.CallOption) (*Booking, error)
 	CheckTableAvailability(ctx context.Context, in *CheckTableAvailabilityRequest, opts ...grpc.CallOption) (*CheckTableAvailabilityResponse, error)
+	ListAttentionBookings(ctx context.Context, in *ListAttentionBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error)
+	ClearBookingAttention(ctx context.Context, in *ClearBookingAttentionRequest, opts ...grpc.CallOption) (*Booking, error)
+	ListUpcomingBookings(ctx context.Context, in *ListUpcomingBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error)
+	MoveBooking(ctx context.Context, in *MoveBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	RequestBookingChange(ctx context.Context, in *RequestBookingChangeRequest, opts ...grpc.CallOption) (*Booking, error)
+	CreateRoomHire(ctx context.Context, in *CreateRoomHireRequest, opts ...grpc.CallOption) (*RoomHire, error)
+	GetRoomHire(ctx context.Context, in *GetRoomHireRequest, opts ...grpc.CallOption) (*RoomHire, error)
+	ListRoomHires(ctx context.Context, in *ListRoomHiresRequest, opts ...grpc.CallOption) (*ListRoomHiresResponse, error)
+	ConfirmRoomHire(ctx context.Context, in *ConfirmRoomHireRequest, opts ...grpc.CallOption) (*RoomHire, error)
+	CancelRoomHire(ctx context.Context, in *CancelRoomHireRequest, opts ...grpc.CallOption) (*RoomHire, error)
+	CompleteRoomHire(ctx context.Context, in *CompleteRoomHireRequest, opts ...grpc.CallOption) (*RoomHire, error)
 }
 
 type bookingServiceClient struct {
@@ -83,6 +111,45 @@ func (c *bookingServiceClient) ListBookings(ctx context.Context, in *ListBooking
 	return out, nil
 }
 
+func (c *bookingServiceClient) SearchBookings(ctx context.Context, in *SearchBookingsRequest, opts ...grpc.CallOption) (*SearchBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchBookingsResponse)
+	err := c.cc.Invoke(ctx, BookingService_SearchBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ExportBookings(ctx context.Context, in *ExportBookingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Booking], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BookingService_ServiceDesc.Streams[0], BookingService_ExportBookings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportBookingsRequest, Booking]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookingService_ExportBookingsClient = grpc.ServerStreamingClient[Booking]
+
+func (c *bookingServiceClient) ImportBookings(ctx context.Context, in *ImportBookingsRequest, opts ...grpc.CallOption) (*ImportBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportBookingsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ImportBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) ConfirmBooking(ctx context.Context, in *ConfirmBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Booking)
@@ -143,6 +210,116 @@ func (c *bookingServiceClient) CheckTableAvailability(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *bookingServiceClient) ListAttentionBookings(ctx context.Context, in *ListAttentionBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookingsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListAttentionBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ClearBookingAttention(ctx context.Context, in *ClearBookingAttentionRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, BookingService_ClearBookingAttention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListUpcomingBookings(ctx context.Context, in *ListUpcomingBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookingsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListUpcomingBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) MoveBooking(ctx context.Context, in *MoveBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, BookingService_MoveBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) RequestBookingChange(ctx context.Context, in *RequestBookingChangeRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, BookingService_RequestBookingChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateRoomHire(ctx context.Context, in *CreateRoomHireRequest, opts ...grpc.CallOption) (*RoomHire, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoomHire)
+	err := c.cc.Invoke(ctx, BookingService_CreateRoomHire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetRoomHire(ctx context.Context, in *GetRoomHireRequest, opts ...grpc.CallOption) (*RoomHire, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoomHire)
+	err := c.cc.Invoke(ctx, BookingService_GetRoomHire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListRoomHires(ctx context.Context, in *ListRoomHiresRequest, opts ...grpc.CallOption) (*ListRoomHiresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoomHiresResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListRoomHires_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ConfirmRoomHire(ctx context.Context, in *ConfirmRoomHireRequest, opts ...grpc.CallOption) (*RoomHire, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoomHire)
+	err := c.cc.Invoke(ctx, BookingService_ConfirmRoomHire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CancelRoomHire(ctx context.Context, in *CancelRoomHireRequest, opts ...grpc.CallOption) (*RoomHire, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoomHire)
+	err := c.cc.Invoke(ctx, BookingService_CancelRoomHire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CompleteRoomHire(ctx context.Context, in *CompleteRoomHireRequest, opts ...grpc.CallOption) (*RoomHire, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoomHire)
+	err := c.cc.Invoke(ctx, BookingService_CompleteRoomHire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookingServiceServer is the server API for BookingService service.
 // All implementations must embed UnimplementedBookingServiceServer
 // for forward compatibility.
@@ -150,12 +327,26 @@ type BookingServiceServer interface {
 	CreateBooking(context.Context, *CreateBookingRequest) (*Booking, error)
 	GetBooking(context.Context, *GetBookingRequest) (*Booking, error)
 	ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error)
+	SearchBookings(context.Context, *SearchBookingsRequest) (*SearchBookingsResponse, error)
+	ExportBookings(*ExportBookingsRequest, grpc.ServerStreamingServer[Booking]) error
+	ImportBookings(context.Context, *ImportBookingsRequest) (*ImportBookingsResponse, error)
 	ConfirmBooking(context.Context, *ConfirmBookingRequest) (*Booking, error)
 	CancelBooking(context.Context, *CancelBookingRequest) (*Booking, error)
 	MarkSeated(context.Context, *MarkSeatedRequest) (*Booking, error)
 	MarkFinished(context.Context, *MarkFinishedRequest) (*Booking, error)
 	MarkNoShow(context.Context, *MarkNoShowRequest) (*Booking, error)
 	CheckTableAvailability(context.Context, *CheckTableAvailabilityRequest) (*CheckTableAvailabilityResponse, error)
+	ListAttentionBookings(context.Context, *ListAttentionBookingsRequest) (*ListBookingsResponse, error)
+	ClearBookingAttention(context.Context, *ClearBookingAttentionRequest) (*Booking, error)
+	ListUpcomingBookings(context.Context, *ListUpcomingBookingsRequest) (*ListBookingsResponse, error)
+	MoveBooking(context.Context, *MoveBookingRequest) (*Booking, error)
+	RequestBookingChange(context.Context, *RequestBookingChangeRequest) (*Booking, error)
+	CreateRoomHire(context.Context, *CreateRoomHireRequest) (*RoomHire, error)
+	GetRoomHire(context.Context, *GetRoomHireRequest) (*RoomHire, error)
+	ListRoomHires(context.Context, *ListRoomHiresRequest) (*ListRoomHiresResponse, error)
+	ConfirmRoomHire(context.Context, *ConfirmRoomHireRequest) (*RoomHire, error)
+	CancelRoomHire(context.Context, *CancelRoomHireRequest) (*RoomHire, error)
+	CompleteRoomHire(context.Context, *CompleteRoomHireRequest) (*RoomHire, error)
 	mustEmbedUnimplementedBookingServiceServer()
 }
 
@@ -175,6 +366,15 @@ func (UnimplementedBookingServiceServer) GetBooking(context.Context, *GetBooking
 func (UnimplementedBookingServiceServer) ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBookings not implemented")
 }
+func (UnimplementedBookingServiceServer) SearchBookings(context.Context, *SearchBookingsRequest) (*SearchBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) ExportBookings(*ExportBookingsRequest, grpc.ServerStreamingServer[Booking]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) ImportBookings(context.Context, *ImportBookingsRequest) (*ImportBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportBookings not implemented")
+}
 func (UnimplementedBookingServiceServer) ConfirmBooking(context.Context, *ConfirmBookingRequest) (*Booking, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmBooking not implemented")
 }
@@ -193,6 +393,39 @@ func (UnimplementedBookingServiceServer) MarkNoShow(context.Context, *MarkNoShow
 func (UnimplementedBookingServiceServer) CheckTableAvailability(context.Context, *CheckTableAvailabilityRequest) (*CheckTableAvailabilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckTableAvailability not implemented")
 }
+func (UnimplementedBookingServiceServer) ListAttentionBookings(context.Context, *ListAttentionBookingsRequest) (*ListBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttentionBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) ClearBookingAttention(context.Context, *ClearBookingAttentionRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearBookingAttention not implemented")
+}
+func (UnimplementedBookingServiceServer) ListUpcomingBookings(context.Context, *ListUpcomingBookingsRequest) (*ListBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUpcomingBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) MoveBooking(context.Context, *MoveBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) RequestBookingChange(context.Context, *RequestBookingChangeRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBookingChange not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateRoomHire(context.Context, *CreateRoomHireRequest) (*RoomHire, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoomHire not implemented")
+}
+func (UnimplementedBookingServiceServer) GetRoomHire(context.Context, *GetRoomHireRequest) (*RoomHire, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoomHire not implemented")
+}
+func (UnimplementedBookingServiceServer) ListRoomHires(context.Context, *ListRoomHiresRequest) (*ListRoomHiresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoomHires not implemented")
+}
+func (UnimplementedBookingServiceServer) ConfirmRoomHire(context.Context, *ConfirmRoomHireRequest) (*RoomHire, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmRoomHire not implemented")
+}
+func (UnimplementedBookingServiceServer) CancelRoomHire(context.Context, *CancelRoomHireRequest) (*RoomHire, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRoomHire not implemented")
+}
+func (UnimplementedBookingServiceServer) CompleteRoomHire(context.Context, *CompleteRoomHireRequest) (*RoomHire, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteRoomHire not implemented")
+}
 func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
 func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
 
@@ -268,6 +501,53 @@ func _BookingService_ListBookings_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_SearchBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SearchBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SearchBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SearchBookings(ctx, req.(*SearchBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ExportBookings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportBookingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookingServiceServer).ExportBookings(m, &grpc.GenericServerStream[ExportBookingsRequest, Booking]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookingService_ExportBookingsServer = grpc.ServerStreamingServer[Booking]
+
+func _BookingService_ImportBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ImportBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ImportBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ImportBookings(ctx, req.(*ImportBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookingService_ConfirmBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConfirmBookingRequest)
 	if err := dec(in); err != nil {
@@ -376,6 +656,204 @@ func _BookingService_CheckTableAvailability_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_ListAttentionBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttentionBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListAttentionBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListAttentionBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListAttentionBookings(ctx, req.(*ListAttentionBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ClearBookingAttention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearBookingAttentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ClearBookingAttention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ClearBookingAttention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ClearBookingAttention(ctx, req.(*ClearBookingAttentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListUpcomingBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUpcomingBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListUpcomingBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListUpcomingBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListUpcomingBookings(ctx, req.(*ListUpcomingBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_MoveBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).MoveBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_MoveBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).MoveBooking(ctx, req.(*MoveBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RequestBookingChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestBookingChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RequestBookingChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_RequestBookingChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RequestBookingChange(ctx, req.(*RequestBookingChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateRoomHire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomHireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateRoomHire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateRoomHire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateRoomHire(ctx, req.(*CreateRoomHireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetRoomHire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomHireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetRoomHire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetRoomHire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetRoomHire(ctx, req.(*GetRoomHireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListRoomHires_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomHiresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListRoomHires(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListRoomHires_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListRoomHires(ctx, req.(*ListRoomHiresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ConfirmRoomHire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmRoomHireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ConfirmRoomHire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ConfirmRoomHire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ConfirmRoomHire(ctx, req.(*ConfirmRoomHireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CancelRoomHire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRoomHireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelRoomHire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelRoomHire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelRoomHire(ctx, req.(*CancelRoomHireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CompleteRoomHire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteRoomHireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CompleteRoomHire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CompleteRoomHire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CompleteRoomHire(ctx, req.(*CompleteRoomHireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -395,6 +873,14 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBookings",
 			Handler:    _BookingService_ListBookings_Handler,
 		},
+		{
+			MethodName: "SearchBookings",
+			Handler:    _BookingService_SearchBookings_Handler,
+		},
+		{
+			MethodName: "ImportBookings",
+			Handler:    _BookingService_ImportBookings_Handler,
+		},
 		{
 			MethodName: "ConfirmBooking",
 			Handler:    _BookingService_ConfirmBooking_Handler,
@@ -419,7 +905,57 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckTableAvailability",
 			Handler:    _BookingService_CheckTableAvailability_Handler,
 		},
+		{
+			MethodName: "ListAttentionBookings",
+			Handler:    _BookingService_ListAttentionBookings_Handler,
+		},
+		{
+			MethodName: "ClearBookingAttention",
+			Handler:    _BookingService_ClearBookingAttention_Handler,
+		},
+		{
+			MethodName: "ListUpcomingBookings",
+			Handler:    _BookingService_ListUpcomingBookings_Handler,
+		},
+		{
+			MethodName: "MoveBooking",
+			Handler:    _BookingService_MoveBooking_Handler,
+		},
+		{
+			MethodName: "RequestBookingChange",
+			Handler:    _BookingService_RequestBookingChange_Handler,
+		},
+		{
+			MethodName: "CreateRoomHire",
+			Handler:    _BookingService_CreateRoomHire_Handler,
+		},
+		{
+			MethodName: "GetRoomHire",
+			Handler:    _BookingService_GetRoomHire_Handler,
+		},
+		{
+			MethodName: "ListRoomHires",
+			Handler:    _BookingService_ListRoomHires_Handler,
+		},
+		{
+			MethodName: "ConfirmRoomHire",
+			Handler:    _BookingService_ConfirmRoomHire_Handler,
+		},
+		{
+			MethodName: "CancelRoomHire",
+			Handler:    _BookingService_CancelRoomHire_Handler,
+		},
+		{
+			MethodName: "CompleteRoomHire",
+			Handler:    _BookingService_CompleteRoomHire_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportBookings",
+			Handler:       _BookingService_ExportBookings_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "booking/booking.proto",
 }
@@ -10,10 +10,17 @@ type Config struct {
 	Env            string
 	GRPCVenueAddr  string
 	GRPCBookingAddr string
+	GRPCNotifyAddr string
 	RedisAddr      string
 	RedisPassword  string
 	JWTSecret      string
 	JaegerEndpoint string
+	// iCalendar feed tokens; feeds are disabled while it is empty
+	ICalSecret     string
+	PublicURL      string
+	// Guest self-service links; rotating the secret revokes all issued links, and
+	// guest routes are disabled while it is empty
+	GuestLinkSecret string
 }
 
 func Load() *Config {
@@ -22,10 +29,14 @@ func Load() *Config {
 		Env:            getEnv("ENV", "development"),
 		GRPCVenueAddr:  getEnv("GRPC_VENUE_ADDR", "localhost:50051"),
 		GRPCBookingAddr: getEnv("GRPC_BOOKING_ADDR", "localhost:50052"),
+		GRPCNotifyAddr: getEnv("GRPC_NOTIFY_ADDR", "localhost:50053"),
 		RedisAddr:      getEnv("REDIS_ADDR", "localhost:6379"),
 		RedisPassword:  getEnv("REDIS_PASSWORD", ""),
 		JWTSecret:      getEnv("JWT_SECRET", "change-me-in-production"),
 		JaegerEndpoint: getEnv("JAEGER_ENDPOINT", "http://localhost:14268/api/traces"),
+		ICalSecret:     getEnv("ICAL_SECRET", ""),
+		PublicURL:      getEnv("PUBLIC_URL", "http://localhost:18080"),
+		GuestLinkSecret: getEnv("GUEST_LINK_SECRET", ""),
 	}
 }
 
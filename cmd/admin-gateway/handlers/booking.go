@@ -7,6 +7,8 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	bookingpb "booker/pkg/proto/booking"
@@ -34,8 +36,9 @@ func (h *Handler) ListVenues(c echo.Context) error {
 	offset, _ := strconv.Atoi(c.QueryParam("offset"))
 
 	resp, err := h.venueClient.ListVenues(c.Request().Context(), &venuepb.ListVenuesRequest{
-		Limit:  int32(limit),
-		Offset: int32(offset),
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+		IncludeDeleted: c.QueryParam("include_deleted") == "true",
 	})
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
@@ -57,9 +60,10 @@ func (h *Handler) GetVenue(c echo.Context) error {
 
 func (h *Handler) CreateVenue(c echo.Context) error {
 	var req struct {
-		Name     string `json:"name"`
-		Timezone string `json:"timezone"`
-		Address  string `json:"address"`
+		Name          string `json:"name"`
+		Timezone      string `json:"timezone"`
+		Address       string `json:"address"`
+		BufferMinutes int32  `json:"buffer_minutes"`
 	}
 	if err := c.Bind(&req); err != nil {
 		log.Warn().Err(err).Msg("Failed to bind CreateVenue request")
@@ -73,9 +77,10 @@ func (h *Handler) CreateVenue(c echo.Context) error {
 		Msg("Creating venue")
 
 	resp, err := h.venueClient.CreateVenue(c.Request().Context(), &venuepb.CreateVenueRequest{
-		Name:     req.Name,
-		Timezone: req.Timezone,
-		Address:  req.Address,
+		Name:          req.Name,
+		Timezone:      req.Timezone,
+		Address:       req.Address,
+		BufferMinutes: req.BufferMinutes,
 	})
 	if err != nil {
 		log.Error().Err(err).
@@ -94,17 +99,28 @@ func (h *Handler) CreateVenue(c echo.Context) error {
 
 func (h *Handler) UpdateVenue(c echo.Context) error {
 	var req struct {
-		Name    string `json:"name"`
-		Address string `json:"address"`
+		Name          string `json:"name"`
+		Address       string `json:"address"`
+		BufferMinutes *int32 `json:"buffer_minutes"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
+	// Clients that do not know about buffers must not reset it
+	if req.BufferMinutes == nil {
+		venue, err := h.venueClient.GetVenue(c.Request().Context(), &venuepb.GetVenueRequest{Id: c.Param("id")})
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		}
+		req.BufferMinutes = &venue.BufferMinutes
+	}
+
 	resp, err := h.venueClient.UpdateVenue(c.Request().Context(), &venuepb.UpdateVenueRequest{
-		Id:      c.Param("id"),
-		Name:    req.Name,
-		Address: req.Address,
+		Id:            c.Param("id"),
+		Name:          req.Name,
+		Address:       req.Address,
+		BufferMinutes: *req.BufferMinutes,
 	})
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
@@ -118,8 +134,10 @@ func (h *Handler) DeleteVenue(c echo.Context) error {
 	
 	log.Info().Str("venue_id", venueID).Msg("Deleting venue")
 	
-	_, err := h.venueClient.DeleteVenue(c.Request().Context(), &venuepb.DeleteVenueRequest{
-		Id: venueID,
+	resp, err := h.venueClient.DeleteVenue(c.Request().Context(), &venuepb.DeleteVenueRequest{
+		Id:      venueID,
+		Force:   c.QueryParam("force") == "true",
+		AdminId: c.Get("admin_id").(string),
 	})
 	if err != nil {
 		log.Error().Err(err).
@@ -127,9 +145,73 @@ func (h *Handler) DeleteVenue(c echo.Context) error {
 			Msg("Failed to delete venue")
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
+	if !resp.Success {
+		return c.JSON(http.StatusConflict, resp)
+	}
 
 	log.Info().Str("venue_id", venueID).Msg("Venue deleted successfully")
-	return c.NoContent(http.StatusNoContent)
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) RestoreVenue(c echo.Context) error {
+	resp, err := h.venueClient.RestoreVenue(c.Request().Context(), &venuepb.RestoreVenueRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		return restoreErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) RestoreRoom(c echo.Context) error {
+	resp, err := h.venueClient.RestoreRoom(c.Request().Context(), &venuepb.RestoreRoomRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		return restoreErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) RestoreTable(c echo.Context) error {
+	resp, err := h.venueClient.RestoreTable(c.Request().Context(), &venuepb.RestoreTableRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		return restoreErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// restoreErrorResponse answers 409 when the entity is not deleted or its parent still is
+func restoreErrorResponse(c echo.Context, err error) error {
+	if status.Code(err) == codes.FailedPrecondition {
+		return c.JSON(http.StatusConflict, map[string]string{"error": status.Convert(err).Message()})
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+}
+
+// deletionRequest is the optional body of room and table deletion: bookings listed
+// here are moved to the given tables instead of blocking the deletion
+type deletionRequest struct {
+	Reassignments []struct {
+		BookingID string   `json:"booking_id"`
+		TableIDs  []string `json:"table_ids"`
+	} `json:"reassignments"`
+}
+
+func (r *deletionRequest) toProto() []*venuepb.BookingReassignment {
+	reassignments := make([]*venuepb.BookingReassignment, len(r.Reassignments))
+	for i, a := range r.Reassignments {
+		reassignments[i] = &venuepb.BookingReassignment{
+			BookingId: a.BookingID,
+			TableIds:  a.TableIDs,
+		}
+	}
+	return reassignments
 }
 
 // Room handlers
@@ -141,9 +223,10 @@ func (h *Handler) ListRooms(c echo.Context) error {
 	offset, _ := strconv.Atoi(c.QueryParam("offset"))
 
 	resp, err := h.venueClient.ListRooms(c.Request().Context(), &venuepb.ListRoomsRequest{
-		VenueId: c.Param("venueId"),
-		Limit:   int32(limit),
-		Offset:  int32(offset),
+		VenueId:        c.Param("venueId"),
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+		IncludeDeleted: c.QueryParam("include_deleted") == "true",
 	})
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
@@ -202,14 +285,92 @@ func (h *Handler) UpdateRoom(c echo.Context) error {
 }
 
 func (h *Handler) DeleteRoom(c echo.Context) error {
-	_, err := h.venueClient.DeleteRoom(c.Request().Context(), &venuepb.DeleteRoomRequest{
-		Id: c.Param("id"),
+	var req deletionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	resp, err := h.venueClient.DeleteRoom(c.Request().Context(), &venuepb.DeleteRoomRequest{
+		Id:            c.Param("id"),
+		Force:         c.QueryParam("force") == "true",
+		Reassignments: req.toProto(),
+		AdminId:       c.Get("admin_id").(string),
 	})
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
+	if !resp.Success {
+		return c.JSON(http.StatusConflict, resp)
+	}
 
-	return c.NoContent(http.StatusNoContent)
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetRoomLayout(c echo.Context) error {
+	resp, err := h.venueClient.GetTableLayout(c.Request().Context(), &venuepb.GetTableLayoutRequest{
+		RoomId: c.Param("id"),
+		Date:   c.QueryParam("date"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) SaveRoomLayout(c echo.Context) error {
+	var req struct {
+		LayoutVersion int32   `json:"layout_version"`
+		Width         float64 `json:"width"`
+		Height        float64 `json:"height"`
+		BackgroundURL string  `json:"background_url"`
+		Tables        []struct {
+			TableID  string  `json:"table_id"`
+			X        float64 `json:"x"`
+			Y        float64 `json:"y"`
+			Width    float64 `json:"width"`
+			Height   float64 `json:"height"`
+			Shape    string  `json:"shape"`
+			Rotation float64 `json:"rotation"`
+		} `json:"tables"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	placements := make([]*venuepb.TablePlacement, len(req.Tables))
+	for i, t := range req.Tables {
+		placements[i] = &venuepb.TablePlacement{
+			TableId:  t.TableID,
+			X:        t.X,
+			Y:        t.Y,
+			Width:    t.Width,
+			Height:   t.Height,
+			Shape:    t.Shape,
+			Rotation: t.Rotation,
+		}
+	}
+
+	resp, err := h.venueClient.SaveRoomLayout(c.Request().Context(), &venuepb.SaveRoomLayoutRequest{
+		RoomId:        c.Param("id"),
+		LayoutVersion: req.LayoutVersion,
+		Width:         req.Width,
+		Height:        req.Height,
+		BackgroundUrl: req.BackgroundURL,
+		Tables:        placements,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Aborted:
+			// Another editor saved the plan first, the client has to reload it
+			return c.JSON(http.StatusConflict, map[string]string{"error": status.Convert(err).Message()})
+		case codes.InvalidArgument:
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": status.Convert(err).Message()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
 }
 
 // Table handlers
@@ -221,9 +382,10 @@ func (h *Handler) ListTables(c echo.Context) error {
 	offset, _ := strconv.Atoi(c.QueryParam("offset"))
 
 	resp, err := h.venueClient.ListTables(c.Request().Context(), &venuepb.ListTablesRequest{
-		RoomId: c.Param("roomId"),
-		Limit:  int32(limit),
-		Offset: int32(offset),
+		RoomId:         c.Param("roomId"),
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+		IncludeDeleted: c.QueryParam("include_deleted") == "true",
 	})
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
@@ -245,21 +407,23 @@ func (h *Handler) GetTable(c echo.Context) error {
 
 func (h *Handler) CreateTable(c echo.Context) error {
 	var req struct {
-		Name     string `json:"name"`
-		Capacity int32  `json:"capacity"`
-		CanMerge bool   `json:"can_merge"`
-		Zone     string `json:"zone"`
+		Name          string `json:"name"`
+		Capacity      int32  `json:"capacity"`
+		CanMerge      bool   `json:"can_merge"`
+		Zone          string `json:"zone"`
+		BufferMinutes int32  `json:"buffer_minutes"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
 	resp, err := h.venueClient.CreateTable(c.Request().Context(), &venuepb.CreateTableRequest{
-		RoomId:   c.Param("roomId"),
-		Name:     req.Name,
-		Capacity: req.Capacity,
-		CanMerge: req.CanMerge,
-		Zone:     req.Zone,
+		RoomId:        c.Param("roomId"),
+		Name:          req.Name,
+		Capacity:      req.Capacity,
+		CanMerge:      req.CanMerge,
+		Zone:          req.Zone,
+		BufferMinutes: req.BufferMinutes,
 	})
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
@@ -270,19 +434,30 @@ func (h *Handler) CreateTable(c echo.Context) error {
 
 func (h *Handler) UpdateTable(c echo.Context) error {
 	var req struct {
-		Name     string `json:"name"`
-		Capacity int32  `json:"capacity"`
-		Zone     string `json:"zone"`
+		Name          string `json:"name"`
+		Capacity      int32  `json:"capacity"`
+		Zone          string `json:"zone"`
+		BufferMinutes *int32 `json:"buffer_minutes"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
+	// Clients that do not know about buffers must not reset it
+	if req.BufferMinutes == nil {
+		table, err := h.venueClient.GetTable(c.Request().Context(), &venuepb.GetTableRequest{Id: c.Param("id")})
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		}
+		req.BufferMinutes = &table.BufferMinutes
+	}
+
 	resp, err := h.venueClient.UpdateTable(c.Request().Context(), &venuepb.UpdateTableRequest{
-		Id:       c.Param("id"),
-		Name:     req.Name,
-		Capacity: req.Capacity,
-		Zone:     req.Zone,
+		Id:            c.Param("id"),
+		Name:          req.Name,
+		Capacity:      req.Capacity,
+		Zone:          req.Zone,
+		BufferMinutes: *req.BufferMinutes,
 	})
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
@@ -292,7 +467,121 @@ func (h *Handler) UpdateTable(c echo.Context) error {
 }
 
 func (h *Handler) DeleteTable(c echo.Context) error {
-	_, err := h.venueClient.DeleteTable(c.Request().Context(), &venuepb.DeleteTableRequest{
+	var req deletionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	resp, err := h.venueClient.DeleteTable(c.Request().Context(), &venuepb.DeleteTableRequest{
+		Id:            c.Param("id"),
+		Force:         c.QueryParam("force") == "true",
+		Reassignments: req.toProto(),
+		AdminId:       c.Get("admin_id").(string),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	if !resp.Success {
+		return c.JSON(http.StatusConflict, resp)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Table block handlers
+func (h *Handler) ListTableBlocks(c echo.Context) error {
+	resp, err := h.venueClient.ListTableBlocks(c.Request().Context(), &venuepb.ListTableBlocksRequest{
+		VenueId: c.Param("venueId"),
+		TableId: c.QueryParam("table_id"),
+		Date:    c.QueryParam("date"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) CreateTableBlock(c echo.Context) error {
+	var req struct {
+		Date       string `json:"date"`
+		StartTime  string `json:"start_time"`
+		EndTime    string `json:"end_time"`
+		Reason     string `json:"reason"`
+		Recurrence string `json:"recurrence"`
+		UntilDate  string `json:"until_date"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	resp, err := h.venueClient.CreateTableBlock(c.Request().Context(), &venuepb.CreateTableBlockRequest{
+		TableId:    c.Param("id"),
+		Date:       req.Date,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		Reason:     req.Reason,
+		Recurrence: req.Recurrence,
+		UntilDate:  req.UntilDate,
+		AdminId:    c.Get("admin_id").(string),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, resp)
+}
+
+func (h *Handler) DeleteTableBlock(c echo.Context) error {
+	_, err := h.venueClient.DeleteTableBlock(c.Request().Context(), &venuepb.DeleteTableBlockRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Table combination handlers
+func (h *Handler) ListTableCombinations(c echo.Context) error {
+	resp, err := h.venueClient.ListTableCombinations(c.Request().Context(), &venuepb.ListTableCombinationsRequest{
+		RoomId: c.Param("roomId"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) CreateTableCombination(c echo.Context) error {
+	var req struct {
+		Name        string   `json:"name"`
+		TableIDs    []string `json:"table_ids"`
+		MinCapacity int32    `json:"min_capacity"`
+		MaxCapacity int32    `json:"max_capacity"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	resp, err := h.venueClient.CreateTableCombination(c.Request().Context(), &venuepb.CreateTableCombinationRequest{
+		RoomId:      c.Param("roomId"),
+		Name:        req.Name,
+		TableIds:    req.TableIDs,
+		MinCapacity: req.MinCapacity,
+		MaxCapacity: req.MaxCapacity,
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, resp)
+}
+
+func (h *Handler) DeleteTableCombination(c echo.Context) error {
+	_, err := h.venueClient.DeleteTableCombination(c.Request().Context(), &venuepb.DeleteTableCombinationRequest{
 		Id: c.Param("id"),
 	})
 	if err != nil {
@@ -371,6 +660,106 @@ func (h *Handler) SetSpecialHours(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
+func (h *Handler) GetTurnTimeRules(c echo.Context) error {
+	resp, err := h.venueClient.GetTurnTimeRules(c.Request().Context(), &venuepb.GetTurnTimeRulesRequest{
+		VenueId: c.Param("venueId"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) SetTurnTimeRules(c echo.Context) error {
+	var req struct {
+		Rules []struct {
+			MinPartySize    int32   `json:"min_party_size"`
+			MaxPartySize    int32   `json:"max_party_size"`
+			Weekdays        []int32 `json:"weekdays"`
+			PeriodStart     string  `json:"period_start"`
+			PeriodEnd       string  `json:"period_end"`
+			DurationMinutes int32   `json:"duration_minutes"`
+			ExtraMinutes    int32   `json:"extra_minutes"`
+		} `json:"rules"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	rules := make([]*venuepb.TurnTimeRule, len(req.Rules))
+	for i, r := range req.Rules {
+		rules[i] = &venuepb.TurnTimeRule{
+			MinPartySize:    r.MinPartySize,
+			MaxPartySize:    r.MaxPartySize,
+			Weekdays:        r.Weekdays,
+			PeriodStart:     r.PeriodStart,
+			PeriodEnd:       r.PeriodEnd,
+			DurationMinutes: r.DurationMinutes,
+			ExtraMinutes:    r.ExtraMinutes,
+		}
+	}
+
+	resp, err := h.venueClient.SetTurnTimeRules(c.Request().Context(), &venuepb.SetTurnTimeRulesRequest{
+		VenueId: c.Param("venueId"),
+		Rules:   rules,
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetPacingRules(c echo.Context) error {
+	resp, err := h.venueClient.GetPacingRules(c.Request().Context(), &venuepb.GetPacingRulesRequest{
+		VenueId: c.Param("venueId"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) SetPacingRules(c echo.Context) error {
+	var req struct {
+		Rules []struct {
+			IntervalMinutes int32   `json:"interval_minutes"`
+			Weekdays        []int32 `json:"weekdays"`
+			PeriodStart     string  `json:"period_start"`
+			PeriodEnd       string  `json:"period_end"`
+			MaxCovers       int32   `json:"max_covers"`
+			MaxBookings     int32   `json:"max_bookings"`
+		} `json:"rules"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	rules := make([]*venuepb.PacingRule, len(req.Rules))
+	for i, r := range req.Rules {
+		rules[i] = &venuepb.PacingRule{
+			IntervalMinutes: r.IntervalMinutes,
+			Weekdays:        r.Weekdays,
+			PeriodStart:     r.PeriodStart,
+			PeriodEnd:       r.PeriodEnd,
+			MaxCovers:       r.MaxCovers,
+			MaxBookings:     r.MaxBookings,
+		}
+	}
+
+	resp, err := h.venueClient.SetPacingRules(c.Request().Context(), &venuepb.SetPacingRulesRequest{
+		VenueId: c.Param("venueId"),
+		Rules:   rules,
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
 // Booking handlers
 func (h *Handler) ListBookings(c echo.Context) error {
 	limit, _ := strconv.Atoi(c.QueryParam("limit"))
@@ -380,12 +769,31 @@ func (h *Handler) ListBookings(c echo.Context) error {
 	offset, _ := strconv.Atoi(c.QueryParam("offset"))
 
 	resp, err := h.bookingClient.ListBookings(c.Request().Context(), &bookingpb.ListBookingsRequest{
-		VenueId: c.QueryParam("venue_id"),
-		Date:    c.QueryParam("date"),
-		Status:  c.QueryParam("status"),
-		TableId: c.QueryParam("table_id"),
-		Limit:   int32(limit),
-		Offset:  int32(offset),
+		VenueId:  c.QueryParam("venue_id"),
+		Date:     c.QueryParam("date"),
+		DateFrom: c.QueryParam("date_from"),
+		DateTo:   c.QueryParam("date_to"),
+		Status:   c.QueryParam("status"),
+		TableId:  c.QueryParam("table_id"),
+		Limit:    int32(limit),
+		Offset:   int32(offset),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) SearchBookings(c echo.Context) error {
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	resp, err := h.bookingClient.SearchBookings(c.Request().Context(), &bookingpb.SearchBookingsRequest{
+		VenueId:  c.QueryParam("venue_id"),
+		Query:    c.QueryParam("q"),
+		DateFrom: c.QueryParam("date_from"),
+		DateTo:   c.QueryParam("date_to"),
+		Limit:    int32(limit),
 	})
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
@@ -423,6 +831,12 @@ func (h *Handler) CreateBooking(c echo.Context) error {
 		CustomerPhone  string `json:"customer_phone"`
 		Comment        string `json:"comment"`
 		IdempotencyKey string `json:"idempotency_key"`
+		PreferredZone  string `json:"preferred_zone"`
+		CombinedTables []struct {
+			VenueID string `json:"venue_id"`
+			RoomID  string `json:"room_id"`
+			TableID string `json:"table_id"`
+		} `json:"combined_tables"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
@@ -430,6 +844,11 @@ func (h *Handler) CreateBooking(c echo.Context) error {
 
 	adminID := c.Get("admin_id").(string)
 
+	combined := make([]*commonpb.TableRef, len(req.CombinedTables))
+	for i, t := range req.CombinedTables {
+		combined[i] = &commonpb.TableRef{VenueId: t.VenueID, RoomId: t.RoomID, TableId: t.TableID}
+	}
+
 	resp, err := h.bookingClient.CreateBooking(c.Request().Context(), &bookingpb.CreateBookingRequest{
 		VenueId: req.VenueID,
 		Table: &commonpb.TableRef{
@@ -448,8 +867,14 @@ func (h *Handler) CreateBooking(c echo.Context) error {
 		Comment:        req.Comment,
 		AdminId:        adminID,
 		IdempotencyKey: req.IdempotencyKey,
+		PreferredZone:  req.PreferredZone,
+		CombinedTables: combined,
 	})
 	if err != nil {
+		if status.Code(err) == codes.Aborted {
+			// The tables were taken while the booking was being made
+			return c.JSON(http.StatusConflict, map[string]string{"error": status.Convert(err).Message()})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
@@ -532,6 +957,37 @@ func (h *Handler) MarkNoShow(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
+// ListAttentionBookings lists upcoming bookings whose tables or hours changed under them
+func (h *Handler) ListAttentionBookings(c echo.Context) error {
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	offset, _ := strconv.Atoi(c.QueryParam("offset"))
+
+	resp, err := h.bookingClient.ListAttentionBookings(c.Request().Context(), &bookingpb.ListAttentionBookingsRequest{
+		VenueId: c.QueryParam("venue_id"),
+		Limit:   int32(limit),
+		Offset:  int32(offset),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) ClearBookingAttention(c echo.Context) error {
+	adminID := c.Get("admin_id").(string)
+
+	resp, err := h.bookingClient.ClearBookingAttention(c.Request().Context(), &bookingpb.ClearBookingAttentionRequest{
+		Id:      c.Param("id"),
+		AdminId: adminID,
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
 func (h *Handler) CheckAvailability(c echo.Context) error {
 	var req struct {
 		VenueID string `json:"venue_id"`
@@ -540,7 +996,8 @@ func (h *Handler) CheckAvailability(c echo.Context) error {
 			StartTime       string `json:"start_time"`
 			DurationMinutes int32  `json:"duration_minutes"`
 		} `json:"slot"`
-		PartySize int32 `json:"party_size"`
+		PartySize     int32  `json:"party_size"`
+		PreferredZone string `json:"preferred_zone"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
@@ -553,7 +1010,8 @@ func (h *Handler) CheckAvailability(c echo.Context) error {
 			StartTime:       req.Slot.StartTime,
 			DurationMinutes: req.Slot.DurationMinutes,
 		},
-		PartySize: req.PartySize,
+		PartySize:     req.PartySize,
+		PreferredZone: req.PreferredZone,
 	})
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
@@ -573,6 +1031,29 @@ func (h *Handler) CheckAvailability(c echo.Context) error {
 	return c.Blob(http.StatusOK, echo.MIMEApplicationJSON, jsonBytes)
 }
 
+// SearchSlots lists free start times of a day, e.g. "what is free on Saturday for 4"
+func (h *Handler) SearchSlots(c echo.Context) error {
+	partySize, _ := strconv.Atoi(c.QueryParam("party_size"))
+	interval, _ := strconv.Atoi(c.QueryParam("interval"))
+	duration, _ := strconv.Atoi(c.QueryParam("duration_minutes"))
+
+	resp, err := h.venueClient.SearchSlots(c.Request().Context(), &venuepb.SearchSlotsRequest{
+		VenueId:         c.Param("venueId"),
+		Date:            c.QueryParam("date"),
+		PartySize:       int32(partySize),
+		WindowStart:     c.QueryParam("from"),
+		WindowEnd:       c.QueryParam("to"),
+		IntervalMinutes: int32(interval),
+		DurationMinutes: int32(duration),
+		PreferredZone:   c.QueryParam("preferred_zone"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
 func (h *Handler) WebSocket(c echo.Context) error {
 	// TODO: Implement WebSocket for live updates
 	return c.String(http.StatusNotImplemented, "WebSocket not implemented yet")
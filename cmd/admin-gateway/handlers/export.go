@@ -0,0 +1,349 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/xuri/excelize/v2"
+
+	bookingpb "booker/pkg/proto/booking"
+	venuepb "booker/pkg/proto/venue"
+)
+
+// exportColumn describes a single column of the bookings export
+type exportColumn struct {
+	header string
+	value  func(b *bookingpb.Booking, ec *exportContext) interface{}
+}
+
+// exportContext holds venue data needed to render rows: timezone and table names
+type exportContext struct {
+	loc        *time.Location
+	tableNames map[string]string
+}
+
+var exportColumns = map[string]exportColumn{
+	"id": {"ID", func(b *bookingpb.Booking, _ *exportContext) interface{} {
+		return b.Id
+	}},
+	"date": {"Date", func(b *bookingpb.Booking, _ *exportContext) interface{} {
+		return b.Slot.GetDate()
+	}},
+	"start_time": {"Start", func(b *bookingpb.Booking, _ *exportContext) interface{} {
+		return formatClock(b.Slot.GetStartTime())
+	}},
+	"duration_minutes": {"Duration (min)", func(b *bookingpb.Booking, _ *exportContext) interface{} {
+		return b.Slot.GetDurationMinutes()
+	}},
+	"table": {"Table", func(b *bookingpb.Booking, ec *exportContext) interface{} {
+		if name, ok := ec.tableNames[b.Table.GetTableId()]; ok {
+			return name
+		}
+		return b.Table.GetTableId()
+	}},
+	"table_id": {"Table ID", func(b *bookingpb.Booking, _ *exportContext) interface{} {
+		return b.Table.GetTableId()
+	}},
+	"party_size": {"Guests", func(b *bookingpb.Booking, _ *exportContext) interface{} {
+		return b.PartySize
+	}},
+	"customer_name": {"Name", func(b *bookingpb.Booking, _ *exportContext) interface{} {
+		return spreadsheetText(b.CustomerName)
+	}},
+	"customer_phone": {"Phone", func(b *bookingpb.Booking, _ *exportContext) interface{} {
+		return b.CustomerPhone
+	}},
+	"status": {"Status", func(b *bookingpb.Booking, _ *exportContext) interface{} {
+		return b.Status
+	}},
+	"comment": {"Comment", func(b *bookingpb.Booking, _ *exportContext) interface{} {
+		return spreadsheetText(b.Comment)
+	}},
+	"admin_id": {"Admin", func(b *bookingpb.Booking, _ *exportContext) interface{} {
+		return b.AdminId
+	}},
+	"created_at": {"Created", func(b *bookingpb.Booking, ec *exportContext) interface{} {
+		return ec.formatUnix(b.CreatedAt)
+	}},
+	"updated_at": {"Updated", func(b *bookingpb.Booking, ec *exportContext) interface{} {
+		return ec.formatUnix(b.UpdatedAt)
+	}},
+}
+
+var defaultExportColumns = []string{
+	"date", "start_time", "table", "party_size", "customer_name", "customer_phone", "status", "comment",
+}
+
+// ExportBookings streams bookings matching ListBookings filters as CSV or XLSX
+func (h *Handler) ExportBookings(c echo.Context) error {
+	format := c.QueryParam("format")
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "xlsx" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "format must be csv or xlsx"})
+	}
+
+	columns, err := parseExportColumns(c.QueryParam("columns"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	ctx := c.Request().Context()
+	venueID := c.QueryParam("venue_id")
+
+	ec, err := h.newExportContext(ctx, venueID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	stream, err := h.bookingClient.ExportBookings(ctx, &bookingpb.ExportBookingsRequest{
+		VenueId:  venueID,
+		Date:     c.QueryParam("date"),
+		Status:   c.QueryParam("status"),
+		TableId:  c.QueryParam("table_id"),
+		DateFrom: c.QueryParam("date_from"),
+		DateTo:   c.QueryParam("date_to"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	// Receive the first row before writing headers so that early errors
+	// are still reported as a proper JSON error response
+	booking, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	filename := fmt.Sprintf("bookings-%s.%s", time.Now().In(ec.loc).Format("2006-01-02"), format)
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+
+	// The status is sent with the first bytes: at once for CSV, on Close for XLSX,
+	// which is built in full before it is written
+	var w exportWriter
+	if format == "xlsx" {
+		c.Response().Header().Set(echo.HeaderContentType, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+		w, err = newXLSXExportWriter(c.Response())
+		if err != nil {
+			return exportFailed(c, err)
+		}
+	} else {
+		c.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+		w = newCSVExportWriter(c.Response())
+	}
+
+	header := make([]interface{}, len(columns))
+	for i, name := range columns {
+		header[i] = exportColumns[name].header
+	}
+	if err := w.WriteRow(header); err != nil {
+		return err
+	}
+
+	rows := 0
+	for booking != nil {
+		row := make([]interface{}, len(columns))
+		for i, name := range columns {
+			row[i] = exportColumns[name].value(booking, ec)
+		}
+		if err := w.WriteRow(row); err != nil {
+			return err
+		}
+		rows++
+
+		booking, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Error().Err(err).Str("venue_id", venueID).Int("rows", rows).Msg("Bookings export interrupted")
+			w.Abort(err)
+			return exportFailed(c, err)
+		}
+	}
+
+	return w.Close()
+}
+
+// exportFailed reports an error that happened after the export started. Before
+// anything is sent it is a JSON error; after that the connection is dropped, so
+// the client sees a failed download rather than a truncated file
+func exportFailed(c echo.Context, err error) error {
+	if !c.Response().Committed {
+		c.Response().Header().Del(echo.HeaderContentDisposition)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	panic(http.ErrAbortHandler)
+}
+
+func (h *Handler) newExportContext(ctx context.Context, venueID string) (*exportContext, error) {
+	ec := &exportContext{
+		loc:        time.UTC,
+		tableNames: map[string]string{},
+	}
+	if venueID == "" {
+		return ec, nil
+	}
+
+	venue, err := h.venueClient.GetVenue(ctx, &venuepb.GetVenueRequest{Id: venueID})
+	if err != nil {
+		return nil, err
+	}
+	if loc, err := time.LoadLocation(venue.Timezone); err == nil {
+		ec.loc = loc
+	} else {
+		log.Warn().Err(err).Str("venue_id", venueID).Str("timezone", venue.Timezone).Msg("Unknown venue timezone, exporting in UTC")
+	}
+
+	tables, err := h.venueClient.ListTables(ctx, &venuepb.ListTablesRequest{
+		VenueId: venueID,
+		Limit:   1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tables.Tables {
+		ec.tableNames[t.Id] = t.Name
+	}
+
+	return ec, nil
+}
+
+func (ec *exportContext) formatUnix(ts int64) string {
+	if ts == 0 {
+		return ""
+	}
+	return time.Unix(ts, 0).In(ec.loc).Format("2006-01-02 15:04")
+}
+
+func parseExportColumns(param string) ([]string, error) {
+	if param == "" {
+		return defaultExportColumns, nil
+	}
+
+	var columns []string
+	for _, name := range strings.Split(param, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := exportColumns[name]; !ok {
+			return nil, fmt.Errorf("unknown export column: %s", name)
+		}
+		columns = append(columns, name)
+	}
+	if len(columns) == 0 {
+		return defaultExportColumns, nil
+	}
+	return columns, nil
+}
+
+// spreadsheetText keeps text guests entered from being run as a formula when the
+// export is opened in a spreadsheet: a leading =, +, -, @, tab or carriage return
+// gets a ' in front
+func spreadsheetText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// formatClock trims seconds from Postgres TIME values ("19:00:00" -> "19:00")
+func formatClock(t string) string {
+	if len(t) > 5 {
+		return t[:5]
+	}
+	return t
+}
+
+type exportWriter interface {
+	WriteRow(values []interface{}) error
+	// Abort ends an export that failed halfway
+	Abort(err error)
+	Close() error
+}
+
+type csvExportWriter struct {
+	w    *csv.Writer
+	rows int
+}
+
+func newCSVExportWriter(out io.Writer) *csvExportWriter {
+	// UTF-8 BOM so that Excel opens Cyrillic names correctly
+	out.Write([]byte("\xEF\xBB\xBF"))
+	return &csvExportWriter{w: csv.NewWriter(out)}
+}
+
+func (cw *csvExportWriter) WriteRow(values []interface{}) error {
+	record := make([]string, len(values))
+	for i, v := range values {
+		record[i] = fmt.Sprint(v)
+	}
+	if err := cw.w.Write(record); err != nil {
+		return err
+	}
+
+	cw.rows++
+	if cw.rows%100 == 0 {
+		cw.w.Flush()
+	}
+	return cw.w.Error()
+}
+
+// Abort drops the rows still buffered; the dropped connection is what tells the
+// client the file is incomplete
+func (cw *csvExportWriter) Abort(error) {}
+
+func (cw *csvExportWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+type xlsxExportWriter struct {
+	out  io.Writer
+	file *excelize.File
+	sw   *excelize.StreamWriter
+	row  int
+}
+
+func newXLSXExportWriter(out io.Writer) (*xlsxExportWriter, error) {
+	f := excelize.NewFile()
+	if err := f.SetSheetName("Sheet1", "Bookings"); err != nil {
+		return nil, err
+	}
+	sw, err := f.NewStreamWriter("Bookings")
+	if err != nil {
+		return nil, err
+	}
+	return &xlsxExportWriter{out: out, file: f, sw: sw}, nil
+}
+
+func (xw *xlsxExportWriter) WriteRow(values []interface{}) error {
+	xw.row++
+	cell, err := excelize.CoordinatesToCellName(1, xw.row)
+	if err != nil {
+		return err
+	}
+	return xw.sw.SetRow(cell, values)
+}
+
+// Abort discards the workbook; nothing of it has been written yet
+func (xw *xlsxExportWriter) Abort(error) {
+	xw.file.Close()
+}
+
+func (xw *xlsxExportWriter) Close() error {
+	defer xw.file.Close()
+	if err := xw.sw.Flush(); err != nil {
+		return err
+	}
+	return xw.file.Write(xw.out)
+}
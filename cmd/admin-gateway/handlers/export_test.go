@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"google.golang.org/grpc"
+
+	bookingpb "booker/pkg/proto/booking"
+	commonpb "booker/pkg/proto/common"
+)
+
+// exportBookingClient streams the bookings, then err, or io.EOF if err is nil
+type exportBookingClient struct {
+	bookingpb.BookingServiceClient
+	bookings []*bookingpb.Booking
+	err      error
+}
+
+func (c *exportBookingClient) ExportBookings(ctx context.Context, _ *bookingpb.ExportBookingsRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[bookingpb.Booking], error) {
+	return &exportStream{bookings: c.bookings, err: c.err}, nil
+}
+
+type exportStream struct {
+	grpc.ClientStream
+	bookings []*bookingpb.Booking
+	err      error
+}
+
+func (s *exportStream) Recv() (*bookingpb.Booking, error) {
+	if len(s.bookings) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+	b := s.bookings[0]
+	s.bookings = s.bookings[1:]
+	return b, nil
+}
+
+func exportBookings(client *exportBookingClient, query string, rec *httptest.ResponseRecorder) error {
+	h := NewWithClients(nil, client, nil, nil, nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/bookings/export?"+query, nil)
+	return h.ExportBookings(echo.New().NewContext(req, rec))
+}
+
+func testExportBookings() []*bookingpb.Booking {
+	return []*bookingpb.Booking{
+		{
+			Id:           "b1",
+			Slot:         &commonpb.Slot{Date: "2026-03-06", StartTime: "19:00:00", DurationMinutes: 90},
+			Table:        &commonpb.TableRef{TableId: "t1"},
+			PartySize:    4,
+			CustomerName: "Анна",
+			Status:       "confirmed",
+		},
+		{
+			Id:           "b2",
+			Slot:         &commonpb.Slot{Date: "2026-03-06", StartTime: "20:30:00", DurationMinutes: 60},
+			PartySize:    2,
+			CustomerName: "Ivan, Jr.",
+			Status:       "held",
+		},
+	}
+}
+
+func TestExportBookings_CSV(t *testing.T) {
+	rec := httptest.NewRecorder()
+	require.NoError(t, exportBookings(&exportBookingClient{bookings: testExportBookings()}, "columns=id,date,start_time,party_size,customer_name", rec))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), ".csv")
+	assert.Equal(t, "\xEF\xBB\xBF"+
+		"ID,Date,Start,Guests,Name\n"+
+		"b1,2026-03-06,19:00,4,Анна\n"+
+		"b2,2026-03-06,20:30,2,\"Ivan, Jr.\"\n", rec.Body.String())
+}
+
+func TestExportBookings_XLSX(t *testing.T) {
+	rec := httptest.NewRecorder()
+	require.NoError(t, exportBookings(&exportBookingClient{bookings: testExportBookings()}, "format=xlsx&columns=id,party_size", rec))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	f, err := excelize.OpenReader(rec.Body)
+	require.NoError(t, err)
+	defer f.Close()
+	rows, err := f.GetRows("Bookings")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"ID", "Guests"}, {"b1", "4"}, {"b2", "2"}}, rows)
+}
+
+func TestExportBookings_Interrupted(t *testing.T) {
+	failing := func() *exportBookingClient {
+		return &exportBookingClient{bookings: testExportBookings(), err: errors.New("booking-svc unavailable")}
+	}
+
+	t.Run("csv drops the connection", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			exportBookings(failing(), "columns=id", rec)
+		})
+		assert.NotContains(t, rec.Body.String(), "booking-svc unavailable", "no error row is mixed into the data")
+	})
+
+	t.Run("xlsx fails before anything is sent", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		require.NoError(t, exportBookings(failing(), "format=xlsx", rec))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Empty(t, rec.Header().Get(echo.HeaderContentDisposition))
+		assert.Contains(t, rec.Body.String(), "booking-svc unavailable")
+	})
+}
+
+func TestExportBookings_FormulaInjection(t *testing.T) {
+	bookings := []*bookingpb.Booking{{
+		Id:            "b1",
+		CustomerName:  "=HYPERLINK(\"http://evil\")",
+		CustomerPhone: "+79161234567",
+		Comment:       "@SUM(A1)",
+	}}
+
+	rec := httptest.NewRecorder()
+	require.NoError(t, exportBookings(&exportBookingClient{bookings: bookings}, "columns=customer_name,customer_phone,comment", rec))
+	assert.Equal(t, "\xEF\xBB\xBF"+
+		"Name,Phone,Comment\n"+
+		"\"'=HYPERLINK(\"\"http://evil\"\")\",+79161234567,'@SUM(A1)\n", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	require.NoError(t, exportBookings(&exportBookingClient{bookings: bookings}, "format=xlsx&columns=customer_name,comment", rec))
+	f, err := excelize.OpenReader(rec.Body)
+	require.NoError(t, err)
+	defer f.Close()
+	rows, err := f.GetRows("Bookings")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"'=HYPERLINK(\"http://evil\")", "'@SUM(A1)"}, rows[1])
+}
+
+func TestSpreadsheetText(t *testing.T) {
+	for _, s := range []string{"=1+1", "+7 916", "-2", "@A1", "\tx", "\rx"} {
+		assert.Equal(t, "'"+s, spreadsheetText(s), s)
+	}
+	for _, s := range []string{"", "Анна", "Ivan", "1+1", "a=b"} {
+		assert.Equal(t, s, spreadsheetText(s), s)
+	}
+}
+
+func TestParseExportColumns(t *testing.T) {
+	columns, err := parseExportColumns("")
+	require.NoError(t, err)
+	assert.Equal(t, defaultExportColumns, columns)
+
+	columns, err = parseExportColumns(" id, status ,,")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "status"}, columns)
+
+	_, err = parseExportColumns("id,password")
+	assert.Error(t, err)
+}
@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"booker/pkg/guestlink"
+	bookingpb "booker/pkg/proto/booking"
+	venuepb "booker/pkg/proto/venue"
+)
+
+// guestAdminID is the audit identity of actions guests take through their links
+const guestAdminID = "guest"
+
+// errGuestLinksDisabled is returned while GUEST_LINK_SECRET is not set: without it
+// anyone could sign links to any booking
+var errGuestLinksDisabled = errors.New("guest links are disabled, GUEST_LINK_SECRET is not set")
+
+// Actions a guest can take, and the booking statuses that allow them
+var guestActions = map[string][]string{
+	"confirm": {"held"},
+	"cancel":  {"held", "confirmed"},
+	"change":  {"held", "confirmed"},
+}
+
+// guestBookingView is what a guest sees of their booking. Token is a fresh link
+// token, as the one the guest came with stops working once the booking changes;
+// it expires when the guest's link does
+type guestBookingView struct {
+	ID              string   `json:"id"`
+	Status          string   `json:"status"`
+	VenueName       string   `json:"venue_name"`
+	VenueAddress    string   `json:"venue_address"`
+	Date            string   `json:"date"`
+	StartTime       string   `json:"start_time"`
+	DurationMinutes int32    `json:"duration_minutes"`
+	PartySize       int32    `json:"party_size"`
+	CustomerName    string   `json:"customer_name"`
+	Actions         []string `json:"actions"`
+	Token           string   `json:"token"`
+	URL             string   `json:"url"`
+	ExpiresAt       int64    `json:"expires_at"`
+}
+
+// GetGuestBooking shows a booking to the guest holding its link
+func (h *Handler) GetGuestBooking(c echo.Context) error {
+	booking, claims, code, err := h.guestBooking(c)
+	if err != nil {
+		return c.JSON(code, map[string]string{"error": err.Error()})
+	}
+	return h.guestBookingResponse(c, booking, claims.ExpiresAt)
+}
+
+// GuestConfirmBooking confirms a held booking on behalf of its guest
+func (h *Handler) GuestConfirmBooking(c echo.Context) error {
+	booking, claims, code, err := h.guestBookingFor(c, "confirm")
+	if err != nil {
+		return c.JSON(code, map[string]string{"error": err.Error()})
+	}
+
+	if _, err := h.bookingClient.ConfirmBooking(c.Request().Context(), &bookingpb.ConfirmBookingRequest{
+		Id:      booking.Id,
+		AdminId: guestAdminID,
+	}); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	log.Info().Str("booking_id", booking.Id).Msg("Booking confirmed by guest")
+	return h.reloadGuestBooking(c, booking.Id, claims.ExpiresAt)
+}
+
+// GuestCancelBooking cancels a booking on behalf of its guest
+func (h *Handler) GuestCancelBooking(c echo.Context) error {
+	var req struct {
+		Reason string `json:"reason"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	booking, claims, code, err := h.guestBookingFor(c, "cancel")
+	if err != nil {
+		return c.JSON(code, map[string]string{"error": err.Error()})
+	}
+
+	if _, err := h.bookingClient.CancelBooking(c.Request().Context(), &bookingpb.CancelBookingRequest{
+		Id:      booking.Id,
+		AdminId: guestAdminID,
+		Reason:  req.Reason,
+	}); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	log.Info().Str("booking_id", booking.Id).Msg("Booking cancelled by guest")
+	return h.reloadGuestBooking(c, booking.Id, claims.ExpiresAt)
+}
+
+// GuestRequestBookingChange passes a guest's request to change the booking on to
+// the venue's staff
+func (h *Handler) GuestRequestBookingChange(c echo.Context) error {
+	var req struct {
+		Message string `json:"message"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	if req.Message == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "message is required"})
+	}
+
+	booking, claims, code, err := h.guestBookingFor(c, "change")
+	if err != nil {
+		return c.JSON(code, map[string]string{"error": err.Error()})
+	}
+
+	if _, err := h.bookingClient.RequestBookingChange(c.Request().Context(), &bookingpb.RequestBookingChangeRequest{
+		Id:      booking.Id,
+		Message: req.Message,
+		AdminId: guestAdminID,
+	}); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return h.reloadGuestBooking(c, booking.Id, claims.ExpiresAt)
+}
+
+// guestBooking loads the booking of the link in the request. It fails if guest
+// links are disabled, or the link is forged, has expired or was issued before the
+// booking last changed
+func (h *Handler) guestBooking(c echo.Context) (*bookingpb.Booking, *guestlink.Claims, int, error) {
+	if h.cfg.GuestLinkSecret == "" {
+		return nil, nil, http.StatusServiceUnavailable, errGuestLinksDisabled
+	}
+	claims, err := guestlink.Parse(h.cfg.GuestLinkSecret, c.Param("token"), time.Now())
+	if errors.Is(err, guestlink.ErrExpired) {
+		return nil, nil, http.StatusGone, err
+	}
+	if err != nil {
+		return nil, nil, http.StatusForbidden, err
+	}
+
+	booking, err := h.bookingClient.GetBooking(c.Request().Context(), &bookingpb.GetBookingRequest{Id: claims.BookingID})
+	if err != nil {
+		return nil, nil, http.StatusNotFound, err
+	}
+	if booking.Sequence != claims.Sequence {
+		return nil, nil, http.StatusGone, errors.New("the booking has changed since this link was sent, use the link from the latest message")
+	}
+	return booking, claims, http.StatusOK, nil
+}
+
+// guestBookingFor loads the booking of the link and checks its status allows the action
+func (h *Handler) guestBookingFor(c echo.Context, action string) (*bookingpb.Booking, *guestlink.Claims, int, error) {
+	booking, claims, code, err := h.guestBooking(c)
+	if err != nil {
+		return nil, nil, code, err
+	}
+	if !slices.Contains(guestActions[action], booking.Status) {
+		return nil, nil, http.StatusConflict, errors.New("this cannot be done with a booking in status " + booking.Status)
+	}
+	return booking, claims, http.StatusOK, nil
+}
+
+func (h *Handler) reloadGuestBooking(c echo.Context, id string, expiresAt time.Time) error {
+	booking, err := h.bookingClient.GetBooking(c.Request().Context(), &bookingpb.GetBookingRequest{Id: id})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return h.guestBookingResponse(c, booking, expiresAt)
+}
+
+// guestBookingResponse shows the booking with a token for its current revision.
+// The token keeps the expiry of the link the guest came with, so using a link
+// never extends it
+func (h *Handler) guestBookingResponse(c echo.Context, booking *bookingpb.Booking, expiresAt time.Time) error {
+	venue, err := h.venueClient.GetVenue(c.Request().Context(), &venuepb.GetVenueRequest{Id: booking.VenueId})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	claims := &guestlink.Claims{
+		BookingID: booking.Id,
+		Sequence:  booking.Sequence,
+		ExpiresAt: expiresAt,
+	}
+	token := guestlink.Sign(h.cfg.GuestLinkSecret, claims)
+
+	actions := []string{}
+	for _, action := range []string{"confirm", "cancel", "change"} {
+		if slices.Contains(guestActions[action], booking.Status) {
+			actions = append(actions, action)
+		}
+	}
+
+	return c.JSON(http.StatusOK, &guestBookingView{
+		ID:              booking.Id,
+		Status:          booking.Status,
+		VenueName:       venue.Name,
+		VenueAddress:    venue.Address,
+		Date:            booking.Slot.GetDate(),
+		StartTime:       formatClock(booking.Slot.GetStartTime()),
+		DurationMinutes: booking.Slot.GetDurationMinutes(),
+		PartySize:       booking.PartySize,
+		CustomerName:    booking.CustomerName,
+		Actions:         actions,
+		Token:           token,
+		URL:             guestlink.URL(h.cfg.PublicURL, token),
+		ExpiresAt:       claims.ExpiresAt.Unix(),
+	})
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"booker/cmd/admin-gateway/config"
+	"booker/pkg/guestlink"
+	bookingpb "booker/pkg/proto/booking"
+	commonpb "booker/pkg/proto/common"
+	venuepb "booker/pkg/proto/venue"
+)
+
+type guestBookingClient struct {
+	bookingpb.BookingServiceClient
+	booking   *bookingpb.Booking
+	cancelled bool
+}
+
+func (c *guestBookingClient) GetBooking(context.Context, *bookingpb.GetBookingRequest, ...grpc.CallOption) (*bookingpb.Booking, error) {
+	return c.booking, nil
+}
+
+func (c *guestBookingClient) CancelBooking(context.Context, *bookingpb.CancelBookingRequest, ...grpc.CallOption) (*bookingpb.Booking, error) {
+	c.cancelled = true
+	return c.booking, nil
+}
+
+type guestVenueClient struct {
+	venuepb.VenueServiceClient
+}
+
+func (c *guestVenueClient) GetVenue(context.Context, *venuepb.GetVenueRequest, ...grpc.CallOption) (*venuepb.Venue, error) {
+	return &venuepb.Venue{Id: "venue-1", Name: "Bistro"}, nil
+}
+
+func newGuestHandler(secret string, booking *guestBookingClient) *Handler {
+	return NewWithClients(&guestVenueClient{}, booking, nil, nil, &config.Config{GuestLinkSecret: secret, PublicURL: "https://booker.example"})
+}
+
+func guestRequest(h *Handler, handler func(*Handler, echo.Context) error, method, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/api/v1/guest/bookings/"+token, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("token")
+	c.SetParamValues(token)
+	handler(h, c)
+	return rec
+}
+
+func testGuestBooking() *bookingpb.Booking {
+	return &bookingpb.Booking{
+		Id:       "b1",
+		VenueId:  "venue-1",
+		Status:   "confirmed",
+		Sequence: 3,
+		Slot:     &commonpb.Slot{Date: "2099-03-06", StartTime: "19:00:00", DurationMinutes: 90},
+	}
+}
+
+func TestGetGuestBooking_KeepsLinkExpiry(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	token := guestlink.Sign("secret", &guestlink.Claims{BookingID: "b1", Sequence: 3, ExpiresAt: expiresAt})
+	h := newGuestHandler("secret", &guestBookingClient{booking: testGuestBooking()})
+
+	rec := guestRequest(h, (*Handler).GetGuestBooking, http.MethodGet, token, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var view guestBookingView
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &view))
+	assert.Equal(t, expiresAt.Unix(), view.ExpiresAt, "viewing a link must not extend it")
+	claims, err := guestlink.Parse("secret", view.Token, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, expiresAt.Unix(), claims.ExpiresAt.Unix())
+}
+
+func TestGuestBooking_DisabledWithoutSecret(t *testing.T) {
+	token := guestlink.Sign("", &guestlink.Claims{BookingID: "b1", Sequence: 3, ExpiresAt: time.Now().Add(time.Hour)})
+	booking := &guestBookingClient{booking: testGuestBooking()}
+	h := newGuestHandler("", booking)
+
+	rec := guestRequest(h, (*Handler).GetGuestBooking, http.MethodGet, token, "")
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	rec = guestRequest(h, (*Handler).GuestCancelBooking, http.MethodPost, token, `{}`)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.False(t, booking.cancelled, "a link signed without a secret must not cancel anything")
+}
+
+func TestGuestCancelBooking_InvalidBody(t *testing.T) {
+	token := guestlink.Sign("secret", &guestlink.Claims{BookingID: "b1", Sequence: 3, ExpiresAt: time.Now().Add(time.Hour)})
+	booking := &guestBookingClient{booking: testGuestBooking()}
+	h := newGuestHandler("secret", booking)
+
+	rec := guestRequest(h, (*Handler).GuestCancelBooking, http.MethodPost, token, `{"reason":`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.False(t, booking.cancelled)
+}
@@ -7,6 +7,7 @@ import (
 	"booker/cmd/admin-gateway/config"
 	"booker/cmd/admin-gateway/middleware"
 	bookingpb "booker/pkg/proto/booking"
+	notifypb "booker/pkg/proto/notify"
 	venuepb "booker/pkg/proto/venue"
 	"booker/pkg/redis"
 )
@@ -14,24 +15,27 @@ import (
 type Handler struct {
 	venueClient   venuepb.VenueServiceClient
 	bookingClient bookingpb.BookingServiceClient
+	notifyClient  notifypb.NotifyServiceClient
 	redisClient   *redis.Client
 	cfg           *config.Config
 }
 
-func New(venueConn, bookingConn *grpc.ClientConn, redisClient *redis.Client, cfg *config.Config) *Handler {
+func New(venueConn, bookingConn, notifyConn *grpc.ClientConn, redisClient *redis.Client, cfg *config.Config) *Handler {
 	return &Handler{
 		venueClient:   venuepb.NewVenueServiceClient(venueConn),
 		bookingClient: bookingpb.NewBookingServiceClient(bookingConn),
+		notifyClient:  notifypb.NewNotifyServiceClient(notifyConn),
 		redisClient:   redisClient,
 		cfg:           cfg,
 	}
 }
 
 // NewWithClients создает Handler с готовыми клиентами (для тестов)
-func NewWithClients(venueClient venuepb.VenueServiceClient, bookingClient bookingpb.BookingServiceClient, redisClient *redis.Client, cfg *config.Config) *Handler {
+func NewWithClients(venueClient venuepb.VenueServiceClient, bookingClient bookingpb.BookingServiceClient, notifyClient notifypb.NotifyServiceClient, redisClient *redis.Client, cfg *config.Config) *Handler {
 	return &Handler{
 		venueClient:   venueClient,
 		bookingClient: bookingClient,
+		notifyClient:  notifyClient,
 		redisClient:   redisClient,
 		cfg:           cfg,
 	}
@@ -80,6 +84,8 @@ func (h *Handler) SetupRoutes(mw *middleware.Middleware) *echo.Echo {
 	protected.POST("/venues", h.CreateVenue)
 	protected.PUT("/venues/:id", h.UpdateVenue)
 	protected.DELETE("/venues/:id", h.DeleteVenue)
+	protected.POST("/venues/:id/restore", h.RestoreVenue)
+	protected.GET("/venues/:id/ical-link", h.GetVenueICalLink)
 
 	// Rooms
 	protected.GET("/venues/:venueId/rooms", h.ListRooms)
@@ -87,6 +93,9 @@ func (h *Handler) SetupRoutes(mw *middleware.Middleware) *echo.Echo {
 	protected.POST("/venues/:venueId/rooms", h.CreateRoom)
 	protected.PUT("/rooms/:id", h.UpdateRoom)
 	protected.DELETE("/rooms/:id", h.DeleteRoom)
+	protected.POST("/rooms/:id/restore", h.RestoreRoom)
+	protected.GET("/rooms/:id/layout", h.GetRoomLayout)
+	protected.PUT("/rooms/:id/layout", h.SaveRoomLayout)
 
 	// Tables
 	protected.GET("/rooms/:roomId/tables", h.ListTables)
@@ -94,14 +103,35 @@ func (h *Handler) SetupRoutes(mw *middleware.Middleware) *echo.Echo {
 	protected.POST("/rooms/:roomId/tables", h.CreateTable)
 	protected.PUT("/tables/:id", h.UpdateTable)
 	protected.DELETE("/tables/:id", h.DeleteTable)
+	protected.POST("/tables/:id/restore", h.RestoreTable)
+	protected.GET("/tables/:id/ical-link", h.GetTableICalLink)
+
+	// Table blocks
+	protected.GET("/venues/:venueId/table-blocks", h.ListTableBlocks)
+	protected.POST("/tables/:id/blocks", h.CreateTableBlock)
+	protected.DELETE("/table-blocks/:id", h.DeleteTableBlock)
+
+	// Table combinations
+	protected.GET("/rooms/:roomId/combinations", h.ListTableCombinations)
+	protected.POST("/rooms/:roomId/combinations", h.CreateTableCombination)
+	protected.DELETE("/combinations/:id", h.DeleteTableCombination)
 
 	// Schedule
 	protected.GET("/venues/:venueId/schedule", h.GetOpeningHours)
 	protected.POST("/venues/:venueId/schedule", h.SetOpeningHours)
 	protected.POST("/venues/:venueId/special-hours", h.SetSpecialHours)
+	protected.POST("/holidays/import", h.ImportHolidays)
+	protected.GET("/venues/:venueId/turn-times", h.GetTurnTimeRules)
+	protected.PUT("/venues/:venueId/turn-times", h.SetTurnTimeRules)
+	protected.GET("/venues/:venueId/pacing", h.GetPacingRules)
+	protected.PUT("/venues/:venueId/pacing", h.SetPacingRules)
 
 	// Bookings
 	protected.GET("/bookings", h.ListBookings)
+	protected.GET("/bookings/search", h.SearchBookings)
+	protected.GET("/bookings/export", h.ExportBookings)
+	protected.GET("/bookings/attention", h.ListAttentionBookings)
+	protected.POST("/bookings/import", h.ImportBookings)
 	protected.GET("/bookings/:id", h.GetBooking)
 	protected.POST("/bookings", h.CreateBooking)
 	protected.POST("/bookings/:id/confirm", h.ConfirmBooking)
@@ -109,13 +139,54 @@ func (h *Handler) SetupRoutes(mw *middleware.Middleware) *echo.Echo {
 	protected.POST("/bookings/:id/seat", h.MarkSeated)
 	protected.POST("/bookings/:id/finish", h.MarkFinished)
 	protected.POST("/bookings/:id/no-show", h.MarkNoShow)
+	protected.POST("/bookings/:id/attention/clear", h.ClearBookingAttention)
+
+	// Room hires
+	protected.GET("/room-hires", h.ListRoomHires)
+	protected.GET("/room-hires/:id", h.GetRoomHire)
+	protected.POST("/rooms/:roomId/hires", h.CreateRoomHire)
+	protected.POST("/room-hires/:id/confirm", h.ConfirmRoomHire)
+	protected.POST("/room-hires/:id/cancel", h.CancelRoomHire)
+	protected.POST("/room-hires/:id/complete", h.CompleteRoomHire)
+
+	// Notifications
+	protected.GET("/venues/:venueId/notification-channels", h.GetNotificationChannels)
+	protected.PUT("/venues/:venueId/notification-channels", h.SetNotificationChannels)
+	protected.GET("/venues/:venueId/reminders", h.GetReminderSettings)
+	protected.PUT("/venues/:venueId/reminders", h.SetReminderSettings)
+	protected.GET("/notifications/reminders", h.ListReminders)
+	protected.GET("/notifications/deliveries", h.ListNotificationDeliveries)
+	protected.GET("/notifications/templates", h.ListNotificationTemplates)
+	protected.PUT("/notifications/templates", h.SetNotificationTemplate)
+	protected.DELETE("/notifications/templates", h.DeleteNotificationTemplate)
+	protected.POST("/notifications/templates/preview", h.PreviewNotificationTemplate)
+
+	// Webhooks
+	protected.GET("/venues/:venueId/webhooks", h.ListWebhooks)
+	protected.POST("/venues/:venueId/webhooks", h.CreateWebhook)
+	protected.PUT("/webhooks/:id", h.UpdateWebhook)
+	protected.DELETE("/webhooks/:id", h.DeleteWebhook)
+	protected.POST("/webhooks/:id/ping", h.PingWebhook)
+	protected.GET("/notifications/webhook-deliveries", h.ListWebhookDeliveries)
+	protected.POST("/notifications/webhook-deliveries/:id/replay", h.ReplayWebhookDelivery)
 
 	// Availability
 	protected.POST("/availability/check", h.CheckAvailability)
+	protected.GET("/venues/:venueId/slots", h.SearchSlots)
 
 	// WebSocket
 	protected.GET("/ws", h.WebSocket)
 
+	// iCalendar feeds - public, authorized by a signed token in the URL
+	e.GET("/ical/venues/:file", h.VenueICalFeed)
+	e.GET("/ical/tables/:file", h.TableICalFeed)
+
+	// Guest self-service - public, authorized by a signed token in the URL
+	api.GET("/guest/bookings/:token", h.GetGuestBooking)
+	api.POST("/guest/bookings/:token/confirm", h.GuestConfirmBooking)
+	api.POST("/guest/bookings/:token/cancel", h.GuestCancelBooking)
+	api.POST("/guest/bookings/:token/change", h.GuestRequestBookingChange)
+
 	// Static files - serve frontend (register AFTER API routes to avoid conflicts)
 	// This will serve index.html on root path and all other static assets
 	e.Static("/", "web/dist")
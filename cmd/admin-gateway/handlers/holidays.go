@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"booker/pkg/ical"
+	venuepb "booker/pkg/proto/venue"
+)
+
+// maxHolidaySpanDays caps all-day events so a malformed DTEND cannot expand into years of dates
+const maxHolidaySpanDays = 31
+
+// ImportHolidays writes holidays into special hours of the venues listed in
+// venue_ids. Holidays come from an .ics file (multipart field "file" or raw
+// request body) or, without a file, from the built-in calendar of country for
+// year. is_closed or open_time/close_time is the rule applied to every holiday;
+// with dry_run=true only the changes are returned
+func (h *Handler) ImportHolidays(c echo.Context) error {
+	var venueIDs []string
+	for _, id := range strings.Split(c.QueryParam("venue_ids"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			venueIDs = append(venueIDs, id)
+		}
+	}
+	if len(venueIDs) == 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "venue_ids is required"})
+	}
+	year, _ := strconv.Atoi(c.QueryParam("year"))
+	isClosed, _ := strconv.ParseBool(c.QueryParam("is_closed"))
+	dryRun, _ := strconv.ParseBool(c.QueryParam("dry_run"))
+
+	var src io.Reader = c.Request().Body
+	if file, err := c.FormFile("file"); err == nil {
+		f, err := file.Open()
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		defer f.Close()
+		src = f
+	}
+
+	data, err := io.ReadAll(io.LimitReader(src, maxImportFileSize+1))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	if len(data) > maxImportFileSize {
+		return c.JSON(http.StatusRequestEntityTooLarge, map[string]string{"error": "file is too large"})
+	}
+
+	var holidays []*venuepb.Holiday
+	if len(bytes.TrimSpace(data)) > 0 {
+		events, err := ical.Parse(bytes.NewReader(data))
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		holidays = holidaysFromEvents(events)
+		if len(holidays) == 0 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "calendar has no events"})
+		}
+	} else if c.QueryParam("country") == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "either an .ics file or country is required"})
+	}
+
+	resp, err := h.venueClient.ImportHolidays(c.Request().Context(), &venuepb.ImportHolidaysRequest{
+		VenueIds:  venueIDs,
+		Holidays:  holidays,
+		Country:   c.QueryParam("country"),
+		Year:      int32(year),
+		IsClosed:  isClosed,
+		OpenTime:  c.QueryParam("open_time"),
+		CloseTime: c.QueryParam("close_time"),
+		DryRun:    dryRun,
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// holidaysFromEvents turns calendar events into holiday dates. An all-day event
+// covers every day up to its exclusive end, a timed one only the day it starts on
+func holidaysFromEvents(events []*ical.Event) []*venuepb.Holiday {
+	var holidays []*venuepb.Holiday
+	for _, e := range events {
+		if e.Status == "CANCELLED" {
+			continue
+		}
+		if !e.AllDay {
+			holidays = append(holidays, &venuepb.Holiday{Date: e.Start.Format("2006-01-02"), Name: e.Summary})
+			continue
+		}
+		for d, n := e.Start, 0; d.Before(e.End) && n < maxHolidaySpanDays; d, n = d.AddDate(0, 0, 1), n+1 {
+			holidays = append(holidays, &venuepb.Holiday{Date: d.Format("2006-01-02"), Name: e.Summary})
+		}
+	}
+	return holidays
+}
@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"booker/pkg/ical"
+	bookingpb "booker/pkg/proto/booking"
+	venuepb "booker/pkg/proto/venue"
+)
+
+// errICalDisabled is returned while ICAL_SECRET is not set: without it anyone
+// could sign feed URLs
+const errICalDisabled = "iCalendar feeds are disabled, ICAL_SECRET is not set"
+
+const (
+	icalPastDays            = 7
+	icalFutureDays          = 90
+	icalDefaultDurationMins = 120
+)
+
+// VenueICalFeed renders all bookings of a venue as an iCalendar feed
+func (h *Handler) VenueICalFeed(c echo.Context) error {
+	venueID, ok := strings.CutSuffix(c.Param("file"), ".ics")
+	if !ok {
+		return c.NoContent(http.StatusNotFound)
+	}
+	if !h.validICalToken("venue", venueID, c.QueryParam("token")) {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "invalid token"})
+	}
+
+	venue, err := h.venueClient.GetVenue(c.Request().Context(), &venuepb.GetVenueRequest{Id: venueID})
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	return h.writeICalFeed(c, venue, "", venue.Name)
+}
+
+// TableICalFeed renders bookings of a single table as an iCalendar feed
+func (h *Handler) TableICalFeed(c echo.Context) error {
+	tableID, ok := strings.CutSuffix(c.Param("file"), ".ics")
+	if !ok {
+		return c.NoContent(http.StatusNotFound)
+	}
+	if !h.validICalToken("table", tableID, c.QueryParam("token")) {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "invalid token"})
+	}
+
+	ctx := c.Request().Context()
+	table, err := h.venueClient.GetTable(ctx, &venuepb.GetTableRequest{Id: tableID})
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+	room, err := h.venueClient.GetRoom(ctx, &venuepb.GetRoomRequest{Id: table.RoomId})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	venue, err := h.venueClient.GetVenue(ctx, &venuepb.GetVenueRequest{Id: room.VenueId})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return h.writeICalFeed(c, venue, tableID, fmt.Sprintf("%s - %s", venue.Name, table.Name))
+}
+
+// GetVenueICalLink returns a signed feed URL for a venue
+func (h *Handler) GetVenueICalLink(c echo.Context) error {
+	id := c.Param("id")
+	if h.cfg.ICalSecret == "" {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": errICalDisabled})
+	}
+	return c.JSON(http.StatusOK, map[string]string{
+		"url": fmt.Sprintf("%s/ical/venues/%s.ics?token=%s", h.cfg.PublicURL, id, h.icalToken("venue", id)),
+	})
+}
+
+// GetTableICalLink returns a signed feed URL for a table
+func (h *Handler) GetTableICalLink(c echo.Context) error {
+	id := c.Param("id")
+	if h.cfg.ICalSecret == "" {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": errICalDisabled})
+	}
+	return c.JSON(http.StatusOK, map[string]string{
+		"url": fmt.Sprintf("%s/ical/tables/%s.ics?token=%s", h.cfg.PublicURL, id, h.icalToken("table", id)),
+	})
+}
+
+func (h *Handler) writeICalFeed(c echo.Context, venue *venuepb.Venue, tableID, name string) error {
+	ctx := c.Request().Context()
+
+	loc, err := time.LoadLocation(venue.Timezone)
+	if err != nil {
+		log.Warn().Err(err).Str("venue_id", venue.Id).Str("timezone", venue.Timezone).Msg("Unknown venue timezone, using UTC")
+		loc = time.UTC
+	}
+
+	tables, err := h.venueClient.ListTables(ctx, &venuepb.ListTablesRequest{
+		VenueId: venue.Id,
+		Limit:   1000,
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	tableNames := make(map[string]string, len(tables.Tables))
+	for _, t := range tables.Tables {
+		tableNames[t.Id] = t.Name
+	}
+
+	today := time.Now().In(loc)
+	stream, err := h.bookingClient.ExportBookings(ctx, &bookingpb.ExportBookingsRequest{
+		VenueId:  venue.Id,
+		TableId:  tableID,
+		DateFrom: today.AddDate(0, 0, -icalPastDays).Format("2006-01-02"),
+		DateTo:   today.AddDate(0, 0, icalFutureDays).Format("2006-01-02"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	cal := &ical.Calendar{
+		ProdID:   "-//Booker//Reservations//EN",
+		Name:     name,
+		Location: loc,
+	}
+	for {
+		booking, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		}
+
+		event, err := bookingToICalEvent(booking, loc, tableNames[booking.Table.GetTableId()])
+		if err != nil {
+			log.Warn().Err(err).Str("booking_id", booking.Id).Msg("Skipping booking in iCal feed")
+			continue
+		}
+		cal.Events = append(cal.Events, event)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "text/calendar; charset=utf-8")
+	c.Response().WriteHeader(http.StatusOK)
+	return cal.Write(c.Response())
+}
+
+func bookingToICalEvent(b *bookingpb.Booking, loc *time.Location, tableName string) (*ical.Event, error) {
+	start, err := time.ParseInLocation("2006-01-02 15:04", b.Slot.GetDate()+" "+formatClock(b.Slot.GetStartTime()), loc)
+	if err != nil {
+		return nil, err
+	}
+	duration := b.Slot.GetDurationMinutes()
+	if duration == 0 {
+		duration = icalDefaultDurationMins
+	}
+
+	summary := fmt.Sprintf("%s, %d guests", b.CustomerName, b.PartySize)
+	if tableName != "" {
+		summary = tableName + ": " + summary
+	}
+
+	var description []string
+	if b.CustomerPhone != "" {
+		description = append(description, "Phone: "+b.CustomerPhone)
+	}
+	if b.Comment != "" {
+		description = append(description, "Comment: "+b.Comment)
+	}
+	description = append(description, "Status: "+b.Status)
+
+	return &ical.Event{
+		UID:          b.Id + "@booker",
+		Sequence:     b.Sequence,
+		Start:        start,
+		End:          start.Add(time.Duration(duration) * time.Minute),
+		Summary:      summary,
+		Description:  strings.Join(description, "\n"),
+		Status:       icalStatus(b.Status),
+		LastModified: time.Unix(b.UpdatedAt, 0),
+	}, nil
+}
+
+func icalStatus(status string) string {
+	switch status {
+	case "held", "requested":
+		return "TENTATIVE"
+	case "cancelled", "expired", "rejected":
+		return "CANCELLED"
+	default:
+		return "CONFIRMED"
+	}
+}
+
+// icalToken signs a feed subject so that feed URLs can be shared with calendar
+// apps without a login. Rotating ICAL_SECRET revokes all issued links, and
+// without it no token is valid
+func (h *Handler) icalToken(kind, id string) string {
+	mac := hmac.New(sha256.New, []byte(h.cfg.ICalSecret))
+	mac.Write([]byte(kind + ":" + id))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (h *Handler) validICalToken(kind, id, token string) bool {
+	return h.cfg.ICalSecret != "" && token != "" && hmac.Equal([]byte(token), []byte(h.icalToken(kind, id)))
+}
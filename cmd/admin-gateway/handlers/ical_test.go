@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"booker/cmd/admin-gateway/config"
+)
+
+func TestValidICalToken(t *testing.T) {
+	h := NewWithClients(nil, nil, nil, nil, &config.Config{ICalSecret: "secret"})
+	token := h.icalToken("venue", "venue-1")
+
+	assert.True(t, h.validICalToken("venue", "venue-1", token))
+	assert.False(t, h.validICalToken("table", "venue-1", token), "tokens are bound to the kind of feed")
+	assert.False(t, h.validICalToken("venue", "venue-2", token))
+	assert.False(t, h.validICalToken("venue", "venue-1", ""))
+
+	unset := NewWithClients(nil, nil, nil, nil, &config.Config{})
+	assert.False(t, unset.validICalToken("venue", "venue-1", unset.icalToken("venue", "venue-1")), "no token is valid without a secret")
+}
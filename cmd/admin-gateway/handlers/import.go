@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bookingpb "booker/pkg/proto/booking"
+	commonpb "booker/pkg/proto/common"
+)
+
+const maxImportFileSize = 10 << 20
+
+// importColumnAliases maps accepted CSV headers (lower case) to import fields.
+// Headers of our own export are accepted too, so an export can be re-imported
+var importColumnAliases = map[string]string{
+	"external_ref":     "external_ref",
+	"date":             "date",
+	"start_time":       "start_time",
+	"start":            "start_time",
+	"duration_minutes": "duration_minutes",
+	"duration (min)":   "duration_minutes",
+	"table":            "table",
+	"table_id":         "table_id",
+	"table id":         "table_id",
+	"party_size":       "party_size",
+	"guests":           "party_size",
+	"customer_name":    "customer_name",
+	"name":             "customer_name",
+	"customer_phone":   "customer_phone",
+	"phone":            "customer_phone",
+	"comment":          "comment",
+}
+
+// ImportBookings accepts a CSV file (multipart field "file" or raw request body)
+// and imports its rows into a venue. With dry_run=true only a report is returned
+func (h *Handler) ImportBookings(c echo.Context) error {
+	venueID := c.QueryParam("venue_id")
+	if venueID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "venue_id is required"})
+	}
+	dryRun, _ := strconv.ParseBool(c.QueryParam("dry_run"))
+
+	var src io.Reader = c.Request().Body
+	if file, err := c.FormFile("file"); err == nil {
+		f, err := file.Open()
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		defer f.Close()
+		src = f
+	}
+
+	data, err := io.ReadAll(io.LimitReader(src, maxImportFileSize+1))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	if len(data) > maxImportFileSize {
+		return c.JSON(http.StatusRequestEntityTooLarge, map[string]string{"error": "file is too large"})
+	}
+
+	rows, invalid, err := parseImportCSV(data)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	adminID := c.Get("admin_id").(string)
+
+	resp, err := h.bookingClient.ImportBookings(c.Request().Context(), &bookingpb.ImportBookingsRequest{
+		VenueId: venueID,
+		Rows:    rows,
+		DryRun:  dryRun,
+		AdminId: adminID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.Aborted {
+			// A booking for the same tables was made during the import, nothing was imported
+			return c.JSON(http.StatusConflict, map[string]string{"error": status.Convert(err).Message()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	// Rows rejected while parsing never reach booking-svc, merge them into its report
+	resp.Results = append(resp.Results, invalid...)
+	resp.Failed += int32(len(invalid))
+	sort.SliceStable(resp.Results, func(i, j int) bool {
+		return resp.Results[i].Line < resp.Results[j].Line
+	})
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// parseImportCSV converts CSV records into import rows. Records with malformed
+// numbers are returned separately as invalid results with their line numbers
+func parseImportCSV(data []byte) ([]*bookingpb.ImportBookingRow, []*bookingpb.ImportBookingResult, error) {
+	data = bytes.TrimPrefix(data, []byte("\xEF\xBB\xBF"))
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = detectCSVDelimiter(data)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil, fmt.Errorf("file is empty")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		if field, ok := importColumnAliases[strings.ToLower(strings.TrimSpace(name))]; ok {
+			columns[field] = i
+		}
+	}
+	for _, required := range []string{"external_ref", "date", "start_time", "party_size", "customer_name"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("missing required column: %s", required)
+		}
+	}
+	_, hasTable := columns["table"]
+	_, hasTableID := columns["table_id"]
+	if !hasTable && !hasTableID {
+		return nil, nil, fmt.Errorf("missing required column: table or table_id")
+	}
+
+	var rows []*bookingpb.ImportBookingRow
+	var invalid []*bookingpb.ImportBookingResult
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		line, _ := r.FieldPos(0)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		if strings.Join(record, "") == "" {
+			continue
+		}
+
+		row := &bookingpb.ImportBookingRow{
+			Line:          int32(line),
+			ExternalRef:   field("external_ref"),
+			TableId:       field("table_id"),
+			TableName:     field("table"),
+			Slot:          &commonpb.Slot{Date: field("date"), StartTime: formatClock(field("start_time"))},
+			CustomerName:  field("customer_name"),
+			CustomerPhone: field("customer_phone"),
+			Comment:       field("comment"),
+		}
+
+		partySize, err := strconv.Atoi(field("party_size"))
+		if err != nil {
+			invalid = append(invalid, invalidImportRow(row, "party_size must be a number"))
+			continue
+		}
+		row.PartySize = int32(partySize)
+
+		if d := field("duration_minutes"); d != "" {
+			duration, err := strconv.Atoi(d)
+			if err != nil {
+				invalid = append(invalid, invalidImportRow(row, "duration_minutes must be a number"))
+				continue
+			}
+			row.Slot.DurationMinutes = int32(duration)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, invalid, nil
+}
+
+func invalidImportRow(row *bookingpb.ImportBookingRow, msg string) *bookingpb.ImportBookingResult {
+	return &bookingpb.ImportBookingResult{
+		Line:        row.Line,
+		ExternalRef: row.ExternalRef,
+		Status:      "invalid",
+		Error:       msg,
+	}
+}
+
+// detectCSVDelimiter picks ';' when the header uses it, which is what Excel
+// writes in locales with a decimal comma
+func detectCSVDelimiter(data []byte) rune {
+	header, _ := bufio.NewReader(bytes.NewReader(data)).ReadString('\n')
+	if strings.Count(header, ";") > strings.Count(header, ",") {
+		return ';'
+	}
+	return ','
+}
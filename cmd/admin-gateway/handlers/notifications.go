@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	notifypb "booker/pkg/proto/notify"
+)
+
+// Notification handlers
+func (h *Handler) GetNotificationChannels(c echo.Context) error {
+	resp, err := h.notifyClient.GetChannelSettings(c.Request().Context(), &notifypb.GetChannelSettingsRequest{
+		VenueId: c.Param("venueId"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SetNotificationChannels replaces the channels and locale of a venue; an empty list
+// or locale returns it to the defaults
+func (h *Handler) SetNotificationChannels(c echo.Context) error {
+	var req struct {
+		Channels []struct {
+			Channel   string `json:"channel"`
+			Enabled   bool   `json:"enabled"`
+			Recipient string `json:"recipient"`
+		} `json:"channels"`
+		Locale string `json:"locale"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	channels := make([]*notifypb.Channel, len(req.Channels))
+	for i, ch := range req.Channels {
+		channels[i] = &notifypb.Channel{Channel: ch.Channel, Enabled: ch.Enabled, Recipient: ch.Recipient}
+	}
+
+	resp, err := h.notifyClient.SetChannelSettings(c.Request().Context(), &notifypb.SetChannelSettingsRequest{
+		VenueId:  c.Param("venueId"),
+		Channels: channels,
+		Locale:   req.Locale,
+		AdminId:  c.Get("admin_id").(string),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) ListNotificationDeliveries(c echo.Context) error {
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	offset, _ := strconv.Atoi(c.QueryParam("offset"))
+
+	resp, err := h.notifyClient.ListDeliveries(c.Request().Context(), &notifypb.ListDeliveriesRequest{
+		VenueId:  c.QueryParam("venue_id"),
+		EventKey: c.QueryParam("event_key"),
+		Channel:  c.QueryParam("channel"),
+		Status:   c.QueryParam("status"),
+		Limit:    int32(limit),
+		Offset:   int32(offset),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// templateRequest identifies a message template and optionally carries its source
+type templateRequest struct {
+	VenueID   string `json:"venue_id"`
+	EventType string `json:"event_type"`
+	Channel   string `json:"channel"`
+	Locale    string `json:"locale"`
+	Subject   string `json:"subject"`
+	Body      string `json:"body"`
+	EventKey  string `json:"event_key"`
+}
+
+// ListNotificationTemplates returns the templates in effect; without venue_id the
+// service-wide ones
+func (h *Handler) ListNotificationTemplates(c echo.Context) error {
+	resp, err := h.notifyClient.ListTemplates(c.Request().Context(), &notifypb.ListTemplatesRequest{
+		VenueId:   c.QueryParam("venue_id"),
+		EventType: c.QueryParam("event_type"),
+		Channel:   c.QueryParam("channel"),
+		Locale:    c.QueryParam("locale"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) SetNotificationTemplate(c echo.Context) error {
+	var req templateRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	resp, err := h.notifyClient.SetTemplate(c.Request().Context(), &notifypb.SetTemplateRequest{
+		VenueId:   req.VenueID,
+		EventType: req.EventType,
+		Channel:   req.Channel,
+		Locale:    req.Locale,
+		Subject:   req.Subject,
+		Body:      req.Body,
+		AdminId:   c.Get("admin_id").(string),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeleteNotificationTemplate drops an override and returns the template now in effect
+func (h *Handler) DeleteNotificationTemplate(c echo.Context) error {
+	resp, err := h.notifyClient.DeleteTemplate(c.Request().Context(), &notifypb.DeleteTemplateRequest{
+		VenueId:   c.QueryParam("venue_id"),
+		EventType: c.QueryParam("event_type"),
+		Channel:   c.QueryParam("channel"),
+		Locale:    c.QueryParam("locale"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) PreviewNotificationTemplate(c echo.Context) error {
+	var req templateRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	resp, err := h.notifyClient.PreviewTemplate(c.Request().Context(), &notifypb.PreviewTemplateRequest{
+		VenueId:   req.VenueID,
+		EventType: req.EventType,
+		Channel:   req.Channel,
+		Locale:    req.Locale,
+		Subject:   req.Subject,
+		Body:      req.Body,
+		EventKey:  req.EventKey,
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetReminderSettings(c echo.Context) error {
+	resp, err := h.notifyClient.GetReminderSettings(c.Request().Context(), &notifypb.GetReminderSettingsRequest{
+		VenueId: c.Param("venueId"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SetReminderSettings sets how many minutes before the slot guests are reminded
+func (h *Handler) SetReminderSettings(c echo.Context) error {
+	var req struct {
+		OffsetMinutes []int32 `json:"offset_minutes"`
+		UseDefault    bool    `json:"use_default"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	resp, err := h.notifyClient.SetReminderSettings(c.Request().Context(), &notifypb.SetReminderSettingsRequest{
+		VenueId:       c.Param("venueId"),
+		OffsetMinutes: req.OffsetMinutes,
+		UseDefault:    req.UseDefault,
+		AdminId:       c.Get("admin_id").(string),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) ListReminders(c echo.Context) error {
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	offset, _ := strconv.Atoi(c.QueryParam("offset"))
+
+	resp, err := h.notifyClient.ListReminders(c.Request().Context(), &notifypb.ListRemindersRequest{
+		VenueId:   c.QueryParam("venue_id"),
+		BookingId: c.QueryParam("booking_id"),
+		Status:    c.QueryParam("status"),
+		Limit:     int32(limit),
+		Offset:    int32(offset),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	bookingpb "booker/pkg/proto/booking"
+	commonpb "booker/pkg/proto/common"
+)
+
+// Room hire handlers
+func (h *Handler) ListRoomHires(c echo.Context) error {
+	activeOnly, _ := strconv.ParseBool(c.QueryParam("active_only"))
+
+	resp, err := h.bookingClient.ListRoomHires(c.Request().Context(), &bookingpb.ListRoomHiresRequest{
+		VenueId:    c.QueryParam("venue_id"),
+		RoomId:     c.QueryParam("room_id"),
+		Date:       c.QueryParam("date"),
+		DateFrom:   c.QueryParam("date_from"),
+		DateTo:     c.QueryParam("date_to"),
+		Status:     c.QueryParam("status"),
+		ActiveOnly: activeOnly,
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetRoomHire(c echo.Context) error {
+	resp, err := h.bookingClient.GetRoomHire(c.Request().Context(), &bookingpb.GetRoomHireRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) CreateRoomHire(c echo.Context) error {
+	var req struct {
+		VenueID string `json:"venue_id"`
+		Slot    struct {
+			Date            string `json:"date"`
+			StartTime       string `json:"start_time"`
+			DurationMinutes int32  `json:"duration_minutes"`
+		} `json:"slot"`
+		EventName    string `json:"event_name"`
+		Headcount    int32  `json:"headcount"`
+		ContactName  string `json:"contact_name"`
+		ContactPhone string `json:"contact_phone"`
+		ContactEmail string `json:"contact_email"`
+		Comment      string `json:"comment"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	resp, err := h.bookingClient.CreateRoomHire(c.Request().Context(), &bookingpb.CreateRoomHireRequest{
+		VenueId: req.VenueID,
+		RoomId:  c.Param("roomId"),
+		Slot: &commonpb.Slot{
+			Date:            req.Slot.Date,
+			StartTime:       req.Slot.StartTime,
+			DurationMinutes: req.Slot.DurationMinutes,
+		},
+		EventName:    req.EventName,
+		Headcount:    req.Headcount,
+		ContactName:  req.ContactName,
+		ContactPhone: req.ContactPhone,
+		ContactEmail: req.ContactEmail,
+		Comment:      req.Comment,
+		AdminId:      c.Get("admin_id").(string),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, resp)
+}
+
+func (h *Handler) ConfirmRoomHire(c echo.Context) error {
+	resp, err := h.bookingClient.ConfirmRoomHire(c.Request().Context(), &bookingpb.ConfirmRoomHireRequest{
+		Id:      c.Param("id"),
+		AdminId: c.Get("admin_id").(string),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) CancelRoomHire(c echo.Context) error {
+	var req struct {
+		Reason string `json:"reason"`
+	}
+	c.Bind(&req)
+
+	resp, err := h.bookingClient.CancelRoomHire(c.Request().Context(), &bookingpb.CancelRoomHireRequest{
+		Id:      c.Param("id"),
+		AdminId: c.Get("admin_id").(string),
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) CompleteRoomHire(c echo.Context) error {
+	resp, err := h.bookingClient.CompleteRoomHire(c.Request().Context(), &bookingpb.CompleteRoomHireRequest{
+		Id:      c.Param("id"),
+		AdminId: c.Get("admin_id").(string),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	notifypb "booker/pkg/proto/notify"
+)
+
+// webhookRequest is the body of creating and updating a webhook
+type webhookRequest struct {
+	URL          string   `json:"url"`
+	Secret       string   `json:"secret"`
+	EventTypes   []string `json:"event_types"`
+	Enabled      *bool    `json:"enabled"`
+	Description  string   `json:"description"`
+	RotateSecret bool     `json:"rotate_secret"`
+}
+
+// enabled returns whether the webhook is to be enabled; it is unless said otherwise
+func (r *webhookRequest) enabled() bool {
+	return r.Enabled == nil || *r.Enabled
+}
+
+// Webhook handlers
+func (h *Handler) ListWebhooks(c echo.Context) error {
+	resp, err := h.notifyClient.ListWebhooks(c.Request().Context(), &notifypb.ListWebhooksRequest{
+		VenueId: c.Param("venueId"),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CreateWebhook subscribes a URL to events of a venue; without a secret one is
+// generated and returned
+func (h *Handler) CreateWebhook(c echo.Context) error {
+	var req webhookRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	resp, err := h.notifyClient.CreateWebhook(c.Request().Context(), &notifypb.CreateWebhookRequest{
+		VenueId:     c.Param("venueId"),
+		Url:         req.URL,
+		Secret:      req.Secret,
+		EventTypes:  req.EventTypes,
+		Enabled:     req.enabled(),
+		Description: req.Description,
+		AdminId:     c.Get("admin_id").(string),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, resp)
+}
+
+// UpdateWebhook replaces the settings of a webhook; the secret is kept unless a
+// new one is given or rotate_secret is set
+func (h *Handler) UpdateWebhook(c echo.Context) error {
+	var req webhookRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	resp, err := h.notifyClient.UpdateWebhook(c.Request().Context(), &notifypb.UpdateWebhookRequest{
+		Id:           c.Param("id"),
+		Url:          req.URL,
+		Secret:       req.Secret,
+		EventTypes:   req.EventTypes,
+		Enabled:      req.enabled(),
+		Description:  req.Description,
+		RotateSecret: req.RotateSecret,
+		AdminId:      c.Get("admin_id").(string),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) DeleteWebhook(c echo.Context) error {
+	resp, err := h.notifyClient.DeleteWebhook(c.Request().Context(), &notifypb.DeleteWebhookRequest{
+		Id:      c.Param("id"),
+		AdminId: c.Get("admin_id").(string),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// PingWebhook sends a test event to a webhook and returns the delivery
+func (h *Handler) PingWebhook(c echo.Context) error {
+	resp, err := h.notifyClient.PingWebhook(c.Request().Context(), &notifypb.PingWebhookRequest{
+		Id:      c.Param("id"),
+		AdminId: c.Get("admin_id").(string),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) ListWebhookDeliveries(c echo.Context) error {
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	offset, _ := strconv.Atoi(c.QueryParam("offset"))
+
+	resp, err := h.notifyClient.ListWebhookDeliveries(c.Request().Context(), &notifypb.ListWebhookDeliveriesRequest{
+		VenueId:   c.QueryParam("venue_id"),
+		WebhookId: c.QueryParam("webhook_id"),
+		EventType: c.QueryParam("event_type"),
+		Status:    c.QueryParam("status"),
+		Limit:     int32(limit),
+		Offset:    int32(offset),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ReplayWebhookDelivery queues a delivery to be sent again
+func (h *Handler) ReplayWebhookDelivery(c echo.Context) error {
+	resp, err := h.notifyClient.ReplayWebhookDelivery(c.Request().Context(), &notifypb.ReplayWebhookDeliveryRequest{
+		Id:      c.Param("id"),
+		AdminId: c.Get("admin_id").(string),
+	})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -28,6 +28,12 @@ func main() {
 	if cfg.Env == "development" {
 		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 	}
+	if cfg.ICalSecret == "" {
+		log.Warn().Msg("ICAL_SECRET is not set, iCalendar feeds are disabled")
+	}
+	if cfg.GuestLinkSecret == "" {
+		log.Warn().Msg("GUEST_LINK_SECRET is not set, guest links are disabled")
+	}
 
 	// Tracing
 	shutdown, err := tracing.InitTracer("admin-gateway", cfg.JaegerEndpoint)
@@ -58,8 +64,17 @@ func main() {
 	}
 	defer bookingConn.Close()
 
+	notifyConn, err := grpc.Dial(
+		cfg.GRPCNotifyAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to connect to notify service")
+	}
+	defer notifyConn.Close()
+
 	// Handlers
-	h := handlers.New(venueConn, bookingConn, redisClient, cfg)
+	h := handlers.New(venueConn, bookingConn, notifyConn, redisClient, cfg)
 
 	// Middleware
 	mw := middleware.New(redisClient, cfg)
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+
+	"booker/cmd/booking-svc/service"
+	"booker/pkg/kafka"
+	commonpb "booker/pkg/proto/common"
+)
+
+const venueEventsGroup = "booking-svc-venue-events"
+
+var venueEventTopics = []string{"table.layout.updated", "venue.schedule.updated"}
+
+// VenueEventHandler re-checks upcoming bookings when tables or opening hours change.
+// It is run by kafka.RetryHandler, which retries failed events through the retry topics
+type VenueEventHandler struct {
+	svc *service.Service
+}
+
+func (h *VenueEventHandler) Handle(ctx context.Context, message *sarama.ConsumerMessage) error {
+	event, err := kafka.DecodeVenueEvent(message.Value)
+	if err != nil {
+		return kafka.Permanent(err)
+	}
+
+	return h.handle(ctx, message.Topic, event)
+}
+
+func (h *VenueEventHandler) handle(ctx context.Context, topic string, event *commonpb.VenueEvent) error {
+	switch topic {
+	case "table.layout.updated":
+		return h.svc.HandleLayoutUpdated(ctx, event.VenueId, event.GetLayoutUpdated().GetTableIds())
+	case "venue.schedule.updated":
+		return h.svc.HandleScheduleUpdated(ctx, event.VenueId, event.GetScheduleUpdated().GetDate())
+	}
+	return nil
+}
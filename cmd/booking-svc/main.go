@@ -6,6 +6,7 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"slices"
 	"syscall"
 	"time"
 
@@ -106,6 +107,31 @@ func main() {
 	// Start expired holds worker
 	go svc.StartExpiredHoldsWorker(context.Background())
 
+	// Graceful shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Venue events consumer
+	venueEvents, err := kafka.NewRetryHandler(kafkaBrokers, venueEventsGroup, kafka.DefaultRetryStages, (&VenueEventHandler{svc: svc}).Handle)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create Kafka retry producer")
+	}
+	defer venueEvents.Close()
+	consumer, err := kafka.NewConsumer(kafkaBrokers, venueEventsGroup, venueEvents)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create Kafka consumer")
+	}
+	defer consumer.Close()
+	topics := slices.Concat(venueEventTopics, kafka.RetryTopics(venueEventTopics, kafka.DefaultRetryStages))
+	go func() {
+		for ctx.Err() == nil {
+			if err := consumer.Consume(ctx, topics); err != nil {
+				log.Error().Err(err).Msg("Venue events consumer error")
+				time.Sleep(retryDelay)
+			}
+		}
+	}()
+
 	// gRPC Server
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
 	if err != nil {
@@ -117,10 +143,6 @@ func main() {
 	)
 	bookingpb.RegisterBookingServiceServer(s, svc)
 
-	// Graceful shutdown
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-	defer stop()
-
 	go func() {
 		log.Info().Int("port", cfg.Port).Msg("Booking service started")
 		if err := s.Serve(lis); err != nil {
@@ -2,10 +2,14 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"booker/pkg/redis"
@@ -23,27 +27,150 @@ func New(db *pgxpool.Pool, redis *redis.Client) *Repository {
 	}
 }
 
+// ErrPacingLimit is returned when a pacing limit filled up after the availability check
+var ErrPacingLimit = errors.New("pacing limit reached")
+
+// ErrBookingOverlap is returned when the overlap trigger finds the tables taken,
+// buffers included
+var ErrBookingOverlap = errors.New("tables are already booked for this time")
+
+// overlapError turns the exclusion violation raised by the overlap trigger into ErrBookingOverlap
+func overlapError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23P01" {
+		return fmt.Errorf("%w: %s", ErrBookingOverlap, pgErr.Message)
+	}
+	return err
+}
+
 // Booking operations
-func (r *Repository) CreateBooking(ctx context.Context, booking *Booking) error {
-	_, err := r.db.Exec(ctx,
+
+// CreateBooking inserts a booking. With pacing limits the covers already starting in
+// each interval are counted under the venue and date lock of the overlap trigger, so
+// concurrent bookings cannot all take the last free covers
+func (r *Repository) CreateBooking(ctx context.Context, booking *Booking, pacing []PacingLimit) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if len(pacing) > 0 {
+		if _, err := tx.Exec(ctx,
+			`SELECT pg_advisory_xact_lock(hashtext($1 || ':' || $2::date::text))`,
+			booking.VenueID, booking.Date); err != nil {
+			return err
+		}
+		for _, limit := range pacing {
+			var covers, bookings int32
+			err := tx.QueryRow(ctx,
+				`SELECT COALESCE(SUM(party_size), 0)::INTEGER, COUNT(*)::INTEGER FROM bookings
+				 WHERE venue_id = $1 AND date = $2 AND status IN ('held', 'confirmed', 'seated')
+				   AND start_time >= $3::time AND start_time < $4::time`,
+				booking.VenueID, booking.Date, limit.IntervalStart, limit.IntervalEnd).Scan(&covers, &bookings)
+			if err != nil {
+				return err
+			}
+			if reason := limit.exceeded(covers, bookings, booking.PartySize); reason != "" {
+				return fmt.Errorf("%w: %s", ErrPacingLimit, reason)
+			}
+		}
+	}
+
+	_, err = tx.Exec(ctx,
 		`INSERT INTO bookings (id, venue_id, table_id, date, start_time, end_time, party_size, 
-		 customer_name, customer_phone, status, comment, admin_id, created_at, updated_at, expires_at)
-		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW(), $13)`,
+		 customer_name, customer_phone, status, comment, admin_id, created_at, updated_at, expires_at, combined_table_ids,
+		 duration_minutes, buffer_minutes)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW(), $13, $14, $15, $16)`,
 		booking.ID, booking.VenueID, booking.TableID, booking.Date, booking.StartTime, booking.EndTime,
 		booking.PartySize, booking.CustomerName, booking.CustomerPhone, booking.Status,
-		booking.Comment, booking.AdminID, booking.ExpiresAt)
-	return err
+		booking.Comment, booking.AdminID, booking.ExpiresAt, booking.combinedTableIDs(), booking.DurationMinutes,
+		booking.BufferMinutes)
+	if err != nil {
+		return overlapError(err)
+	}
+	return tx.Commit(ctx)
+}
+
+// GetBookingIDsByExternalRefs maps already imported external references of a venue to booking IDs
+func (r *Repository) GetBookingIDsByExternalRefs(ctx context.Context, venueID string, refs []string) (map[string]string, error) {
+	result := make(map[string]string)
+	if len(refs) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.db.Query(ctx,
+		`SELECT external_ref, id FROM bookings WHERE venue_id = $1 AND external_ref = ANY($2)`,
+		venueID, refs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ref, id string
+		if err := rows.Scan(&ref, &id); err != nil {
+			return nil, err
+		}
+		result[ref] = id
+	}
+
+	return result, rows.Err()
+}
+
+// ImportBookings inserts bookings in a single transaction. Bookings whose external
+// reference is already taken are skipped; the returned set holds IDs actually inserted
+func (r *Repository) ImportBookings(ctx context.Context, bookings []*Booking) (map[string]bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	inserted := make(map[string]bool, len(bookings))
+	for _, b := range bookings {
+		tag, err := tx.Exec(ctx,
+			`INSERT INTO bookings (id, venue_id, table_id, date, start_time, end_time, party_size,
+			 customer_name, customer_phone, status, comment, admin_id, external_ref, duration_minutes, buffer_minutes,
+			 created_at, updated_at)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, NOW(), NOW())
+			 ON CONFLICT (venue_id, external_ref) WHERE external_ref IS NOT NULL DO NOTHING`,
+			b.ID, b.VenueID, b.TableID, b.Date, b.StartTime, b.EndTime,
+			b.PartySize, b.CustomerName, b.CustomerPhone, b.Status,
+			b.Comment, b.AdminID, b.ExternalRef, b.DurationMinutes, b.BufferMinutes)
+		if err != nil {
+			return nil, fmt.Errorf("insert booking %s: %w", b.ExternalRef, overlapError(err))
+		}
+		if tag.RowsAffected() == 0 {
+			continue
+		}
+
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO booking_events (id, booking_id, type, payload_json, ts)
+			 VALUES ($1, $2, 'imported', jsonb_build_object('external_ref', $3::text), NOW())`,
+			uuid.New().String(), b.ID, b.ExternalRef); err != nil {
+			return nil, err
+		}
+		inserted[b.ID] = true
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return inserted, nil
 }
 
 func (r *Repository) GetBooking(ctx context.Context, id string) (*Booking, error) {
 	var b Booking
 	err := r.db.QueryRow(ctx,
 		`SELECT id, venue_id, table_id, date::text, start_time::text, end_time::text, party_size, customer_name,
-		 customer_phone, status, comment, admin_id, created_at, updated_at, expires_at
+		 customer_phone, status, comment, admin_id, created_at, updated_at, expires_at, sequence, combined_table_ids, duration_minutes, buffer_minutes,
+		 needs_attention, attention_reason
 		 FROM bookings WHERE id = $1`, id).
 		Scan(&b.ID, &b.VenueID, &b.TableID, &b.Date, &b.StartTime, &b.EndTime,
 			&b.PartySize, &b.CustomerName, &b.CustomerPhone, &b.Status,
-			&b.Comment, &b.AdminID, &b.CreatedAt, &b.UpdatedAt, &b.ExpiresAt)
+			&b.Comment, &b.AdminID, &b.CreatedAt, &b.UpdatedAt, &b.ExpiresAt, &b.Sequence, &b.CombinedTableIDs, &b.DurationMinutes, &b.BufferMinutes,
+			&b.NeedsAttention, &b.AttentionReason)
 	if err != nil {
 		return nil, err
 	}
@@ -51,6 +178,142 @@ func (r *Repository) GetBooking(ctx context.Context, id string) (*Booking, error
 }
 
 func (r *Repository) ListBookings(ctx context.Context, filters *BookingFilters) ([]*Booking, int32, error) {
+	whereClause, args := buildBookingWhere(filters)
+	argPos := len(args) + 1
+
+	var total int32
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM bookings %s", whereClause)
+	err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, filters.Limit, filters.Offset)
+	query := fmt.Sprintf(
+		`SELECT id, venue_id, table_id, date::text, start_time::text, end_time::text, party_size, customer_name,
+		 customer_phone, status, comment, admin_id, created_at, updated_at, expires_at, sequence, combined_table_ids, duration_minutes, buffer_minutes,
+		 needs_attention, attention_reason
+		 FROM bookings %s ORDER BY date, start_time LIMIT $%d OFFSET $%d`,
+		whereClause, argPos, argPos+1)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var bookings []*Booking
+	for rows.Next() {
+		var b Booking
+		if err := rows.Scan(&b.ID, &b.VenueID, &b.TableID, &b.Date, &b.StartTime, &b.EndTime,
+			&b.PartySize, &b.CustomerName, &b.CustomerPhone, &b.Status,
+			&b.Comment, &b.AdminID, &b.CreatedAt, &b.UpdatedAt, &b.ExpiresAt, &b.Sequence, &b.CombinedTableIDs, &b.DurationMinutes, &b.BufferMinutes,
+			&b.NeedsAttention, &b.AttentionReason); err != nil {
+			return nil, 0, err
+		}
+		bookings = append(bookings, &b)
+	}
+
+	return bookings, total, nil
+}
+
+// SearchBookings finds bookings by fuzzy guest name and/or partial normalized phone,
+// ranked by relevance. Name is expected in lower case, phone as digits only.
+func (r *Repository) SearchBookings(ctx context.Context, filters *SearchFilters) ([]*SearchResult, error) {
+	args := []interface{}{filters.VenueID, filters.DateFrom, filters.DateTo}
+	argPos := 4
+
+	match := []string{}
+	score := []string{}
+	if filters.Name != "" {
+		match = append(match, fmt.Sprintf(`($%d <%% lower(customer_name) OR lower(customer_name) LIKE '%%' || $%d || '%%' ESCAPE '\')`, argPos, argPos+1))
+		score = append(score, fmt.Sprintf("word_similarity($%d, lower(customer_name))", argPos))
+		args = append(args, filters.Name, escapeLike(filters.Name))
+		argPos += 2
+	}
+	if filters.Phone != "" {
+		match = append(match, fmt.Sprintf(`customer_phone_normalized LIKE '%%' || $%d || '%%' ESCAPE '\'`, argPos))
+		// A phone hit is a much stronger signal than a similar-looking name
+		score = append(score, fmt.Sprintf(`CASE WHEN customer_phone_normalized LIKE '%%' || $%d || '%%' ESCAPE '\' THEN 1.0 ELSE 0.0 END`, argPos))
+		args = append(args, escapeLike(filters.Phone))
+		argPos++
+	}
+	if len(match) == 0 {
+		return []*SearchResult{}, nil
+	}
+
+	args = append(args, filters.Limit)
+	query := fmt.Sprintf(
+		`SELECT id, venue_id, table_id, date::text, start_time::text, end_time::text, party_size, customer_name,
+		 customer_phone, status, comment, admin_id, created_at, updated_at, expires_at, sequence, combined_table_ids, duration_minutes, buffer_minutes,
+		 needs_attention, attention_reason, (%s)::real AS score
+		 FROM bookings
+		 WHERE venue_id = $1 AND date BETWEEN $2 AND $3 AND (%s)
+		 ORDER BY score DESC, date, start_time LIMIT $%d`,
+		strings.Join(score, " + "), strings.Join(match, " OR "), argPos)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*SearchResult
+	for rows.Next() {
+		var b Booking
+		var res SearchResult
+		if err := rows.Scan(&b.ID, &b.VenueID, &b.TableID, &b.Date, &b.StartTime, &b.EndTime,
+			&b.PartySize, &b.CustomerName, &b.CustomerPhone, &b.Status,
+			&b.Comment, &b.AdminID, &b.CreatedAt, &b.UpdatedAt, &b.ExpiresAt, &b.Sequence, &b.CombinedTableIDs, &b.DurationMinutes, &b.BufferMinutes,
+			&b.NeedsAttention, &b.AttentionReason, &res.Score); err != nil {
+			return nil, err
+		}
+		res.Booking = &b
+		results = append(results, &res)
+	}
+
+	return results, rows.Err()
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern, so user input matches literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// StreamBookings iterates over all bookings matching filters (ignoring Limit and Offset)
+// without loading them into memory, calling fn for each row
+func (r *Repository) StreamBookings(ctx context.Context, filters *BookingFilters, fn func(*Booking) error) error {
+	whereClause, args := buildBookingWhere(filters)
+	query := fmt.Sprintf(
+		`SELECT id, venue_id, table_id, date::text, start_time::text, end_time::text, party_size, customer_name,
+		 customer_phone, status, comment, admin_id, created_at, updated_at, expires_at, sequence, combined_table_ids, duration_minutes, buffer_minutes,
+		 needs_attention, attention_reason
+		 FROM bookings %s ORDER BY date, start_time, id`,
+		whereClause)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var b Booking
+		if err := rows.Scan(&b.ID, &b.VenueID, &b.TableID, &b.Date, &b.StartTime, &b.EndTime,
+			&b.PartySize, &b.CustomerName, &b.CustomerPhone, &b.Status,
+			&b.Comment, &b.AdminID, &b.CreatedAt, &b.UpdatedAt, &b.ExpiresAt, &b.Sequence, &b.CombinedTableIDs, &b.DurationMinutes, &b.BufferMinutes,
+			&b.NeedsAttention, &b.AttentionReason); err != nil {
+			return err
+		}
+		if err := fn(&b); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func buildBookingWhere(filters *BookingFilters) (string, []interface{}) {
 	where := []string{}
 	args := []interface{}{}
 	argPos := 1
@@ -65,66 +328,104 @@ func (r *Repository) ListBookings(ctx context.Context, filters *BookingFilters)
 		args = append(args, filters.Date)
 		argPos++
 	}
+	if filters.DateFrom != "" {
+		where = append(where, fmt.Sprintf("date >= $%d", argPos))
+		args = append(args, filters.DateFrom)
+		argPos++
+	}
+	if filters.DateTo != "" {
+		where = append(where, fmt.Sprintf("date <= $%d", argPos))
+		args = append(args, filters.DateTo)
+		argPos++
+	}
 	if filters.Status != "" {
 		where = append(where, fmt.Sprintf("status = $%d", argPos))
 		args = append(args, filters.Status)
 		argPos++
 	}
 	if filters.TableID != "" {
-		where = append(where, fmt.Sprintf("table_id = $%d", argPos))
+		where = append(where, fmt.Sprintf("(table_id = $%d OR $%d = ANY(combined_table_ids))", argPos, argPos))
 		args = append(args, filters.TableID)
 		argPos++
 	}
 
-	whereClause := ""
-	if len(where) > 0 {
-		whereClause = "WHERE " + fmt.Sprintf("%s", where[0])
-		for i := 1; i < len(where); i++ {
-			whereClause += " AND " + where[i]
-		}
+	if filters.NeedsAttention {
+		where = append(where, "needs_attention AND status IN ('held', 'confirmed')")
 	}
 
-	var total int32
-	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM bookings %s", whereClause)
-	err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total)
-	if err != nil {
-		return nil, 0, err
+	if len(where) == 0 {
+		return "", args
 	}
+	return "WHERE " + strings.Join(where, " AND "), args
+}
 
-	args = append(args, filters.Limit, filters.Offset)
-	query := fmt.Sprintf(
-		`SELECT id, venue_id, table_id, date::text, start_time::text, end_time::text, party_size, customer_name,
-		 customer_phone, status, comment, admin_id, created_at, updated_at, expires_at
-		 FROM bookings %s ORDER BY date, start_time LIMIT $%d OFFSET $%d`,
-		whereClause, argPos, argPos+1)
+func (r *Repository) UpdateBookingStatus(ctx context.Context, id, status string) error {
+	_, err := r.db.Exec(ctx,
+		`UPDATE bookings SET status = $1, sequence = sequence + 1, updated_at = NOW() WHERE id = $2`,
+		status, id)
+	return err
+}
 
-	rows, err := r.db.Query(ctx, query, args...)
+// FlagBooking marks a booking as needing attention and records why
+func (r *Repository) FlagBooking(ctx context.Context, id, reason string) error {
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return nil, 0, err
+		return err
 	}
-	defer rows.Close()
+	defer tx.Rollback(ctx)
 
-	var bookings []*Booking
-	for rows.Next() {
-		var b Booking
-		if err := rows.Scan(&b.ID, &b.VenueID, &b.TableID, &b.Date, &b.StartTime, &b.EndTime,
-			&b.PartySize, &b.CustomerName, &b.CustomerPhone, &b.Status,
-			&b.Comment, &b.AdminID, &b.CreatedAt, &b.UpdatedAt, &b.ExpiresAt); err != nil {
-			return nil, 0, err
-		}
-		bookings = append(bookings, &b)
+	if _, err := tx.Exec(ctx,
+		`UPDATE bookings SET needs_attention = TRUE, attention_reason = $1, updated_at = NOW() WHERE id = $2`,
+		reason, id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx,
+		`INSERT INTO booking_events (id, booking_id, type, payload_json, ts)
+		 VALUES ($1, $2, 'needs_attention', jsonb_build_object('reason', $3::text), NOW())`,
+		uuid.New().String(), id, reason); err != nil {
+		return err
 	}
 
-	return bookings, total, nil
+	return tx.Commit(ctx)
 }
 
-func (r *Repository) UpdateBookingStatus(ctx context.Context, id, status string) error {
+func (r *Repository) ClearBookingAttention(ctx context.Context, id string) error {
 	_, err := r.db.Exec(ctx,
-		`UPDATE bookings SET status = $1, updated_at = NOW() WHERE id = $2`,
-		status, id)
+		`UPDATE bookings SET needs_attention = FALSE, attention_reason = '', updated_at = NOW() WHERE id = $1`,
+		id)
 	return err
 }
 
+// ReassignBooking moves a booking to other tables and clears its attention flag.
+// The overlap trigger rejects the move if the new tables are taken
+func (r *Repository) ReassignBooking(ctx context.Context, id, tableID string, combinedTableIDs []string, bufferMinutes int32, reason string) error {
+	if combinedTableIDs == nil {
+		combinedTableIDs = []string{}
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx,
+		`UPDATE bookings SET table_id = $1, combined_table_ids = $2, buffer_minutes = $3,
+		 needs_attention = FALSE, attention_reason = '', sequence = sequence + 1, updated_at = NOW()
+		 WHERE id = $4`,
+		tableID, combinedTableIDs, bufferMinutes, id); err != nil {
+		return overlapError(err)
+	}
+	if _, err := tx.Exec(ctx,
+		`INSERT INTO booking_events (id, booking_id, type, payload_json, ts)
+		 VALUES ($1, $2, 'reassigned', jsonb_build_object('table_id', $3::text, 'combined_table_ids', $4::text[], 'reason', $5::text), NOW())`,
+		uuid.New().String(), id, tableID, combinedTableIDs, reason); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
 func (r *Repository) AddBookingEvent(ctx context.Context, bookingID, eventType string, payload []byte) error {
 	_, err := r.db.Exec(ctx,
 		`INSERT INTO booking_events (id, booking_id, type, payload_json, ts)
@@ -174,7 +475,8 @@ func (r *Repository) UpdateOutboxStatus(ctx context.Context, id, status string,
 func (r *Repository) GetExpiredHolds(ctx context.Context) ([]*Booking, error) {
 	rows, err := r.db.Query(ctx,
 		`SELECT id, venue_id, table_id, date::text, start_time::text, end_time::text, party_size, customer_name,
-		 customer_phone, status, comment, admin_id, created_at, updated_at, expires_at
+		 customer_phone, status, comment, admin_id, created_at, updated_at, expires_at, sequence, combined_table_ids, duration_minutes, buffer_minutes,
+		 needs_attention, attention_reason
 		 FROM bookings WHERE status = 'held' AND expires_at < NOW()`)
 	if err != nil {
 		return nil, err
@@ -186,7 +488,8 @@ func (r *Repository) GetExpiredHolds(ctx context.Context) ([]*Booking, error) {
 		var b Booking
 		if err := rows.Scan(&b.ID, &b.VenueID, &b.TableID, &b.Date, &b.StartTime, &b.EndTime,
 			&b.PartySize, &b.CustomerName, &b.CustomerPhone, &b.Status,
-			&b.Comment, &b.AdminID, &b.CreatedAt, &b.UpdatedAt, &b.ExpiresAt); err != nil {
+			&b.Comment, &b.AdminID, &b.CreatedAt, &b.UpdatedAt, &b.ExpiresAt, &b.Sequence, &b.CombinedTableIDs, &b.DurationMinutes, &b.BufferMinutes,
+			&b.NeedsAttention, &b.AttentionReason); err != nil {
 			return nil, err
 		}
 		bookings = append(bookings, &b)
@@ -195,40 +498,39 @@ func (r *Repository) GetExpiredHolds(ctx context.Context) ([]*Booking, error) {
 	return bookings, nil
 }
 
-// CheckTableAvailability checks if tables are available for a given slot
-func (r *Repository) CheckTableAvailability(ctx context.Context, venueID string, tableIDs []string, date, startTime, endTime string) (map[string]bool, error) {
+// CheckTableAvailability checks if tables are available for a given slot. A table is busy
+// while an existing booking with its buffer, or an active hire of its room, overlaps the
+// slot followed by the table's buffer from buffers; tables missing from buffers get none
+func (r *Repository) CheckTableAvailability(ctx context.Context, venueID string, tableIDs []string, buffers map[string]int32, date, startTime, endTime string) (map[string]bool, error) {
 	if len(tableIDs) == 0 {
 		return make(map[string]bool), nil
 	}
 
-	// Build query with IN clause
-	placeholders := make([]string, len(tableIDs))
-	args := []interface{}{venueID, date, startTime, endTime}
-	argPos := 5
-	for i := range tableIDs {
-		placeholders[i] = fmt.Sprintf("$%d", argPos)
-		argPos++
-	}
-	args = append(args, make([]interface{}, len(tableIDs))...)
+	tableBuffers := make([]int32, len(tableIDs))
 	for i, tableID := range tableIDs {
-		args[4+i] = tableID
+		tableBuffers[i] = buffers[tableID]
 	}
 
-	query := fmt.Sprintf(
-		`SELECT DISTINCT table_id FROM bookings 
-		 WHERE venue_id = $1 
-		   AND date = $2 
+	// A booking occupies its own table and every table combined with it,
+	// a room hire every table of the room
+	query := `SELECT DISTINCT req.table_id
+		 FROM unnest($5::text[], $6::int[]) AS req(table_id, buffer_minutes)
+		 JOIN bookings ON req.table_id = ANY(array_prepend(bookings.table_id::text, bookings.combined_table_ids))
+		 WHERE venue_id = $1
+		   AND date = $2
 		   AND status IN ('held', 'confirmed', 'seated')
-		   AND (
-		     (start_time <= $3 AND end_time > $3) OR
-		     (start_time < $4 AND end_time >= $4) OR
-		     (start_time >= $3 AND end_time <= $4)
-		   )
-		   AND table_id IN (%s)`,
-		fmt.Sprintf("%s", placeholders[0]))
-	for i := 1; i < len(placeholders); i++ {
-		query = query[:len(query)-1] + fmt.Sprintf(", %s)", placeholders[i])
-	}
+		   AND (EXTRACT(EPOCH FROM start_time) / 60)::INTEGER < booking_busy_until($3::time, $4::time, req.buffer_minutes)
+		   AND (EXTRACT(EPOCH FROM $3::time) / 60)::INTEGER < booking_busy_until(start_time, end_time, bookings.buffer_minutes)
+		 UNION
+		 SELECT req.table_id
+		 FROM unnest($5::text[], $6::int[]) AS req(table_id, buffer_minutes)
+		 JOIN room_hires ON req.table_id = ANY(room_hires.table_ids)
+		 WHERE venue_id = $1
+		   AND date = $2
+		   AND status IN ('tentative', 'confirmed')
+		   AND (EXTRACT(EPOCH FROM start_time) / 60)::INTEGER < booking_busy_until($3::time, $4::time, req.buffer_minutes)
+		   AND (EXTRACT(EPOCH FROM $3::time) / 60)::INTEGER < booking_busy_until(start_time, end_time, 0)`
+	args := []interface{}{venueID, date, startTime, endTime, tableIDs, tableBuffers}
 
 	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
@@ -254,6 +556,150 @@ func (r *Repository) CheckTableAvailability(ctx context.Context, venueID string,
 	return result, nil
 }
 
+// Room hire operations
+const roomHireColumns = `id, venue_id, room_id, table_ids, date::text, start_time::text, end_time::text, duration_minutes,
+		 event_name, headcount, contact_name, contact_phone, contact_email, status, comment, admin_id, cancel_reason,
+		 created_at, updated_at`
+
+func scanRoomHire(row pgx.Row) (*RoomHire, error) {
+	var h RoomHire
+	err := row.Scan(&h.ID, &h.VenueID, &h.RoomID, &h.TableIDs, &h.Date, &h.StartTime, &h.EndTime, &h.DurationMinutes,
+		&h.EventName, &h.Headcount, &h.ContactName, &h.ContactPhone, &h.ContactEmail, &h.Status, &h.Comment, &h.AdminID,
+		&h.CancelReason, &h.CreatedAt, &h.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+// CreateRoomHire stores a hire. The overlap trigger rejects it if the room's tables
+// are booked or the room is already hired for the slot
+func (r *Repository) CreateRoomHire(ctx context.Context, h *RoomHire) error {
+	tableIDs := h.TableIDs
+	if tableIDs == nil {
+		tableIDs = []string{}
+	}
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO room_hires (id, venue_id, room_id, table_ids, date, start_time, end_time, duration_minutes,
+		 event_name, headcount, contact_name, contact_phone, contact_email, status, comment, admin_id, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, NOW(), NOW())`,
+		h.ID, h.VenueID, h.RoomID, tableIDs, h.Date, h.StartTime, h.EndTime, h.DurationMinutes,
+		h.EventName, h.Headcount, h.ContactName, h.ContactPhone, h.ContactEmail, h.Status, h.Comment, h.AdminID)
+	return err
+}
+
+func (r *Repository) GetRoomHire(ctx context.Context, id string) (*RoomHire, error) {
+	return scanRoomHire(r.db.QueryRow(ctx,
+		`SELECT `+roomHireColumns+` FROM room_hires WHERE id = $1`, id))
+}
+
+func (r *Repository) ListRoomHires(ctx context.Context, filters *RoomHireFilters) ([]*RoomHire, error) {
+	where := []string{}
+	args := []interface{}{}
+	add := func(cond string, value interface{}) {
+		args = append(args, value)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+	if filters.VenueID != "" {
+		add("venue_id = $%d", filters.VenueID)
+	}
+	if filters.RoomID != "" {
+		add("room_id = $%d", filters.RoomID)
+	}
+	if filters.Date != "" {
+		add("date = $%d", filters.Date)
+	}
+	if filters.DateFrom != "" {
+		add("date >= $%d", filters.DateFrom)
+	}
+	if filters.DateTo != "" {
+		add("date <= $%d", filters.DateTo)
+	}
+	if filters.Status != "" {
+		add("status = $%d", filters.Status)
+	}
+	if filters.ActiveOnly {
+		where = append(where, "status IN ('tentative', 'confirmed')")
+	}
+
+	whereClause := ""
+	if len(where) > 0 {
+		whereClause = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	rows, err := r.db.Query(ctx,
+		fmt.Sprintf(`SELECT %s FROM room_hires %s ORDER BY date, start_time, id`, roomHireColumns, whereClause),
+		args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hires []*RoomHire
+	for rows.Next() {
+		h, err := scanRoomHire(rows)
+		if err != nil {
+			return nil, err
+		}
+		hires = append(hires, h)
+	}
+
+	return hires, rows.Err()
+}
+
+// UpdateRoomHireStatus moves a hire through its lifecycle; cancelReason is kept only for cancellations
+func (r *Repository) UpdateRoomHireStatus(ctx context.Context, id, status, cancelReason string) error {
+	_, err := r.db.Exec(ctx,
+		`UPDATE room_hires SET status = $1, cancel_reason = $2, updated_at = NOW() WHERE id = $3`,
+		status, cancelReason, id)
+	return err
+}
+
+// UpdateRoomHireTables replaces the tables a hire occupies after its room's layout changed
+func (r *Repository) UpdateRoomHireTables(ctx context.Context, id string, tableIDs []string) error {
+	if tableIDs == nil {
+		tableIDs = []string{}
+	}
+	_, err := r.db.Exec(ctx,
+		`UPDATE room_hires SET table_ids = $1, updated_at = NOW() WHERE id = $2`,
+		tableIDs, id)
+	return err
+}
+
+// ListBookingsOverlapping returns IDs of active bookings that occupy any of the tables
+// during the given time, buffers included
+func (r *Repository) ListBookingsOverlapping(ctx context.Context, venueID string, tableIDs []string, date, startTime, endTime string) ([]string, error) {
+	if len(tableIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.db.Query(ctx,
+		`SELECT id FROM bookings
+		 WHERE venue_id = $1
+		   AND date = $2
+		   AND status IN ('held', 'confirmed', 'seated')
+		   AND array_prepend(table_id::text, combined_table_ids) && $5::text[]
+		   AND (EXTRACT(EPOCH FROM start_time) / 60)::INTEGER < booking_busy_until($3::time, $4::time, 0)
+		   AND (EXTRACT(EPOCH FROM $3::time) / 60)::INTEGER < booking_busy_until(start_time, end_time, buffer_minutes)
+		 ORDER BY start_time, id`,
+		venueID, date, startTime, endTime, tableIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
 // Models
 type Booking struct {
 	ID            string
@@ -271,15 +717,103 @@ type Booking struct {
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	ExpiresAt    *time.Time
+	Sequence     int32 // bumped on every change, used as iCalendar SEQUENCE
+	ExternalRef  string // reference in the system the booking was imported from
+	CombinedTableIDs []string // other tables occupied by this booking
+	DurationMinutes int32
+	BufferMinutes int32 // tables stay blocked this long after the booking ends
+	NeedsAttention  bool   // the booking's tables or the venue's hours changed under it
+	AttentionReason string
+}
+
+// combinedTableIDs never returns nil, the column is NOT NULL
+func (b *Booking) combinedTableIDs() []string {
+	if b.CombinedTableIDs == nil {
+		return []string{}
+	}
+	return b.CombinedTableIDs
 }
 
 type BookingFilters struct {
-	VenueID string
-	Date    string
-	Status  string
-	TableID string
-	Limit   int32
-	Offset  int32
+	VenueID  string
+	Date     string
+	DateFrom string
+	DateTo   string
+	Status   string
+	TableID  string
+	NeedsAttention bool // only flagged bookings that are still upcoming
+	Limit    int32
+	Offset   int32
+}
+
+type SearchFilters struct {
+	VenueID  string
+	Name     string
+	Phone    string
+	DateFrom string
+	DateTo   string
+	Limit    int32
+}
+
+type SearchResult struct {
+	Booking *Booking
+	Score   float32
+}
+
+// PacingLimit is the interval of a pacing rule covering a booking's start time
+type PacingLimit struct {
+	IntervalStart string // HH:MM, end exclusive
+	IntervalEnd   string
+	MaxCovers     int32 // 0 means no limit
+	MaxBookings   int32
+}
+
+// exceeded tells why one more booking of partySize does not fit next to the covers
+// and bookings already starting in the interval, or returns an empty string
+func (l PacingLimit) exceeded(covers, bookings, partySize int32) string {
+	switch {
+	case l.MaxCovers > 0 && covers+partySize > l.MaxCovers:
+		return fmt.Sprintf("%d of %d covers already start between %s and %s",
+			covers, l.MaxCovers, l.IntervalStart, l.IntervalEnd)
+	case l.MaxBookings > 0 && bookings+1 > l.MaxBookings:
+		return fmt.Sprintf("%d of %d bookings already start between %s and %s",
+			bookings, l.MaxBookings, l.IntervalStart, l.IntervalEnd)
+	}
+	return ""
+}
+
+// RoomHire books a whole room for an event; while tentative or confirmed it
+// occupies every table in TableIDs
+type RoomHire struct {
+	ID              string
+	VenueID         string
+	RoomID          string
+	TableIDs        []string
+	Date            string
+	StartTime       string
+	EndTime         string
+	DurationMinutes int32
+	EventName       string
+	Headcount       int32
+	ContactName     string
+	ContactPhone    string
+	ContactEmail    string
+	Status          string
+	Comment         string
+	AdminID         string
+	CancelReason    string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type RoomHireFilters struct {
+	VenueID    string
+	RoomID     string
+	Date       string
+	DateFrom   string
+	DateTo     string
+	Status     string
+	ActiveOnly bool // only tentative and confirmed hires
 }
 
 type OutboxMessage struct {
@@ -129,7 +129,7 @@ func TestRepository_CreateBooking_Integration(t *testing.T) {
 		Status:       "held",
 	}
 
-	err := repo.CreateBooking(ctx, booking)
+	err := repo.CreateBooking(ctx, booking, nil)
 	require.NoError(t, err)
 
 	// Verify booking was created
@@ -160,14 +160,32 @@ func TestRepository_CheckTableAvailability_Integration(t *testing.T) {
 		PartySize:  4,
 		Status:     "confirmed",
 	}
-	err := repo.CreateBooking(ctx, booking)
+	err := repo.CreateBooking(ctx, booking, nil)
 	require.NoError(t, err)
 
 	// Check availability - table-1 should be booked, table-2 should be available
-	availability, err := repo.CheckTableAvailability(ctx, "venue-1", []string{"table-1", "table-2"}, "2024-01-15", "19:00", "21:00")
+	availability, err := repo.CheckTableAvailability(ctx, "venue-1", []string{"table-1", "table-2"}, nil, "2024-01-15", "19:00", "21:00")
 	require.NoError(t, err)
 
 	assert.False(t, availability["table-1"], "table-1 should be booked")
 	assert.True(t, availability["table-2"], "table-2 should be available")
 }
 
+
+func TestEscapeLike(t *testing.T) {
+	assert.Equal(t, "anna", escapeLike("anna"))
+	assert.Equal(t, `100\%`, escapeLike("100%"))
+	assert.Equal(t, `a\_b`, escapeLike("a_b"))
+	assert.Equal(t, `c:\\x`, escapeLike(`c:\x`))
+}
+
+func TestPacingLimitExceeded(t *testing.T) {
+	limit := PacingLimit{IntervalStart: "19:00", IntervalEnd: "19:15", MaxCovers: 10, MaxBookings: 3}
+
+	assert.Empty(t, limit.exceeded(8, 1, 2))
+	assert.Equal(t, "8 of 10 covers already start between 19:00 and 19:15", limit.exceeded(8, 1, 3))
+	assert.Equal(t, "3 of 3 bookings already start between 19:00 and 19:15", limit.exceeded(4, 3, 2))
+
+	// Zero limits are not checked
+	assert.Empty(t, PacingLimit{}.exceeded(100, 100, 10))
+}
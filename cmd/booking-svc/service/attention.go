@@ -0,0 +1,482 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"booker/cmd/booking-svc/repository"
+	bookingpb "booker/pkg/proto/booking"
+	commonpb "booker/pkg/proto/common"
+	venuepb "booker/pkg/proto/venue"
+	"booker/pkg/tracing"
+)
+
+// upcomingStatuses are statuses of bookings the venue still has to honour
+var upcomingStatuses = map[string]bool{
+	"held":      true,
+	"confirmed": true,
+}
+
+// HandleLayoutUpdated refreshes the tables of hired rooms and re-checks upcoming
+// bookings on changed tables, or on all tables of the venue when tableIDs is empty.
+// A booking whose table is gone or too small is moved to an equivalent free table,
+// or flagged as needing attention if there is none
+func (s *Service) HandleLayoutUpdated(ctx context.Context, venueID string, tableIDs []string) error {
+	ctx, span := tracing.StartSpan(ctx, "HandleLayoutUpdated")
+	defer span.End()
+
+	layout, err := s.venueClient.GetTableLayout(ctx, &venuepb.GetTableLayoutRequest{VenueId: venueID})
+	if err != nil {
+		return fmt.Errorf("failed to get layout: %w", err)
+	}
+	tables := make(map[string]*venuepb.Table, len(layout.Tables))
+	for _, t := range layout.Tables {
+		tables[t.Id] = t
+	}
+	combinations, err := s.venueClient.ListTableCombinations(ctx, &venuepb.ListTableCombinationsRequest{VenueId: venueID})
+	if err != nil {
+		return fmt.Errorf("failed to list table combinations: %w", err)
+	}
+
+	changed := make(map[string]bool, len(tableIDs))
+	for _, id := range tableIDs {
+		changed[id] = true
+	}
+
+	// Hired rooms take in new tables first, so bookings are not moved onto them
+	if err := s.refreshRoomHires(ctx, venueID, layout.Tables); err != nil {
+		log.Error().Err(err).Str("venue_id", venueID).Msg("Failed to refresh room hires")
+	}
+
+	bookings, err := s.upcomingBookings(ctx, venueID, "")
+	if err != nil {
+		return err
+	}
+
+	for _, b := range bookings {
+		if len(changed) > 0 && !touchesTables(b, changed) {
+			continue
+		}
+		reason := layoutProblem(b, tables, combinations.Combinations)
+		if reason == "" {
+			continue
+		}
+		if err := s.reassignBooking(ctx, b, tables, reason); err != nil {
+			log.Info().Err(err).Str("booking_id", b.ID).Msg("Booking could not be reassigned")
+			s.flagBooking(ctx, b, reason)
+		}
+	}
+
+	return nil
+}
+
+// HandleScheduleUpdated flags upcoming bookings of a date, or of all dates when date
+// is empty, that no longer fit the venue's opening hours. Such bookings cannot be
+// fixed by another table, so they are only flagged
+func (s *Service) HandleScheduleUpdated(ctx context.Context, venueID, date string) error {
+	ctx, span := tracing.StartSpan(ctx, "HandleScheduleUpdated")
+	defer span.End()
+
+	bookings, err := s.upcomingBookings(ctx, venueID, date)
+	if err != nil {
+		return err
+	}
+
+	schedules := make(map[string]*venuepb.DaySchedule)
+	for _, b := range bookings {
+		schedule, ok := schedules[b.Date]
+		if !ok {
+			schedule, err = s.venueClient.GetDaySchedule(ctx, &venuepb.GetDayScheduleRequest{VenueId: venueID, Date: b.Date})
+			if err != nil {
+				return fmt.Errorf("failed to get schedule for %s: %w", b.Date, err)
+			}
+			schedules[b.Date] = schedule
+		}
+
+		if reason := scheduleProblem(b, schedule); reason != "" {
+			s.flagBooking(ctx, b, reason)
+		}
+	}
+
+	return nil
+}
+
+// ListAttentionBookings returns upcoming bookings flagged as needing attention
+func (s *Service) ListAttentionBookings(ctx context.Context, req *bookingpb.ListAttentionBookingsRequest) (*bookingpb.ListBookingsResponse, error) {
+	if req.VenueId == "" {
+		return nil, fmt.Errorf("venue_id is required")
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	bookings, total, err := s.repo.ListBookings(ctx, &repository.BookingFilters{
+		VenueID:        req.VenueId,
+		NeedsAttention: true,
+		Limit:          limit,
+		Offset:         req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	protoBookings := make([]*bookingpb.Booking, len(bookings))
+	for i, b := range bookings {
+		protoBookings[i] = s.toBookingProto(b)
+	}
+
+	return &bookingpb.ListBookingsResponse{
+		Bookings: protoBookings,
+		Total:    total,
+	}, nil
+}
+
+// ClearBookingAttention removes the flag once an admin has dealt with the booking
+func (s *Service) ClearBookingAttention(ctx context.Context, req *bookingpb.ClearBookingAttentionRequest) (*bookingpb.Booking, error) {
+	if err := s.repo.ClearBookingAttention(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.repo.GetBooking(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().Str("booking_id", req.Id).Str("admin_id", req.AdminId).Msg("Booking attention cleared")
+	return s.toBookingProto(booking), nil
+}
+
+// maxChangeRequestLength caps the text of a change request kept as attention reason
+const maxChangeRequestLength = 500
+
+// RequestBookingChange records that the guest wants the booking changed. Staff see
+// it among the bookings that need attention, with the request as the reason
+func (s *Service) RequestBookingChange(ctx context.Context, req *bookingpb.RequestBookingChangeRequest) (*bookingpb.Booking, error) {
+	ctx, span := tracing.StartSpan(ctx, "RequestBookingChange")
+	defer span.End()
+
+	message := strings.TrimSpace(req.Message)
+	if message == "" {
+		return nil, fmt.Errorf("message is required")
+	}
+	if len([]rune(message)) > maxChangeRequestLength {
+		message = string([]rune(message)[:maxChangeRequestLength])
+	}
+
+	booking, err := s.repo.GetBooking(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !upcomingStatuses[booking.Status] {
+		return nil, fmt.Errorf("booking in status %s cannot be changed", booking.Status)
+	}
+
+	reason := "change requested"
+	if req.AdminId != "" {
+		reason += " by " + req.AdminId
+	}
+	reason += ": " + message
+	if err := s.repo.FlagBooking(ctx, req.Id, reason); err != nil {
+		return nil, err
+	}
+	booking.NeedsAttention = true
+	booking.AttentionReason = reason
+
+	log.Info().Str("booking_id", req.Id).Str("admin_id", req.AdminId).Msg("Booking change requested")
+	return s.toBookingProto(booking), nil
+}
+
+// ListUpcomingBookings returns held and confirmed bookings from today on that occupy
+// any of the given tables, used by venue-svc before tables are deleted
+func (s *Service) ListUpcomingBookings(ctx context.Context, req *bookingpb.ListUpcomingBookingsRequest) (*bookingpb.ListBookingsResponse, error) {
+	if req.VenueId == "" {
+		return nil, fmt.Errorf("venue_id is required")
+	}
+
+	bookings, err := s.upcomingBookings(ctx, req.VenueId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	tableIDs := make(map[string]bool, len(req.TableIds))
+	for _, id := range req.TableIds {
+		tableIDs[id] = true
+	}
+
+	resp := &bookingpb.ListBookingsResponse{Bookings: []*bookingpb.Booking{}}
+	for _, b := range bookings {
+		if len(tableIDs) > 0 && !touchesTables(b, tableIDs) {
+			continue
+		}
+		resp.Bookings = append(resp.Bookings, s.toBookingProto(b))
+	}
+	resp.Total = int32(len(resp.Bookings))
+	return resp, nil
+}
+
+// MoveBooking seats an upcoming booking at other tables and lets the guest know
+func (s *Service) MoveBooking(ctx context.Context, req *bookingpb.MoveBookingRequest) (*bookingpb.Booking, error) {
+	ctx, span := tracing.StartSpan(ctx, "MoveBooking")
+	defer span.End()
+
+	if req.TableId == "" {
+		return nil, fmt.Errorf("table_id is required")
+	}
+	if req.BufferMinutes < 0 {
+		return nil, fmt.Errorf("buffer_minutes must not be negative")
+	}
+
+	booking, err := s.repo.GetBooking(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !upcomingStatuses[booking.Status] {
+		return nil, fmt.Errorf("booking in status %s cannot be moved", booking.Status)
+	}
+
+	moved, err := s.moveBooking(ctx, booking, req.TableId, req.CombinedTableIds, req.BufferMinutes, req.AdminId, req.Reason)
+	if err != nil {
+		return nil, conflictError(fmt.Errorf("failed to move booking: %w", err))
+	}
+
+	return s.toBookingProto(moved), nil
+}
+
+// moveBooking seats a booking at other tables, releases the holds of its old tables
+// and publishes booking.moved, so the guest learns about the new table
+func (s *Service) moveBooking(ctx context.Context, booking *repository.Booking, tableID string, combinedTableIDs []string, bufferMinutes int32, adminID, reason string) (*repository.Booking, error) {
+	if err := s.repo.ReassignBooking(ctx, booking.ID, tableID, combinedTableIDs, bufferMinutes, reason); err != nil {
+		return nil, err
+	}
+	// Holds are keyed by table, the database trigger guards the new tables
+	s.releaseHolds(ctx, s.bookingHoldKeys(booking))
+
+	moved, err := s.repo.GetBooking(ctx, booking.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &commonpb.BookingEvent{
+		BookingId:     moved.ID,
+		Table:         &commonpb.TableRef{VenueId: moved.VenueID, TableId: moved.TableID},
+		Slot:          &commonpb.Slot{Date: moved.Date, StartTime: formatClock(moved.StartTime), DurationMinutes: moved.DurationMinutes},
+		PartySize:     moved.PartySize,
+		CustomerName:  moved.CustomerName,
+		CustomerPhone: moved.CustomerPhone,
+		Payload: &commonpb.BookingEvent_Moved{
+			Moved: &commonpb.BookingMoved{
+				AdminId:   adminID,
+				Reason:    reason,
+				FromTable: &commonpb.TableRef{VenueId: booking.VenueID, TableId: booking.TableID},
+			},
+		},
+	}
+	if err := s.addToOutbox(ctx, "booking.moved", moved.ID, event); err != nil {
+		log.Error().Err(err).Msg("Failed to add to outbox")
+	}
+
+	return moved, nil
+}
+
+// upcomingBookings loads held and confirmed bookings of a venue from today on in the
+// venue's timezone, or of a single date if it is not in the past
+func (s *Service) upcomingBookings(ctx context.Context, venueID, date string) ([]*repository.Booking, error) {
+	venue, err := s.venueClient.GetVenue(ctx, &venuepb.GetVenueRequest{Id: venueID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get venue: %w", err)
+	}
+	loc, err := time.LoadLocation(venue.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	today := time.Now().In(loc).Format("2006-01-02")
+
+	filters := &repository.BookingFilters{VenueID: venueID, DateFrom: today}
+	if date != "" {
+		if date < today {
+			return nil, nil
+		}
+		filters = &repository.BookingFilters{VenueID: venueID, Date: date}
+	}
+
+	var bookings []*repository.Booking
+	err = s.repo.StreamBookings(ctx, filters, func(b *repository.Booking) error {
+		if upcomingStatuses[b.Status] {
+			bookings = append(bookings, b)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load bookings: %w", err)
+	}
+	return bookings, nil
+}
+
+// reassignBooking moves a booking to the best free assignment suggested by venue-svc.
+// When the old table still exists and has a zone, only assignments entirely in that
+// zone are equivalent. The move goes through moveBooking like a manual one, so the guest
+// is told about the new table
+func (s *Service) reassignBooking(ctx context.Context, b *repository.Booking, tables map[string]*venuepb.Table, reason string) error {
+	zone := ""
+	if t, ok := tables[b.TableID]; ok {
+		zone = t.Zone
+	}
+
+	availability, err := s.venueClient.CheckAvailability(ctx, &venuepb.CheckAvailabilityRequest{
+		VenueId:       b.VenueID,
+		Slot:          &commonpb.Slot{Date: b.Date, StartTime: b.StartTime, DurationMinutes: b.DurationMinutes},
+		PartySize:     b.PartySize,
+		PreferredZone: zone,
+	})
+	if err != nil {
+		return fmt.Errorf("availability check failed: %w", err)
+	}
+
+	for _, assignment := range availability.SuggestedAssignment {
+		if !inZone(assignment.Tables, tables, zone) {
+			continue
+		}
+
+		ids := make([]string, len(assignment.Tables))
+		var buffer int32
+		for i, ref := range assignment.Tables {
+			ids[i] = ref.TableId
+			buffer = max(buffer, availability.TableBufferMinutes[ref.TableId])
+		}
+
+		// Another booking may have taken the tables since the check, try the next option
+		if _, err := s.moveBooking(ctx, b, ids[0], ids[1:], buffer, "", reason); err != nil {
+			log.Warn().Err(err).Str("booking_id", b.ID).Strs("tables", ids).Msg("Failed to reassign booking")
+			continue
+		}
+
+		log.Info().
+			Str("booking_id", b.ID).
+			Str("from_table", b.TableID).
+			Strs("to_tables", ids).
+			Str("reason", reason).
+			Msg("Booking reassigned")
+		return nil
+	}
+
+	return fmt.Errorf("no equivalent table is free")
+}
+
+// flagBooking marks a booking as needing attention unless it already is for the same reason
+func (s *Service) flagBooking(ctx context.Context, b *repository.Booking, reason string) {
+	if b.NeedsAttention && b.AttentionReason == reason {
+		return
+	}
+	if err := s.repo.FlagBooking(ctx, b.ID, reason); err != nil {
+		log.Error().Err(err).Str("booking_id", b.ID).Msg("Failed to flag booking")
+		return
+	}
+	log.Info().Str("booking_id", b.ID).Str("reason", reason).Msg("Booking needs attention")
+}
+
+func touchesTables(b *repository.Booking, tableIDs map[string]bool) bool {
+	if tableIDs[b.TableID] {
+		return true
+	}
+	for _, id := range b.CombinedTableIDs {
+		if tableIDs[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// layoutProblem explains why a booking no longer fits its tables, or returns "".
+// Tables forming a configured combination seat its max_capacity, as in availability
+func layoutProblem(b *repository.Booking, tables map[string]*venuepb.Table, combinations []*venuepb.TableCombination) string {
+	ids := append([]string{b.TableID}, b.CombinedTableIDs...)
+	var capacity int32
+	for _, id := range ids {
+		t, ok := tables[id]
+		if !ok {
+			return fmt.Sprintf("table %s was removed", id)
+		}
+		capacity += t.Capacity
+	}
+	if c := findCombination(ids, combinations); c != nil && c.MaxCapacity > 0 {
+		capacity = c.MaxCapacity
+	}
+	if capacity < b.PartySize {
+		return fmt.Sprintf("tables seat %d guests, party of %d", capacity, b.PartySize)
+	}
+	return ""
+}
+
+// findCombination returns the combination made of exactly the given tables, or nil
+func findCombination(ids []string, combinations []*venuepb.TableCombination) *venuepb.TableCombination {
+	if len(ids) < 2 {
+		return nil
+	}
+	sorted := slices.Sorted(slices.Values(ids))
+	for _, c := range combinations {
+		if slices.Equal(sorted, slices.Sorted(slices.Values(c.TableIds))) {
+			return c
+		}
+	}
+	return nil
+}
+
+// scheduleProblem explains why a booking no longer fits the opening hours of its day, or returns ""
+func scheduleProblem(b *repository.Booking, schedule *venuepb.DaySchedule) string {
+	if schedule.Closed {
+		return fmt.Sprintf("venue is closed on %s", b.Date)
+	}
+
+	open, err1 := clockMinutes(schedule.OpenTime)
+	close, err2 := clockMinutes(schedule.CloseTime)
+	start, err3 := clockMinutes(b.StartTime)
+	end, err4 := clockMinutes(b.EndTime)
+	if err1 != nil || err2 != nil || err3 != nil || err4 != nil {
+		return ""
+	}
+	// A booking ending at midnight has end time 00:00
+	if end <= start {
+		end += 24 * 60
+	}
+
+	if start < open || end > close {
+		return fmt.Sprintf("booking %s-%s is outside opening hours %s-%s",
+			formatClock(b.StartTime), formatClock(b.EndTime), schedule.OpenTime, schedule.CloseTime)
+	}
+	return ""
+}
+
+func inZone(refs []*commonpb.TableRef, tables map[string]*venuepb.Table, zone string) bool {
+	if zone == "" {
+		return true
+	}
+	for _, ref := range refs {
+		if t, ok := tables[ref.TableId]; !ok || !strings.EqualFold(t.Zone, zone) {
+			return false
+		}
+	}
+	return true
+}
+
+// formatClock trims seconds from TIME values ("19:00:00" -> "19:00")
+func formatClock(t string) string {
+	if len(t) > 5 {
+		return t[:5]
+	}
+	return t
+}
+
+// clockMinutes converts HH:MM (or HH:MM:SS) to minutes after midnight; 24:00 is the end of the day
+func clockMinutes(t string) (int, error) {
+	var h, m int
+	if _, err := fmt.Sscanf(formatClock(t), "%d:%d", &h, &m); err != nil || h < 0 || h > 24 || m < 0 || m > 59 {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", t)
+	}
+	return h*60 + m, nil
+}
@@ -0,0 +1,350 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"booker/cmd/booking-svc/repository"
+	bookingpb "booker/pkg/proto/booking"
+	venuepb "booker/pkg/proto/venue"
+	"booker/pkg/tracing"
+)
+
+const maxImportRows = 5000
+
+// Import row statuses
+const (
+	importStatusCreated     = "created"
+	importStatusWouldCreate = "would_create"
+	importStatusDuplicate   = "duplicate"
+	importStatusConflict    = "conflict"
+	importStatusInvalid     = "invalid"
+)
+
+// importCandidate is a validated row waiting for conflict checks
+type importCandidate struct {
+	result  *bookingpb.ImportBookingResult
+	booking *repository.Booking
+	start   int
+	end     int
+	buffer  int
+}
+
+// ImportBookings validates rows coming from another reservation system and stores
+// them as confirmed bookings. Rows are keyed by external_ref, so re-running the same
+// file only reports duplicates. With dry_run nothing is written.
+func (s *Service) ImportBookings(ctx context.Context, req *bookingpb.ImportBookingsRequest) (*bookingpb.ImportBookingsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "ImportBookings")
+	defer span.End()
+
+	if req.VenueId == "" {
+		return nil, fmt.Errorf("venue_id is required")
+	}
+	if len(req.Rows) > maxImportRows {
+		return nil, fmt.Errorf("too many rows: %d, max %d", len(req.Rows), maxImportRows)
+	}
+
+	venue, err := s.venueClient.GetVenue(ctx, &venuepb.GetVenueRequest{Id: req.VenueId})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get venue: %w", err)
+	}
+	loc, err := time.LoadLocation(venue.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	today := time.Now().In(loc).Format("2006-01-02")
+
+	tables, err := s.venueClient.ListTables(ctx, &venuepb.ListTablesRequest{VenueId: req.VenueId, Limit: 1000})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	resolver := newTableResolver(tables.Tables)
+	buffers := make(map[string]int32, len(tables.Tables))
+	for _, t := range tables.Tables {
+		buffers[t.Id] = venue.BufferMinutes
+		if t.BufferMinutes > 0 {
+			buffers[t.Id] = t.BufferMinutes
+		}
+	}
+
+	// Rows without a duration get the venue's turn time, resolved once per distinct slot
+	turnTimes := make(map[string]int32)
+	for _, row := range req.Rows {
+		if row.Slot == nil || row.Slot.DurationMinutes != 0 || row.PartySize <= 0 {
+			continue
+		}
+		// Malformed date or time is reported by row validation; anything else that
+		// fails the lookup fails the whole import
+		if _, err := time.Parse("2006-01-02", row.Slot.Date); err != nil {
+			continue
+		}
+		if _, err := time.Parse("15:04", row.Slot.StartTime); err != nil {
+			continue
+		}
+		key := fmt.Sprintf("%s|%s|%d", row.Slot.Date, row.Slot.StartTime, row.PartySize)
+		duration, ok := turnTimes[key]
+		if !ok {
+			resp, err := s.venueClient.ResolveTurnTime(ctx, &venuepb.ResolveTurnTimeRequest{
+				VenueId:   req.VenueId,
+				Date:      row.Slot.Date,
+				StartTime: row.Slot.StartTime,
+				PartySize: row.PartySize,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve turn time for %s %s: %w", row.Slot.Date, row.Slot.StartTime, err)
+			}
+			duration = resp.DurationMinutes
+			turnTimes[key] = duration
+		}
+		row.Slot.DurationMinutes = duration
+	}
+
+	resp := &bookingpb.ImportBookingsResponse{}
+	results := make([]*bookingpb.ImportBookingResult, len(req.Rows))
+	candidates := make([]*importCandidate, 0, len(req.Rows))
+	seenRefs := make(map[string]bool, len(req.Rows))
+
+	for i, row := range req.Rows {
+		result := &bookingpb.ImportBookingResult{Line: row.Line, ExternalRef: row.ExternalRef}
+		results[i] = result
+
+		candidate, err := validateImportRow(row, resolver, today)
+		if err == nil && seenRefs[row.ExternalRef] {
+			err = fmt.Errorf("external_ref %s appears more than once in the file", row.ExternalRef)
+		}
+		if err != nil {
+			result.Status = importStatusInvalid
+			result.Error = err.Error()
+			continue
+		}
+		seenRefs[row.ExternalRef] = true
+
+		candidate.result = result
+		candidate.booking.VenueID = req.VenueId
+		candidate.booking.AdminID = req.AdminId
+		candidate.booking.BufferMinutes = buffers[candidate.booking.TableID]
+		candidate.buffer = int(candidate.booking.BufferMinutes)
+		candidates = append(candidates, candidate)
+	}
+
+	refs := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		refs = append(refs, c.booking.ExternalRef)
+	}
+	existing, err := s.repo.GetBookingIDsByExternalRefs(ctx, req.VenueId, refs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up external refs: %w", err)
+	}
+
+	// Check every row against stored bookings and against rows accepted earlier in the same file
+	accepted := make(map[string][]*importCandidate)
+	var toInsert []*importCandidate
+	for _, c := range candidates {
+		b := c.booking
+		if id, ok := existing[b.ExternalRef]; ok {
+			c.result.Status = importStatusDuplicate
+			c.result.BookingId = id
+			continue
+		}
+
+		availability, err := s.repo.CheckTableAvailability(ctx, req.VenueId, []string{b.TableID}, buffers, b.Date, b.StartTime, b.EndTime)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check table availability: %w", err)
+		}
+		if !availability[b.TableID] {
+			c.result.Status = importStatusConflict
+			c.result.Error = "table is already booked for this time slot"
+			continue
+		}
+
+		key := b.TableID + "|" + b.Date
+		if other := findOverlap(accepted[key], c); other != nil {
+			c.result.Status = importStatusConflict
+			c.result.Error = fmt.Sprintf("overlaps with line %d (%s)", other.result.Line, other.booking.ExternalRef)
+			continue
+		}
+		accepted[key] = append(accepted[key], c)
+
+		if req.DryRun {
+			c.result.Status = importStatusWouldCreate
+		}
+		toInsert = append(toInsert, c)
+	}
+
+	if !req.DryRun && len(toInsert) > 0 {
+		bookings := make([]*repository.Booking, len(toInsert))
+		for i, c := range toInsert {
+			bookings[i] = c.booking
+		}
+		// Imported bookings are not published to Kafka: guests were already
+		// notified by the previous system and must not get a second confirmation
+		inserted, err := s.repo.ImportBookings(ctx, bookings)
+		if err != nil {
+			return nil, conflictError(fmt.Errorf("failed to import bookings: %w", err))
+		}
+
+		var raced []string
+		for _, c := range toInsert {
+			if inserted[c.booking.ID] {
+				c.result.Status = importStatusCreated
+				c.result.BookingId = c.booking.ID
+			} else {
+				// Imported concurrently by another request
+				c.result.Status = importStatusDuplicate
+				raced = append(raced, c.booking.ExternalRef)
+			}
+		}
+		if len(raced) > 0 {
+			ids, err := s.repo.GetBookingIDsByExternalRefs(ctx, req.VenueId, raced)
+			if err != nil {
+				log.Warn().Err(err).Msg("Failed to look up concurrently imported bookings")
+			}
+			for _, c := range toInsert {
+				if !inserted[c.booking.ID] {
+					c.result.BookingId = ids[c.booking.ExternalRef]
+				}
+			}
+		}
+	}
+
+	for _, r := range results {
+		switch r.Status {
+		case importStatusCreated, importStatusWouldCreate:
+			resp.Created++
+		case importStatusDuplicate:
+			resp.Duplicates++
+		default:
+			resp.Failed++
+		}
+	}
+	resp.Results = results
+
+	log.Info().
+		Str("venue_id", req.VenueId).
+		Bool("dry_run", req.DryRun).
+		Int32("created", resp.Created).
+		Int32("duplicates", resp.Duplicates).
+		Int32("failed", resp.Failed).
+		Msg("Bookings import processed")
+
+	return resp, nil
+}
+
+func validateImportRow(row *bookingpb.ImportBookingRow, resolver *tableResolver, today string) (*importCandidate, error) {
+	if strings.TrimSpace(row.ExternalRef) == "" {
+		return nil, fmt.Errorf("external_ref is required")
+	}
+	if strings.TrimSpace(row.CustomerName) == "" {
+		return nil, fmt.Errorf("customer_name is required")
+	}
+	if row.PartySize <= 0 {
+		return nil, fmt.Errorf("party_size must be positive")
+	}
+
+	table, err := resolver.resolve(row.TableId, row.TableName)
+	if err != nil {
+		return nil, err
+	}
+	if row.PartySize > table.Capacity {
+		return nil, fmt.Errorf("party of %d does not fit table %s (capacity %d)", row.PartySize, table.Name, table.Capacity)
+	}
+
+	slot := row.Slot
+	if slot == nil {
+		return nil, fmt.Errorf("date and start_time are required")
+	}
+	if _, err := time.Parse("2006-01-02", slot.Date); err != nil {
+		return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", slot.Date)
+	}
+	if slot.Date < today {
+		return nil, fmt.Errorf("date %s is in the past", slot.Date)
+	}
+	start, err := time.Parse("15:04", slot.StartTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start_time %q, expected HH:MM", slot.StartTime)
+	}
+	duration := slot.DurationMinutes
+	if duration <= 0 {
+		return nil, fmt.Errorf("duration_minutes must be positive")
+	}
+	startMin := start.Hour()*60 + start.Minute()
+	endMin := startMin + int(duration)
+	if endMin > 24*60 {
+		return nil, fmt.Errorf("booking must end before midnight")
+	}
+
+	return &importCandidate{
+		booking: &repository.Booking{
+			ID:              uuid.New().String(),
+			TableID:         table.Id,
+			Date:            slot.Date,
+			StartTime:       slot.StartTime,
+			EndTime:         fmt.Sprintf("%02d:%02d", endMin/60, endMin%60),
+			DurationMinutes: duration,
+			PartySize:       row.PartySize,
+			CustomerName:    strings.TrimSpace(row.CustomerName),
+			CustomerPhone:   strings.TrimSpace(row.CustomerPhone),
+			Status:          "confirmed",
+			Comment:         row.Comment,
+			ExternalRef:     strings.TrimSpace(row.ExternalRef),
+		},
+		start: startMin,
+		end:   endMin,
+	}, nil
+}
+
+// findOverlap returns a row whose table is still taken, buffer included, when c starts or the other way round
+func findOverlap(existing []*importCandidate, c *importCandidate) *importCandidate {
+	for _, other := range existing {
+		if c.start < other.end+other.buffer && other.start < c.end+c.buffer {
+			return other
+		}
+	}
+	return nil
+}
+
+// tableResolver maps table IDs and case-insensitive names of a venue to tables
+type tableResolver struct {
+	byID   map[string]*venuepb.Table
+	byName map[string][]*venuepb.Table
+}
+
+func newTableResolver(tables []*venuepb.Table) *tableResolver {
+	r := &tableResolver{
+		byID:   make(map[string]*venuepb.Table, len(tables)),
+		byName: make(map[string][]*venuepb.Table, len(tables)),
+	}
+	for _, t := range tables {
+		r.byID[t.Id] = t
+		name := strings.ToLower(strings.TrimSpace(t.Name))
+		r.byName[name] = append(r.byName[name], t)
+	}
+	return r
+}
+
+func (r *tableResolver) resolve(id, name string) (*venuepb.Table, error) {
+	if id != "" {
+		if t, ok := r.byID[id]; ok {
+			return t, nil
+		}
+		return nil, fmt.Errorf("table %s not found in venue", id)
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("table is required")
+	}
+
+	matches := r.byName[strings.ToLower(strings.TrimSpace(name))]
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("table %q not found in venue", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("table name %q is ambiguous, use table_id", name)
+	}
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	bookingpb "booker/pkg/proto/booking"
+	commonpb "booker/pkg/proto/common"
+	venuepb "booker/pkg/proto/venue"
+)
+
+// importVenueClient answers the venue-svc calls of ImportBookings
+type importVenueClient struct {
+	venuepb.VenueServiceClient
+	turnTimeErr   error
+	turnTimeCalls int
+}
+
+func (c *importVenueClient) GetVenue(context.Context, *venuepb.GetVenueRequest, ...grpc.CallOption) (*venuepb.Venue, error) {
+	return &venuepb.Venue{Id: "venue-1", Timezone: "Europe/Moscow"}, nil
+}
+
+func (c *importVenueClient) ListTables(context.Context, *venuepb.ListTablesRequest, ...grpc.CallOption) (*venuepb.ListTablesResponse, error) {
+	return &venuepb.ListTablesResponse{Tables: []*venuepb.Table{{Id: "t1", Name: "Table 1", Capacity: 4}}}, nil
+}
+
+func (c *importVenueClient) ResolveTurnTime(context.Context, *venuepb.ResolveTurnTimeRequest, ...grpc.CallOption) (*venuepb.ResolveTurnTimeResponse, error) {
+	c.turnTimeCalls++
+	if c.turnTimeErr != nil {
+		return nil, c.turnTimeErr
+	}
+	return &venuepb.ResolveTurnTimeResponse{DurationMinutes: 120}, nil
+}
+
+func TestImportBookings_TurnTimeUnavailable(t *testing.T) {
+	venue := &importVenueClient{turnTimeErr: errors.New("venue-svc unavailable")}
+	s := New(nil, nil, venue, nil, nil)
+
+	_, err := s.ImportBookings(context.Background(), &bookingpb.ImportBookingsRequest{
+		VenueId: "venue-1",
+		Rows: []*bookingpb.ImportBookingRow{
+			// Malformed slots are left to row validation and never looked up
+			{Line: 2, ExternalRef: "r1", Slot: &commonpb.Slot{Date: "06.03.2099", StartTime: "19:00"}, PartySize: 2},
+			{Line: 3, ExternalRef: "r2", Slot: &commonpb.Slot{Date: "2099-03-06", StartTime: "19:00"}, PartySize: 2},
+		},
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "venue-svc unavailable")
+	assert.Equal(t, 1, venue.turnTimeCalls)
+}
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"booker/cmd/booking-svc/repository"
+	bookingpb "booker/pkg/proto/booking"
+	commonpb "booker/pkg/proto/common"
+	venuepb "booker/pkg/proto/venue"
+	"booker/pkg/tracing"
+)
+
+const roomHireTopicPrefix = "room_hire."
+
+// roomHireTransitions lists the statuses a hire may move to from each status.
+// Tentative and confirmed hires occupy the room's tables
+var roomHireTransitions = map[string][]string{
+	"tentative": {"confirmed", "cancelled"},
+	"confirmed": {"completed", "cancelled"},
+}
+
+// CreateRoomHire books every table of a room for an event. The hire starts as
+// tentative and already blocks the room; bookings in the way must be moved or
+// cancelled first
+func (s *Service) CreateRoomHire(ctx context.Context, req *bookingpb.CreateRoomHireRequest) (*bookingpb.RoomHire, error) {
+	ctx, span := tracing.StartSpan(ctx, "CreateRoomHire")
+	defer span.End()
+
+	if err := validateRoomHire(req); err != nil {
+		return nil, err
+	}
+
+	layout, err := s.venueClient.GetTableLayout(ctx, &venuepb.GetTableLayoutRequest{VenueId: req.VenueId, RoomId: req.RoomId})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get room layout: %w", err)
+	}
+	if layout.Room.GetVenueId() != req.VenueId || layout.Room.GetDeletedAt() != 0 {
+		return nil, fmt.Errorf("room %s not found in venue %s", req.RoomId, req.VenueId)
+	}
+
+	hire := &repository.RoomHire{
+		ID:              uuid.New().String(),
+		VenueID:         req.VenueId,
+		RoomID:          req.RoomId,
+		TableIDs:        roomTableIDs(layout.Tables, req.RoomId),
+		Date:            req.Slot.Date,
+		StartTime:       req.Slot.StartTime,
+		EndTime:         s.calculateEndTime(req.Slot.StartTime, req.Slot.DurationMinutes),
+		DurationMinutes: req.Slot.DurationMinutes,
+		EventName:       strings.TrimSpace(req.EventName),
+		Headcount:       req.Headcount,
+		ContactName:     strings.TrimSpace(req.ContactName),
+		ContactPhone:    strings.TrimSpace(req.ContactPhone),
+		ContactEmail:    strings.TrimSpace(req.ContactEmail),
+		Status:          "tentative",
+		Comment:         req.Comment,
+		AdminID:         req.AdminId,
+	}
+
+	conflicts, err := s.repo.ListBookingsOverlapping(ctx, hire.VenueID, hire.TableIDs, hire.Date, hire.StartTime, hire.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bookings in the room: %w", err)
+	}
+	if len(conflicts) > 0 {
+		return nil, fmt.Errorf("room has bookings at this time, move or cancel them first: %s", strings.Join(conflicts, ", "))
+	}
+
+	// The overlap trigger catches bookings made since the check and other hires of the room
+	if err := s.repo.CreateRoomHire(ctx, hire); err != nil {
+		return nil, fmt.Errorf("failed to create room hire: %w", err)
+	}
+
+	hire.CreatedAt = time.Now()
+	hire.UpdatedAt = hire.CreatedAt
+	s.publishRoomHire(ctx, "room_hire.created", hire, req.AdminId)
+
+	log.Info().
+		Str("room_hire_id", hire.ID).
+		Str("venue_id", hire.VenueID).
+		Str("room_id", hire.RoomID).
+		Str("date", hire.Date).
+		Msg("Room hire created")
+
+	return toRoomHireProto(hire), nil
+}
+
+func (s *Service) GetRoomHire(ctx context.Context, req *bookingpb.GetRoomHireRequest) (*bookingpb.RoomHire, error) {
+	hire, err := s.repo.GetRoomHire(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toRoomHireProto(hire), nil
+}
+
+func (s *Service) ListRoomHires(ctx context.Context, req *bookingpb.ListRoomHiresRequest) (*bookingpb.ListRoomHiresResponse, error) {
+	if req.VenueId == "" && req.RoomId == "" {
+		return nil, fmt.Errorf("venue_id or room_id is required")
+	}
+
+	hires, err := s.repo.ListRoomHires(ctx, &repository.RoomHireFilters{
+		VenueID:    req.VenueId,
+		RoomID:     req.RoomId,
+		Date:       req.Date,
+		DateFrom:   req.DateFrom,
+		DateTo:     req.DateTo,
+		Status:     req.Status,
+		ActiveOnly: req.ActiveOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &bookingpb.ListRoomHiresResponse{RoomHires: make([]*bookingpb.RoomHire, len(hires))}
+	for i, h := range hires {
+		resp.RoomHires[i] = toRoomHireProto(h)
+	}
+	return resp, nil
+}
+
+func (s *Service) ConfirmRoomHire(ctx context.Context, req *bookingpb.ConfirmRoomHireRequest) (*bookingpb.RoomHire, error) {
+	return s.transitionRoomHire(ctx, req.Id, "confirmed", req.AdminId, "")
+}
+
+func (s *Service) CancelRoomHire(ctx context.Context, req *bookingpb.CancelRoomHireRequest) (*bookingpb.RoomHire, error) {
+	return s.transitionRoomHire(ctx, req.Id, "cancelled", req.AdminId, req.Reason)
+}
+
+// CompleteRoomHire closes a confirmed hire once the event is over
+func (s *Service) CompleteRoomHire(ctx context.Context, req *bookingpb.CompleteRoomHireRequest) (*bookingpb.RoomHire, error) {
+	return s.transitionRoomHire(ctx, req.Id, "completed", req.AdminId, "")
+}
+
+// transitionRoomHire moves a hire to the next status and publishes room_hire.<status>
+func (s *Service) transitionRoomHire(ctx context.Context, id, status, adminID, reason string) (*bookingpb.RoomHire, error) {
+	ctx, span := tracing.StartSpan(ctx, "TransitionRoomHire")
+	defer span.End()
+
+	hire, err := s.repo.GetRoomHire(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(roomHireTransitions[hire.Status], status) {
+		return nil, fmt.Errorf("room hire in status %s cannot become %s", hire.Status, status)
+	}
+
+	if err := s.repo.UpdateRoomHireStatus(ctx, id, status, reason); err != nil {
+		return nil, err
+	}
+	hire.Status = status
+	hire.CancelReason = reason
+	hire.UpdatedAt = time.Now()
+
+	s.publishRoomHire(ctx, roomHireTopicPrefix+status, hire, adminID)
+	return toRoomHireProto(hire), nil
+}
+
+// refreshRoomHires points upcoming hires of a venue at the current tables of their
+// rooms, so tables added to a hired room are blocked too. Hires are taken from
+// yesterday on, which covers today in any timezone
+func (s *Service) refreshRoomHires(ctx context.Context, venueID string, tables []*venuepb.Table) error {
+	hires, err := s.repo.ListRoomHires(ctx, &repository.RoomHireFilters{
+		VenueID:    venueID,
+		DateFrom:   time.Now().AddDate(0, 0, -1).Format("2006-01-02"),
+		ActiveOnly: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load room hires: %w", err)
+	}
+
+	for _, h := range hires {
+		tableIDs := roomTableIDs(tables, h.RoomID)
+		if sameTableSet(h.TableIDs, tableIDs) {
+			continue
+		}
+		if err := s.repo.UpdateRoomHireTables(ctx, h.ID, tableIDs); err != nil {
+			log.Error().Err(err).Str("room_hire_id", h.ID).Msg("Failed to refresh room hire tables")
+			continue
+		}
+		log.Info().Str("room_hire_id", h.ID).Strs("tables", tableIDs).Msg("Room hire tables refreshed")
+	}
+	return nil
+}
+
+func validateRoomHire(req *bookingpb.CreateRoomHireRequest) error {
+	switch {
+	case req.VenueId == "" || req.RoomId == "":
+		return fmt.Errorf("venue_id and room_id are required")
+	case strings.TrimSpace(req.EventName) == "":
+		return fmt.Errorf("event_name is required")
+	case req.Headcount <= 0:
+		return fmt.Errorf("headcount must be positive")
+	case strings.TrimSpace(req.ContactName) == "":
+		return fmt.Errorf("contact_name is required")
+	case strings.TrimSpace(req.ContactPhone) == "" && strings.TrimSpace(req.ContactEmail) == "":
+		return fmt.Errorf("contact_phone or contact_email is required")
+	}
+
+	slot := req.Slot
+	if _, err := time.Parse("2006-01-02", slot.GetDate()); err != nil {
+		return fmt.Errorf("invalid date %q, expected YYYY-MM-DD", slot.GetDate())
+	}
+	if _, err := clockMinutes(slot.GetStartTime()); err != nil {
+		return err
+	}
+	// Like bookings, a hire belongs to one day and may end at midnight at the latest
+	if slot.GetDurationMinutes() <= 0 || slot.GetDurationMinutes() > 24*60 {
+		return fmt.Errorf("duration_minutes must be between 1 and %d", 24*60)
+	}
+	return nil
+}
+
+// roomTableIDs lists the tables of a room in layout order
+func roomTableIDs(tables []*venuepb.Table, roomID string) []string {
+	ids := []string{}
+	for _, t := range tables {
+		if t.RoomId == roomID && t.DeletedAt == 0 {
+			ids = append(ids, t.Id)
+		}
+	}
+	return ids
+}
+
+func sameTableSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, id := range a {
+		if !slices.Contains(b, id) {
+			return false
+		}
+	}
+	return true
+}
+
+// publishRoomHire queues a room hire event in the outbox
+func (s *Service) publishRoomHire(ctx context.Context, topic string, h *repository.RoomHire, adminID string) {
+	event := &commonpb.RoomHireEvent{
+		RoomHireId:   h.ID,
+		VenueId:      h.VenueID,
+		RoomId:       h.RoomID,
+		Slot:         &commonpb.Slot{Date: h.Date, StartTime: formatClock(h.StartTime), DurationMinutes: h.DurationMinutes},
+		EventName:    h.EventName,
+		Headcount:    h.Headcount,
+		ContactName:  h.ContactName,
+		ContactPhone: h.ContactPhone,
+		ContactEmail: h.ContactEmail,
+		Status:       h.Status,
+		AdminId:      adminID,
+		Reason:       h.CancelReason,
+	}
+
+	if err := s.addToOutbox(ctx, topic, h.ID, event); err != nil {
+		log.Error().Err(err).Str("room_hire_id", h.ID).Msg("Failed to add to outbox")
+	}
+}
+
+// relayRoomHireEvent publishes an outbox message of a room_hire.* topic
+func (s *Service) relayRoomHireEvent(ctx context.Context, msg *repository.OutboxMessage) {
+	var event commonpb.RoomHireEvent
+	if err := protojson.Unmarshal(msg.Payload, &event); err != nil {
+		log.Error().Err(err).Str("id", msg.ID).Msg("Failed to unmarshal room hire event")
+		s.repo.UpdateOutboxStatus(ctx, msg.ID, "failed", msg.RetryCount+1)
+		return
+	}
+
+	event.Headers = withEventID(event.Headers, msg.ID)
+	if err := s.producer.PublishRoomHireEvent(ctx, msg.Topic, &event); err != nil {
+		log.Error().Err(err).Str("id", msg.ID).Msg("Failed to publish event")
+		s.retryOutbox(ctx, msg)
+		return
+	}
+
+	s.repo.UpdateOutboxStatus(ctx, msg.ID, "sent", msg.RetryCount)
+}
+
+func toRoomHireProto(h *repository.RoomHire) *bookingpb.RoomHire {
+	return &bookingpb.RoomHire{
+		Id:           h.ID,
+		VenueId:      h.VenueID,
+		RoomId:       h.RoomID,
+		TableIds:     h.TableIDs,
+		Slot:         &commonpb.Slot{Date: h.Date, StartTime: formatClock(h.StartTime), DurationMinutes: h.DurationMinutes},
+		EventName:    h.EventName,
+		Headcount:    h.Headcount,
+		ContactName:  h.ContactName,
+		ContactPhone: h.ContactPhone,
+		ContactEmail: h.ContactEmail,
+		Status:       h.Status,
+		Comment:      h.Comment,
+		AdminId:      h.AdminID,
+		CancelReason: h.CancelReason,
+		CreatedAt:    h.CreatedAt.Unix(),
+		UpdatedAt:    h.UpdatedAt.Unix(),
+	}
+}
@@ -3,12 +3,19 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"booker/cmd/booking-svc/config"
 	"booker/cmd/booking-svc/repository"
@@ -48,40 +55,85 @@ func (s *Service) CreateBooking(ctx context.Context, req *bookingpb.CreateBookin
 		// TODO: Check idempotency key in Redis
 	}
 
+	// Use the venue's turn time when the duration is omitted
+	slot := req.Slot
+	if slot.GetDurationMinutes() == 0 {
+		turnTime, err := s.venueClient.ResolveTurnTime(ctx, &venuepb.ResolveTurnTimeRequest{
+			VenueId:   req.VenueId,
+			Date:      slot.GetDate(),
+			StartTime: slot.GetStartTime(),
+			PartySize: req.PartySize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve turn time: %w", err)
+		}
+		slot = &commonpb.Slot{Date: slot.GetDate(), StartTime: slot.GetStartTime(), DurationMinutes: turnTime.DurationMinutes}
+	}
+
 	// Validate slot availability with venue service
-	_, err := s.venueClient.CheckAvailability(ctx, &venuepb.CheckAvailabilityRequest{
+	availability, err := s.venueClient.CheckAvailability(ctx, &venuepb.CheckAvailabilityRequest{
 		VenueId: req.VenueId,
-		Slot:    req.Slot,
+		Slot:    slot,
 		PartySize: req.PartySize,
+		PreferredZone: req.PreferredZone,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("availability check failed: %w", err)
 	}
+	if rejection := availability.PacingRejection; rejection != nil {
+		return nil, fmt.Errorf("pacing limit reached: %s", rejection.Reason)
+	}
 
-	// Try to acquire hold in Redis
-	holdKey := s.getHoldKey(req.VenueId, req.Table.TableId, req.Slot.Date, req.Slot.StartTime)
+	// No table given: take the best assignment suggested by venue-svc. A table the
+	// caller names must be one venue-svc offers for the slot, which rules out blocked,
+	// deleted and too small tables
+	table := req.Table
+	var combined []*commonpb.TableRef
+	switch {
+	case table.GetTableId() == "":
+		if len(req.CombinedTables) > 0 {
+			return nil, fmt.Errorf("table is required with combined_tables")
+		}
+		if len(availability.SuggestedAssignment) == 0 {
+			return nil, fmt.Errorf("no tables available for %d guests", req.PartySize)
+		}
+		best := availability.SuggestedAssignment[0]
+		table = best.Tables[0]
+		combined = best.Tables[1:]
+	default:
+		table, combined, err = availableTables(table, req.CombinedTables, availability.Tables, req.PartySize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Tables are reset together, so the longest buffer of the booking's tables applies
+	tables := append([]*commonpb.TableRef{table}, combined...)
+	var buffer int32
+	for _, t := range tables {
+		buffer = max(buffer, availability.TableBufferMinutes[t.TableId])
+	}
+
+	// Try to acquire holds in Redis for every table of the booking, buffer included
 	bookingID := uuid.New().String()
-	
-	acquired, err := s.redis.SetHold(ctx, holdKey, bookingID, time.Duration(s.cfg.HoldTTLMinutes)*time.Minute)
+	holdKeys, err := s.acquireHolds(ctx, bookingID, req.VenueId, tables, slot, buffer)
 	if err != nil {
-		return nil, fmt.Errorf("failed to acquire hold: %w", err)
-	}
-	if !acquired {
-		return nil, fmt.Errorf("slot already held")
+		return nil, err
 	}
 
 	// Calculate end time
-	endTime := s.calculateEndTime(req.Slot.StartTime, req.Slot.DurationMinutes)
+	endTime := s.calculateEndTime(slot.StartTime, slot.DurationMinutes)
 	expiresAt := time.Now().Add(time.Duration(s.cfg.HoldTTLMinutes) * time.Minute)
 
 	// Create booking in DB
 	booking := &repository.Booking{
 		ID:            bookingID,
 		VenueID:      req.VenueId,
-		TableID:      req.Table.TableId,
-		Date:         req.Slot.Date,
-		StartTime:    req.Slot.StartTime,
+		TableID:      table.TableId,
+		Date:         slot.Date,
+		StartTime:    slot.StartTime,
 		EndTime:      endTime,
+		DurationMinutes: slot.DurationMinutes,
 		PartySize:    req.PartySize,
 		CustomerName: req.CustomerName,
 		CustomerPhone: req.CustomerPhone,
@@ -89,18 +141,37 @@ func (s *Service) CreateBooking(ctx context.Context, req *bookingpb.CreateBookin
 		Comment:      req.Comment,
 		AdminID:      req.AdminId,
 		ExpiresAt:    &expiresAt,
+		BufferMinutes: buffer,
+	}
+	for _, t := range combined {
+		booking.CombinedTableIDs = append(booking.CombinedTableIDs, t.TableId)
 	}
 
-	if err := s.repo.CreateBooking(ctx, booking); err != nil {
-		s.redis.DeleteHold(ctx, holdKey)
-		return nil, fmt.Errorf("failed to create booking: %w", err)
+	// Pacing is checked again under the lock taken by the insert, bookings made since
+	// the availability check count too
+	pacing := make([]repository.PacingLimit, len(availability.PacingLimits))
+	for i, l := range availability.PacingLimits {
+		pacing[i] = repository.PacingLimit{
+			IntervalStart: l.IntervalStart,
+			IntervalEnd:   l.IntervalEnd,
+			MaxCovers:     l.MaxCovers,
+			MaxBookings:   l.MaxBookings,
+		}
+	}
+
+	if err := s.repo.CreateBooking(ctx, booking, pacing); err != nil {
+		s.releaseHolds(ctx, holdKeys)
+		if errors.Is(err, repository.ErrPacingLimit) {
+			return nil, err
+		}
+		return nil, conflictError(fmt.Errorf("failed to create booking: %w", err))
 	}
 
 	// Add event to outbox
 	event := &commonpb.BookingEvent{
 		BookingId: bookingID,
-		Table:     req.Table,
-		Slot:      req.Slot,
+		Table:     table,
+		Slot:      slot,
 		PartySize: req.PartySize,
 		CustomerName: req.CustomerName,
 		CustomerPhone: req.CustomerPhone,
@@ -118,6 +189,48 @@ func (s *Service) CreateBooking(ctx context.Context, req *bookingpb.CreateBookin
 	return s.toBookingProto(booking), nil
 }
 
+// availableTables checks that the tables a caller named are one of the options
+// venue-svc returned as available for the slot, a single table or a whole
+// combination, and returns them with their rooms filled in. The main table comes
+// first, the rest keep the caller's order
+func availableTables(table *commonpb.TableRef, combined []*commonpb.TableRef, options []*venuepb.TableAvailability, partySize int32) (*commonpb.TableRef, []*commonpb.TableRef, error) {
+	ids := []string{table.GetTableId()}
+	for _, t := range combined {
+		if slices.Contains(ids, t.GetTableId()) {
+			return nil, nil, fmt.Errorf("table %s is listed more than once", t.GetTableId())
+		}
+		ids = append(ids, t.GetTableId())
+	}
+
+	for _, option := range options {
+		refs := option.CombinedTables
+		if len(refs) == 0 {
+			refs = []*commonpb.TableRef{option.Table}
+		}
+		if !option.Available || len(refs) != len(ids) {
+			continue
+		}
+		byID := make(map[string]*commonpb.TableRef, len(refs))
+		for _, ref := range refs {
+			byID[ref.GetTableId()] = ref
+		}
+		matched := make([]*commonpb.TableRef, 0, len(ids))
+		for _, id := range ids {
+			if ref, ok := byID[id]; ok {
+				matched = append(matched, ref)
+			}
+		}
+		if len(matched) == len(ids) {
+			return matched[0], matched[1:], nil
+		}
+	}
+
+	if len(ids) == 1 {
+		return nil, nil, fmt.Errorf("table %s is not available for %d guests at this time", ids[0], partySize)
+	}
+	return nil, nil, fmt.Errorf("tables %s are not an available combination for %d guests at this time", strings.Join(ids, ", "), partySize)
+}
+
 func (s *Service) GetBooking(ctx context.Context, req *bookingpb.GetBookingRequest) (*bookingpb.Booking, error) {
 	booking, err := s.repo.GetBooking(ctx, req.Id)
 	if err != nil {
@@ -128,12 +241,14 @@ func (s *Service) GetBooking(ctx context.Context, req *bookingpb.GetBookingReque
 
 func (s *Service) ListBookings(ctx context.Context, req *bookingpb.ListBookingsRequest) (*bookingpb.ListBookingsResponse, error) {
 	filters := &repository.BookingFilters{
-		VenueID: req.VenueId,
-		Date:    req.Date,
-		Status:  req.Status,
-		TableID: req.TableId,
-		Limit:   req.Limit,
-		Offset:  req.Offset,
+		VenueID:  req.VenueId,
+		Date:     req.Date,
+		DateFrom: req.DateFrom,
+		DateTo:   req.DateTo,
+		Status:   req.Status,
+		TableID:  req.TableId,
+		Limit:    req.Limit,
+		Offset:   req.Offset,
 	}
 
 	bookings, total, err := s.repo.ListBookings(ctx, filters)
@@ -152,6 +267,134 @@ func (s *Service) ListBookings(ctx context.Context, req *bookingpb.ListBookingsR
 	}, nil
 }
 
+func (s *Service) ExportBookings(req *bookingpb.ExportBookingsRequest, stream bookingpb.BookingService_ExportBookingsServer) error {
+	ctx, span := tracing.StartSpan(stream.Context(), "ExportBookings")
+	defer span.End()
+
+	filters := &repository.BookingFilters{
+		VenueID:  req.VenueId,
+		Date:     req.Date,
+		DateFrom: req.DateFrom,
+		DateTo:   req.DateTo,
+		Status:   req.Status,
+		TableID:  req.TableId,
+	}
+
+	var count int
+	err := s.repo.StreamBookings(ctx, filters, func(b *repository.Booking) error {
+		count++
+		return stream.Send(s.toBookingProto(b))
+	})
+	if err != nil {
+		log.Error().Err(err).Str("venue_id", req.VenueId).Int("sent", count).Msg("Failed to export bookings")
+		return fmt.Errorf("failed to export bookings: %w", err)
+	}
+
+	log.Info().Str("venue_id", req.VenueId).Int("count", count).Msg("Bookings exported")
+	return nil
+}
+
+const (
+	defaultSearchLimit      = 20
+	maxSearchLimit          = 100
+	defaultSearchWindowDays = 30
+	minSearchPhoneDigits    = 3
+)
+
+func (s *Service) SearchBookings(ctx context.Context, req *bookingpb.SearchBookingsRequest) (*bookingpb.SearchBookingsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "SearchBookings")
+	defer span.End()
+
+	if req.VenueId == "" {
+		return nil, fmt.Errorf("venue_id is required")
+	}
+
+	name, phone := parseSearchQuery(req.Query)
+	if name == "" && phone == "" {
+		return nil, fmt.Errorf("query must contain a guest name or at least %d phone digits", minSearchPhoneDigits)
+	}
+
+	dateFrom := req.DateFrom
+	if dateFrom == "" {
+		dateFrom = time.Now().Format("2006-01-02")
+	}
+	dateTo := req.DateTo
+	if dateTo == "" {
+		from, err := time.Parse("2006-01-02", dateFrom)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date_from: %w", err)
+		}
+		dateTo = from.AddDate(0, 0, defaultSearchWindowDays).Format("2006-01-02")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	results, err := s.repo.SearchBookings(ctx, &repository.SearchFilters{
+		VenueID:  req.VenueId,
+		Name:     name,
+		Phone:    phone,
+		DateFrom: dateFrom,
+		DateTo:   dateTo,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search bookings: %w", err)
+	}
+
+	hits := make([]*bookingpb.BookingSearchHit, len(results))
+	for i, r := range results {
+		hits[i] = &bookingpb.BookingSearchHit{
+			Booking: s.toBookingProto(r.Booking),
+			Score:   r.Score,
+		}
+	}
+
+	return &bookingpb.SearchBookingsResponse{Hits: hits}, nil
+}
+
+// parseSearchQuery splits a free-form query like "Ivanov, +7 916" into a lower-cased
+// name part and a phone part normalized the same way as bookings.customer_phone_normalized
+func parseSearchQuery(query string) (name, phone string) {
+	var nameBuf, digits strings.Builder
+	plus := false
+	for _, r := range query {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '+':
+			if digits.Len() == 0 {
+				plus = true
+			}
+		case unicode.IsLetter(r):
+			nameBuf.WriteRune(unicode.ToLower(r))
+		case r == '(' || r == ')' || r == '-':
+			// Phone formatting characters
+		default:
+			nameBuf.WriteRune(' ')
+		}
+	}
+
+	name = strings.Join(strings.Fields(nameBuf.String()), " ")
+	phone = digits.String()
+
+	// Russian trunk prefix: "8 916 123 45 67" is the same number as "+7 916 123 45 67".
+	// Partial numbers are left alone, "8912" may as well be part of "+7 8912..."
+	if !plus && len(phone) == 11 && strings.HasPrefix(phone, "8") {
+		phone = "7" + phone[1:]
+	}
+	if len(phone) < minSearchPhoneDigits {
+		phone = ""
+	}
+
+	return name, phone
+}
+
 func (s *Service) ConfirmBooking(ctx context.Context, req *bookingpb.ConfirmBookingRequest) (*bookingpb.Booking, error) {
 	ctx, span := tracing.StartSpan(ctx, "ConfirmBooking")
 	defer span.End()
@@ -171,8 +414,7 @@ func (s *Service) ConfirmBooking(ctx context.Context, req *bookingpb.ConfirmBook
 	}
 
 	// Remove hold from Redis since booking is now confirmed
-	holdKey := s.getHoldKey(booking.VenueID, booking.TableID, booking.Date, booking.StartTime)
-	s.redis.DeleteHold(ctx, holdKey)
+	s.releaseHolds(ctx, s.bookingHoldKeys(booking))
 
 	booking.Status = "confirmed"
 	booking.ExpiresAt = nil
@@ -213,8 +455,7 @@ func (s *Service) CancelBooking(ctx context.Context, req *bookingpb.CancelBookin
 	}
 
 	// Release hold
-	holdKey := s.getHoldKey(booking.VenueID, booking.TableID, booking.Date, booking.StartTime)
-	s.redis.DeleteHold(ctx, holdKey)
+	s.releaseHolds(ctx, s.bookingHoldKeys(booking))
 
 	booking.Status = "cancelled"
 
@@ -275,8 +516,7 @@ func (s *Service) MarkFinished(ctx context.Context, req *bookingpb.MarkFinishedR
 	}
 
 	// Release hold
-	holdKey := s.getHoldKey(booking.VenueID, booking.TableID, booking.Date, booking.StartTime)
-	s.redis.DeleteHold(ctx, holdKey)
+	s.releaseHolds(ctx, s.bookingHoldKeys(booking))
 
 	booking.Status = "finished"
 
@@ -307,8 +547,7 @@ func (s *Service) MarkNoShow(ctx context.Context, req *bookingpb.MarkNoShowReque
 	}
 
 	// Release hold
-	holdKey := s.getHoldKey(booking.VenueID, booking.TableID, booking.Date, booking.StartTime)
-	s.redis.DeleteHold(ctx, holdKey)
+	s.releaseHolds(ctx, s.bookingHoldKeys(booking))
 
 	booking.Status = "no_show"
 
@@ -350,6 +589,11 @@ func (s *Service) processOutbox(ctx context.Context) {
 	}
 
 	for _, msg := range messages {
+		if strings.HasPrefix(msg.Topic, roomHireTopicPrefix) {
+			s.relayRoomHireEvent(ctx, msg)
+			continue
+		}
+
 		var event commonpb.BookingEvent
 		// Try protojson first (new format), fallback to json (old format for backward compatibility)
 		err := protojson.Unmarshal(msg.Payload, &event)
@@ -364,13 +608,10 @@ func (s *Service) processOutbox(ctx context.Context) {
 			log.Warn().Str("id", msg.ID).Msg("Unmarshaled event using legacy json format")
 		}
 
+		event.Headers = withEventID(event.Headers, msg.ID)
 		if err := s.producer.PublishBookingEvent(ctx, msg.Topic, &event); err != nil {
 			log.Error().Err(err).Str("id", msg.ID).Msg("Failed to publish event")
-			if msg.RetryCount >= 3 {
-				s.repo.UpdateOutboxStatus(ctx, msg.ID, "dlq", msg.RetryCount+1)
-			} else {
-				s.repo.UpdateOutboxStatus(ctx, msg.ID, "pending", msg.RetryCount+1)
-			}
+			s.retryOutbox(ctx, msg)
 			continue
 		}
 
@@ -378,6 +619,25 @@ func (s *Service) processOutbox(ctx context.Context) {
 	}
 }
 
+// withEventID makes the outbox message ID the event ID, so a message published
+// again after a failed attempt is recognised as the same event
+func withEventID(headers *commonpb.EventHeaders, outboxID string) *commonpb.EventHeaders {
+	if headers == nil {
+		headers = &commonpb.EventHeaders{}
+	}
+	headers.EventId = outboxID
+	return headers
+}
+
+// retryOutbox leaves a message for the next run, or parks it after too many failures
+func (s *Service) retryOutbox(ctx context.Context, msg *repository.OutboxMessage) {
+	if msg.RetryCount >= 3 {
+		s.repo.UpdateOutboxStatus(ctx, msg.ID, "dlq", msg.RetryCount+1)
+	} else {
+		s.repo.UpdateOutboxStatus(ctx, msg.ID, "pending", msg.RetryCount+1)
+	}
+}
+
 func (s *Service) StartExpiredHoldsWorker(ctx context.Context) {
 	ticker := time.NewTicker(1 * time.Minute)
 	defer ticker.Stop()
@@ -407,8 +667,7 @@ func (s *Service) processExpiredHolds(ctx context.Context) {
 		}
 
 		// Release hold
-		holdKey := s.getHoldKey(booking.VenueID, booking.TableID, booking.Date, booking.StartTime)
-		s.redis.DeleteHold(ctx, holdKey)
+		s.releaseHolds(ctx, s.bookingHoldKeys(booking))
 
 		// Add event to outbox
 		event := &commonpb.BookingEvent{
@@ -426,7 +685,9 @@ func (s *Service) processExpiredHolds(ctx context.Context) {
 	}
 }
 
-func (s *Service) addToOutbox(ctx context.Context, topic, key string, event *commonpb.BookingEvent) error {
+// addToOutbox stores a booking or room hire event in the outbox. Rows are protojson,
+// the relay decodes them the same way before publishing
+func (s *Service) addToOutbox(ctx context.Context, topic, key string, event proto.Message) error {
 	data, err := protojson.Marshal(event)
 	if err != nil {
 		return err
@@ -434,15 +695,76 @@ func (s *Service) addToOutbox(ctx context.Context, topic, key string, event *com
 	return s.repo.AddToOutbox(ctx, topic, key, data)
 }
 
-func (s *Service) getHoldKey(venueID, tableID, date, startTime string) string {
-	return fmt.Sprintf("hold:%s:%s:%s:%s", venueID, tableID, date, startTime)
+// conflictError reports a booking rejected by the overlap trigger as aborted, so the
+// gateway answers 409 and the caller can pick other tables
+func conflictError(err error) error {
+	if errors.Is(err, repository.ErrBookingOverlap) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return err
+}
+
+// holdBucketMinutes is the step of hold keys. A booking holds every bucket its tables
+// are busy in, from the start to the end of the buffer, so bookings whose busy times
+// overlap on a table always share a key
+const holdBucketMinutes = 5
+
+// tableHoldKeys lists hold keys of a table busy for the given minutes from start
+func tableHoldKeys(venueID, tableID, date string, start, busy int) []string {
+	var keys []string
+	for m := start / holdBucketMinutes * holdBucketMinutes; m < start+busy; m += holdBucketMinutes {
+		keys = append(keys, fmt.Sprintf("hold:%s:%s:%s:%d", venueID, tableID, date, m))
+	}
+	return keys
+}
+
+// acquireHolds takes Redis holds on each table for the slot and the buffer after it.
+// Either all holds are taken or none
+func (s *Service) acquireHolds(ctx context.Context, bookingID, venueID string, tables []*commonpb.TableRef, slot *commonpb.Slot, bufferMinutes int32) ([]string, error) {
+	start, err := clockMinutes(slot.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	var holdKeys []string
+	for _, t := range tables {
+		holdKeys = append(holdKeys, tableHoldKeys(venueID, t.TableId, slot.Date, start, int(slot.DurationMinutes+bufferMinutes))...)
+	}
+
+	acquired, err := s.redis.SetHolds(ctx, holdKeys, bookingID, time.Duration(s.cfg.HoldTTLMinutes)*time.Minute)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire hold: %w", err)
+	}
+	if !acquired {
+		return nil, status.Error(codes.Aborted, "slot already held")
+	}
+	return holdKeys, nil
+}
+
+// bookingHoldKeys lists hold keys of all tables occupied by a booking
+func (s *Service) bookingHoldKeys(b *repository.Booking) []string {
+	start, err := clockMinutes(b.StartTime)
+	if err != nil {
+		return nil
+	}
+	var keys []string
+	for _, tableID := range append([]string{b.TableID}, b.CombinedTableIDs...) {
+		keys = append(keys, tableHoldKeys(b.VenueID, tableID, b.Date, start, int(b.DurationMinutes+b.BufferMinutes))...)
+	}
+	return keys
+}
+
+func (s *Service) releaseHolds(ctx context.Context, holdKeys []string) {
+	if err := s.redis.DeleteHolds(ctx, holdKeys); err != nil {
+		log.Warn().Err(err).Msg("Failed to release holds")
+	}
 }
 
 func (s *Service) CheckTableAvailability(ctx context.Context, req *bookingpb.CheckTableAvailabilityRequest) (*bookingpb.CheckTableAvailabilityResponse, error) {
 	ctx, span := tracing.StartSpan(ctx, "CheckTableAvailability")
 	defer span.End()
 
-	// Calculate end time (default to 120 minutes if not specified)
+	// Calculate end time. venue-svc resolves the turn time before calling,
+	// 120 minutes is only a fallback for requests without a duration
 	durationMinutes := req.Slot.DurationMinutes
 	if durationMinutes == 0 {
 		durationMinutes = 120
@@ -450,7 +772,7 @@ func (s *Service) CheckTableAvailability(ctx context.Context, req *bookingpb.Che
 	endTime := s.calculateEndTime(req.Slot.StartTime, durationMinutes)
 
 	// Check availability
-	availability, err := s.repo.CheckTableAvailability(ctx, req.VenueId, req.TableIds, req.Slot.Date, req.Slot.StartTime, endTime)
+	availability, err := s.repo.CheckTableAvailability(ctx, req.VenueId, req.TableIds, req.BufferMinutes, req.Slot.Date, req.Slot.StartTime, endTime)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check table availability: %w", err)
 	}
@@ -501,11 +823,16 @@ func (s *Service) toBookingProto(b *repository.Booking) *bookingpb.Booking {
 		expiresAt = b.ExpiresAt.Unix()
 	}
 
+	var combinedTables []*commonpb.TableRef
+	for _, id := range b.CombinedTableIDs {
+		combinedTables = append(combinedTables, &commonpb.TableRef{VenueId: b.VenueID, TableId: id})
+	}
+
 	return &bookingpb.Booking{
 		Id:            b.ID,
 		VenueId:      b.VenueID,
 		Table:        &commonpb.TableRef{TableId: b.TableID},
-		Slot:         &commonpb.Slot{Date: b.Date, StartTime: b.StartTime},
+		Slot:         &commonpb.Slot{Date: b.Date, StartTime: b.StartTime, DurationMinutes: b.DurationMinutes},
 		PartySize:    b.PartySize,
 		CustomerName: b.CustomerName,
 		CustomerPhone: b.CustomerPhone,
@@ -515,6 +842,11 @@ func (s *Service) toBookingProto(b *repository.Booking) *bookingpb.Booking {
 		CreatedAt:    b.CreatedAt.Unix(),
 		UpdatedAt:    b.UpdatedAt.Unix(),
 		ExpiresAt:    expiresAt,
+		Sequence:     b.Sequence,
+		CombinedTables: combinedTables,
+		BufferMinutes: b.BufferMinutes,
+		NeedsAttention: b.NeedsAttention,
+		AttentionReason: b.AttentionReason,
 	}
 }
 
@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"booker/cmd/booking-svc/repository"
+	bookingpb "booker/pkg/proto/booking"
+	commonpb "booker/pkg/proto/common"
+	venuepb "booker/pkg/proto/venue"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		name  string
+		phone string
+	}{
+		{"Ivanov, +7 916", "ivanov", "7916"},
+		{"Иванов", "иванов", ""},
+		{"+7 (916) 123-45-67", "", "79161234567"},
+		{"8 916 123 45 67", "", "79161234567"},
+		{"8916", "", "8916"},
+		{"+7 8912", "", "78912"},
+		{"4567", "", "4567"},
+		{"Anna Maria 12", "anna maria", ""},
+		{"  ", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			name, phone := parseSearchQuery(tt.query)
+			assert.Equal(t, tt.name, name)
+			assert.Equal(t, tt.phone, phone)
+		})
+	}
+}
+
+func TestValidateImportRow(t *testing.T) {
+	resolver := newTableResolver([]*venuepb.Table{
+		{Id: "t1", Name: "Table 1", Capacity: 4},
+		{Id: "t2", Name: "Window", Capacity: 2},
+		{Id: "t3", Name: "window", Capacity: 6},
+	})
+	valid := func() *bookingpb.ImportBookingRow {
+		return &bookingpb.ImportBookingRow{
+			Line:         2,
+			ExternalRef:  "ext-1",
+			TableName:    " table 1 ",
+			Slot:         &commonpb.Slot{Date: "2030-05-01", StartTime: "19:30", DurationMinutes: 90},
+			PartySize:    3,
+			CustomerName: "Ivanov",
+		}
+	}
+
+	c, err := validateImportRow(valid(), resolver, "2030-01-01")
+	require.NoError(t, err)
+	assert.Equal(t, "t1", c.booking.TableID)
+	assert.Equal(t, "21:00", c.booking.EndTime)
+	assert.Equal(t, "confirmed", c.booking.Status)
+	assert.Equal(t, 19*60+30, c.start)
+
+	tests := []struct {
+		name   string
+		modify func(r *bookingpb.ImportBookingRow)
+		errMsg string
+	}{
+		{"missing ref", func(r *bookingpb.ImportBookingRow) { r.ExternalRef = "" }, "external_ref is required"},
+		{"unknown table", func(r *bookingpb.ImportBookingRow) { r.TableName = "Bar" }, "not found"},
+		{"ambiguous table", func(r *bookingpb.ImportBookingRow) { r.TableName = "WINDOW" }, "ambiguous"},
+		{"too many guests", func(r *bookingpb.ImportBookingRow) { r.PartySize = 5 }, "does not fit"},
+		{"past date", func(r *bookingpb.ImportBookingRow) { r.Slot.Date = "2029-12-31" }, "in the past"},
+		{"bad time", func(r *bookingpb.ImportBookingRow) { r.Slot.StartTime = "7pm" }, "invalid start_time"},
+		{"past midnight", func(r *bookingpb.ImportBookingRow) { r.Slot.StartTime = "23:00" }, "before midnight"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := valid()
+			tt.modify(row)
+			_, err := validateImportRow(row, resolver, "2030-01-01")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestFindOverlap(t *testing.T) {
+	existing := []*importCandidate{{start: 18 * 60, end: 20 * 60}}
+
+	assert.NotNil(t, findOverlap(existing, &importCandidate{start: 19 * 60, end: 21 * 60}))
+	assert.Nil(t, findOverlap(existing, &importCandidate{start: 20 * 60, end: 22 * 60}))
+	assert.Nil(t, findOverlap(existing, &importCandidate{start: 16 * 60, end: 18 * 60}))
+
+	// A 15 minute buffer after either booking keeps the table busy
+	existing[0].buffer = 15
+	assert.NotNil(t, findOverlap(existing, &importCandidate{start: 20 * 60, end: 22 * 60}))
+	assert.NotNil(t, findOverlap(existing, &importCandidate{start: 16 * 60, end: 18 * 60, buffer: 15}))
+	assert.Nil(t, findOverlap(existing, &importCandidate{start: 20*60 + 15, end: 22 * 60}))
+}
+
+func TestLayoutProblem(t *testing.T) {
+	tables := map[string]*venuepb.Table{
+		"t1": {Id: "t1", Capacity: 4},
+		"t2": {Id: "t2", Capacity: 2},
+		"t3": {Id: "t3", Capacity: 4},
+	}
+
+	assert.Empty(t, layoutProblem(&repository.Booking{TableID: "t1", PartySize: 4}, tables, nil))
+	assert.Equal(t, "table t9 was removed", layoutProblem(&repository.Booking{TableID: "t9", PartySize: 2}, tables, nil))
+	assert.Equal(t, "tables seat 2 guests, party of 3", layoutProblem(&repository.Booking{TableID: "t2", PartySize: 3}, tables, nil))
+
+	// Combined tables count together, and every one of them must still exist
+	assert.Empty(t, layoutProblem(&repository.Booking{TableID: "t1", CombinedTableIDs: []string{"t2"}, PartySize: 6}, tables, nil))
+	assert.Equal(t, "table t9 was removed",
+		layoutProblem(&repository.Booking{TableID: "t1", CombinedTableIDs: []string{"t9"}, PartySize: 6}, tables, nil))
+}
+
+func TestLayoutProblem_Combination(t *testing.T) {
+	tables := map[string]*venuepb.Table{
+		"t1": {Id: "t1", Capacity: 4},
+		"t2": {Id: "t2", Capacity: 2},
+		"t3": {Id: "t3", Capacity: 4},
+	}
+	combinations := []*venuepb.TableCombination{
+		{Id: "c1", TableIds: []string{"t2", "t1"}, MaxCapacity: 5},
+		{Id: "c2", TableIds: []string{"t1", "t3"}, MaxCapacity: 10},
+		{Id: "c3", TableIds: []string{"t2", "t3"}},
+	}
+
+	// Joining t1 and t2 loses a seat, the combination seats 5 and not 6
+	assert.Equal(t, "tables seat 5 guests, party of 6",
+		layoutProblem(&repository.Booking{TableID: "t1", CombinedTableIDs: []string{"t2"}, PartySize: 6}, tables, combinations))
+	assert.Empty(t, layoutProblem(&repository.Booking{TableID: "t2", CombinedTableIDs: []string{"t1"}, PartySize: 5}, tables, combinations))
+
+	// A combination may seat more than its tables on their own
+	assert.Empty(t, layoutProblem(&repository.Booking{TableID: "t1", CombinedTableIDs: []string{"t3"}, PartySize: 10}, tables, combinations))
+
+	// Without max_capacity the tables add up
+	assert.Equal(t, "tables seat 6 guests, party of 7",
+		layoutProblem(&repository.Booking{TableID: "t3", CombinedTableIDs: []string{"t2"}, PartySize: 7}, tables, combinations))
+}
+
+func TestScheduleProblem(t *testing.T) {
+	booking := &repository.Booking{Date: "2026-12-31", StartTime: "19:00:00", EndTime: "21:00:00"}
+
+	assert.Empty(t, scheduleProblem(booking, &venuepb.DaySchedule{OpenTime: "12:00", CloseTime: "23:00"}))
+	assert.Equal(t, "venue is closed on 2026-12-31", scheduleProblem(booking, &venuepb.DaySchedule{Closed: true}))
+	assert.Equal(t, "booking 19:00-21:00 is outside opening hours 12:00-20:00",
+		scheduleProblem(booking, &venuepb.DaySchedule{OpenTime: "12:00", CloseTime: "20:00"}))
+
+	// Ending at midnight fits a venue open until 24:00
+	late := &repository.Booking{Date: "2026-12-31", StartTime: "22:00:00", EndTime: "00:00:00"}
+	assert.Empty(t, scheduleProblem(late, &venuepb.DaySchedule{OpenTime: "12:00", CloseTime: "24:00"}))
+	assert.NotEmpty(t, scheduleProblem(late, &venuepb.DaySchedule{OpenTime: "12:00", CloseTime: "23:00"}))
+}
+
+func TestInZone(t *testing.T) {
+	tables := map[string]*venuepb.Table{
+		"t1": {Id: "t1", Zone: "terrace"},
+		"t2": {Id: "t2", Zone: "hall"},
+	}
+	refs := func(ids ...string) []*commonpb.TableRef {
+		out := make([]*commonpb.TableRef, len(ids))
+		for i, id := range ids {
+			out[i] = &commonpb.TableRef{TableId: id}
+		}
+		return out
+	}
+
+	assert.True(t, inZone(refs("t1"), tables, "Terrace"))
+	assert.False(t, inZone(refs("t1", "t2"), tables, "terrace"))
+	assert.True(t, inZone(refs("t1", "t2"), tables, ""))
+}
+
+func TestValidateRoomHire(t *testing.T) {
+	valid := func() *bookingpb.CreateRoomHireRequest {
+		return &bookingpb.CreateRoomHireRequest{
+			VenueId:      "venue",
+			RoomId:       "hall",
+			Slot:         &commonpb.Slot{Date: "2026-03-06", StartTime: "18:00", DurationMinutes: 240},
+			EventName:    "Corporate party",
+			Headcount:    40,
+			ContactName:  "Anna",
+			ContactEmail: "anna@example.com",
+		}
+	}
+	require.NoError(t, validateRoomHire(valid()))
+
+	tests := map[string]func(*bookingpb.CreateRoomHireRequest){
+		"no room":       func(r *bookingpb.CreateRoomHireRequest) { r.RoomId = "" },
+		"no event name": func(r *bookingpb.CreateRoomHireRequest) { r.EventName = " " },
+		"no headcount":  func(r *bookingpb.CreateRoomHireRequest) { r.Headcount = 0 },
+		"no contact":    func(r *bookingpb.CreateRoomHireRequest) { r.ContactEmail = "" },
+		"bad date":      func(r *bookingpb.CreateRoomHireRequest) { r.Slot.Date = "06.03.2026" },
+		"bad start":     func(r *bookingpb.CreateRoomHireRequest) { r.Slot.StartTime = "evening" },
+		"no duration":   func(r *bookingpb.CreateRoomHireRequest) { r.Slot.DurationMinutes = 0 },
+		"over a day":    func(r *bookingpb.CreateRoomHireRequest) { r.Slot.DurationMinutes = 24*60 + 1 },
+	}
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := valid()
+			mutate(req)
+			assert.Error(t, validateRoomHire(req))
+		})
+	}
+}
+
+func TestRoomTableIDs(t *testing.T) {
+	tables := []*venuepb.Table{
+		{Id: "t1", RoomId: "hall"},
+		{Id: "t2", RoomId: "terrace"},
+		{Id: "t3", RoomId: "hall"},
+		{Id: "t4", RoomId: "hall", DeletedAt: 1},
+	}
+	ids := roomTableIDs(tables, "hall")
+	assert.Equal(t, []string{"t1", "t3"}, ids)
+	assert.True(t, sameTableSet(ids, []string{"t3", "t1"}))
+	assert.False(t, sameTableSet(ids, []string{"t1", "t2"}))
+	assert.Equal(t, []string{}, roomTableIDs(tables, "bar"))
+}
+
+func TestAvailableTables(t *testing.T) {
+	ref := func(id string) *commonpb.TableRef { return &commonpb.TableRef{RoomId: "room-1", TableId: id} }
+	options := []*venuepb.TableAvailability{
+		{Table: ref("t1"), Available: true, Capacity: 4},
+		{Table: ref("t1"), Available: true, Capacity: 8, CombinedTables: []*commonpb.TableRef{ref("t1"), ref("t2")}},
+		{Table: ref("t3"), Available: true, Capacity: 10, CombinedTables: []*commonpb.TableRef{ref("t3"), ref("t4"), ref("t5")}},
+	}
+
+	t.Run("combination", func(t *testing.T) {
+		table, combined, err := availableTables(&commonpb.TableRef{TableId: "t5"}, []*commonpb.TableRef{{TableId: "t3"}, {TableId: "t4"}}, options, 9)
+		require.NoError(t, err)
+		assert.Equal(t, "t5", table.TableId, "the caller's main table stays first")
+		assert.Equal(t, "room-1", table.RoomId)
+		require.Len(t, combined, 2)
+		assert.Equal(t, "t3", combined[0].TableId)
+		assert.Equal(t, "t4", combined[1].TableId)
+	})
+
+	t.Run("part of a combination", func(t *testing.T) {
+		_, _, err := availableTables(ref("t3"), []*commonpb.TableRef{ref("t4")}, options, 6)
+		assert.ErrorContains(t, err, "not an available combination")
+	})
+
+	t.Run("tables that are no combination", func(t *testing.T) {
+		_, _, err := availableTables(ref("t1"), []*commonpb.TableRef{ref("t3")}, options, 6)
+		assert.Error(t, err)
+	})
+
+	t.Run("duplicate table", func(t *testing.T) {
+		_, _, err := availableTables(ref("t1"), []*commonpb.TableRef{ref("t1")}, options, 6)
+		assert.ErrorContains(t, err, "more than once")
+	})
+}
+
+// availabilityVenueClient answers CheckAvailability with a fixed response
+type availabilityVenueClient struct {
+	venuepb.VenueServiceClient
+	availability *venuepb.CheckAvailabilityResponse
+}
+
+func (c *availabilityVenueClient) CheckAvailability(context.Context, *venuepb.CheckAvailabilityRequest, ...grpc.CallOption) (*venuepb.CheckAvailabilityResponse, error) {
+	return c.availability, nil
+}
+
+func TestCreateBooking_BlockedTable(t *testing.T) {
+	// t2 is blocked for the slot, so venue-svc only offers t1
+	venue := &availabilityVenueClient{availability: &venuepb.CheckAvailabilityResponse{
+		Tables: []*venuepb.TableAvailability{
+			{Table: &commonpb.TableRef{VenueId: "venue-1", TableId: "t1"}, Available: true, Capacity: 4},
+		},
+	}}
+	s := New(nil, nil, venue, nil, nil)
+
+	_, err := s.CreateBooking(context.Background(), &bookingpb.CreateBookingRequest{
+		VenueId:   "venue-1",
+		Table:     &commonpb.TableRef{VenueId: "venue-1", TableId: "t2"},
+		Slot:      &commonpb.Slot{Date: "2099-03-06", StartTime: "19:00", DurationMinutes: 120},
+		PartySize: 2,
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "table t2 is not available")
+}
+
+func TestTableHoldKeys(t *testing.T) {
+	// 19:00 for 60 minutes with a 10 minute buffer holds the table until 20:10
+	keys := tableHoldKeys("venue-1", "t1", "2099-03-06", 19*60, 70)
+	require.Len(t, keys, 14)
+	assert.Equal(t, "hold:venue-1:t1:2099-03-06:1140", keys[0])
+	assert.Equal(t, "hold:venue-1:t1:2099-03-06:1205", keys[13])
+
+	// A booking at 20:05 starts inside the buffer and shares a key, one at 20:10 does not
+	assert.Contains(t, keys, tableHoldKeys("venue-1", "t1", "2099-03-06", 20*60+5, 60)[0])
+	assert.NotContains(t, keys, tableHoldKeys("venue-1", "t1", "2099-03-06", 20*60+10, 60)[0])
+
+	// Starts off the bucket grid still cover their first minute
+	assert.Equal(t, "hold:venue-1:t1:2099-03-06:1140", tableHoldKeys("venue-1", "t1", "2099-03-06", 19*60+3, 30)[0])
+}
+
+func TestConflictError(t *testing.T) {
+	err := conflictError(fmt.Errorf("failed to create booking: %w", repository.ErrBookingOverlap))
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "already booked")
+
+	assert.Equal(t, codes.Unknown, status.Code(conflictError(errors.New("connection refused"))))
+}
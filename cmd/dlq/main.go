@@ -0,0 +1,100 @@
+// Command dlq inspects dead letter topics and replays their messages.
+//
+//	dlq list -topic booking.confirmed
+//	dlq replay -topic booking.confirmed -partition 0 -offset 12
+//	dlq replay -topic booking.confirmed -all
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"booker/pkg/kafka"
+)
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	flags := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+	brokers := flags.String("brokers", getEnv("KAFKA_BROKERS", "localhost:19092"), "comma-separated Kafka brokers")
+	topic := flags.String("topic", "", "dead letter topic, or the topic it belongs to")
+	partition := flags.Int("partition", -1, "partition of the message to replay")
+	offset := flags.Int64("offset", -1, "offset of the message to replay")
+	all := flags.Bool("all", false, "replay every message of the topic")
+	showValue := flags.Bool("value", false, "print message values")
+	flags.Parse(os.Args[2:])
+
+	if *topic == "" {
+		fmt.Fprintln(os.Stderr, "-topic is required")
+		os.Exit(2)
+	}
+
+	dlq, err := kafka.NewDeadLetters(strings.Split(*brokers, ","), kafka.DefaultRetryStages)
+	if err != nil {
+		fail(err)
+	}
+	defer dlq.Close()
+
+	letters, err := dlq.List(*topic)
+	if err != nil {
+		fail(err)
+	}
+
+	switch os.Args[1] {
+	case "list":
+		for _, l := range letters {
+			fmt.Printf("%s/%d/%d key=%s group=%s from=%s/%d/%d attempts=%d failed_at=%s\n  error: %s\n",
+				l.Topic, l.Partition, l.Offset, l.Key, l.ConsumerGroup,
+				l.OriginalTopic, l.OriginalPartition, l.OriginalOffset, l.Attempts, l.FailedAt.Format("2006-01-02 15:04:05"), l.Error)
+			if *showValue {
+				fmt.Printf("  value: %s\n", l.Value)
+			}
+		}
+		fmt.Printf("%d message(s)\n", len(letters))
+
+	case "replay":
+		if !*all && (*partition < 0 || *offset < 0) {
+			fmt.Fprintln(os.Stderr, "either -partition and -offset or -all is required")
+			os.Exit(2)
+		}
+		replayed := 0
+		for _, l := range letters {
+			if !*all && (l.Partition != int32(*partition) || l.Offset != *offset) {
+				continue
+			}
+			to, err := dlq.Replay(l)
+			if err != nil {
+				fail(err)
+			}
+			fmt.Printf("%s/%d/%d replayed to %s\n", l.Topic, l.Partition, l.Offset, to)
+			replayed++
+		}
+		if replayed == 0 {
+			fail(fmt.Errorf("no matching message"))
+		}
+
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: dlq list|replay -topic <topic> [-partition N -offset N | -all] [-value]")
+	os.Exit(2)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
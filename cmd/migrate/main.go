@@ -8,6 +8,39 @@ import (
 	_ "github.com/jackc/pgx/v5/stdlib"
 )
 
+// Migrations are applied in order on every run, so each file must be idempotent
+var (
+	venueMigrations = []string{
+		"001_venue_schema.sql",
+		"006_venue_floor_plan.sql",
+		"007_venue_table_combinations.sql",
+		"010_venue_turn_time_rules.sql",
+		"011_venue_pacing_rules.sql",
+		"012_venue_buffer_time.sql",
+		"015_venue_soft_delete.sql",
+		"016_venue_table_blocks.sql",
+	}
+	bookingMigrations = []string{
+		"002_booking_schema.sql",
+		"003_booking_search.sql",
+		"004_booking_sequence.sql",
+		"005_booking_import.sql",
+		"008_booking_combined_tables.sql",
+		"009_booking_duration.sql",
+		"013_booking_buffer_time.sql",
+		"014_booking_needs_attention.sql",
+		"017_booking_room_hires.sql",
+	}
+	notifyMigrations = []string{
+		"018_notify_schema.sql",
+		"019_notify_templates.sql",
+		"020_notify_reminders.sql",
+		"021_notify_processed_events.sql",
+		"022_notify_webhooks.sql",
+		"023_notify_reminder_attempts.sql",
+	}
+)
+
 func main() {
 	// Venue DB
 	venueDSN := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
@@ -21,12 +54,7 @@ func main() {
 	defer venueDB.Close()
 
 	// Read and execute venue migrations
-	venueSQL, err := os.ReadFile("../../migrations/001_venue_schema.sql")
-	if err != nil {
-		panic(err)
-	}
-
-	if _, err := venueDB.Exec(string(venueSQL)); err != nil {
+	if err := applyMigrations(venueDB, venueMigrations); err != nil {
 		panic(err)
 	}
 
@@ -44,14 +72,40 @@ func main() {
 	defer bookingDB.Close()
 
 	// Read and execute booking migrations
-	bookingSQL, err := os.ReadFile("../../migrations/002_booking_schema.sql")
+	if err := applyMigrations(bookingDB, bookingMigrations); err != nil {
+		panic(err)
+	}
+
+	fmt.Println("Booking migrations applied")
+
+	// Notify DB
+	notifyDSN := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		os.Getenv("NOTIFY_DB_USER"), os.Getenv("NOTIFY_DB_PASSWORD"),
+		os.Getenv("NOTIFY_DB_HOST"), os.Getenv("NOTIFY_DB_PORT"), os.Getenv("NOTIFY_DB_NAME"))
+
+	notifyDB, err := sql.Open("pgx", notifyDSN)
 	if err != nil {
 		panic(err)
 	}
+	defer notifyDB.Close()
 
-	if _, err := bookingDB.Exec(string(bookingSQL)); err != nil {
+	if err := applyMigrations(notifyDB, notifyMigrations); err != nil {
 		panic(err)
 	}
 
-	fmt.Println("Booking migrations applied")
+	fmt.Println("Notify migrations applied")
+}
+
+func applyMigrations(db *sql.DB, files []string) error {
+	for _, file := range files {
+		migrationSQL, err := os.ReadFile("../../migrations/" + file)
+		if err != nil {
+			return err
+		}
+
+		if _, err := db.Exec(string(migrationSQL)); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+	}
+	return nil
 }
@@ -0,0 +1,142 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+type Config struct {
+	Port             int
+	Env              string
+	PostgresHost     string
+	PostgresPort     int
+	PostgresDB       string
+	PostgresUser     string
+	PostgresPassword string
+	KafkaBrokers     string
+	GRPCBookingAddr  string
+	GRPCVenueAddr    string
+	JaegerEndpoint   string
+	// Channels of venues that have not chosen their own
+	DefaultChannels []string
+	// Locale of venues that have not chosen their own
+	DefaultLocale string
+	// Minutes before the slot guests are reminded, for venues that have not chosen their own
+	ReminderOffsets []int32
+	// Seconds between looks for due reminders and attempts before a reminder fails
+	ReminderInterval int
+	ReminderAttempts int
+	// Days processed event IDs are kept for deduplication
+	EventKeepDays int
+	// Guest self-service links in booking messages, left out without a secret.
+	// The secret and URL are the ones of admin-gateway
+	GuestLinkSecret string
+	GuestLinkHours  int
+	PublicURL       string
+	// Outbound webhooks: seconds between looks for due deliveries, attempts before
+	// a delivery fails, the request timeout in seconds and how many webhooks are
+	// sent to at a time. Private and loopback targets are refused unless allowed,
+	// which is meant for local development only
+	WebhookInterval int
+	WebhookAttempts int
+	WebhookTimeout  int
+	WebhookWorkers  int
+	WebhookPrivate  bool
+
+	// SMTP email, disabled without a host
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUser     string
+	SMTPPassword string
+	SMTPFrom     string
+
+	// Generic HTTP SMS gateway, disabled without a URL
+	SMSGatewayURL string
+	SMSAPIKey     string
+	SMSSender     string
+
+	// Telegram Bot API, disabled without a token
+	TelegramBotToken string
+	TelegramAPIURL   string
+}
+
+func Load() *Config {
+	return &Config{
+		Port:             getEnvInt("PORT", 50053),
+		Env:              getEnv("ENV", "development"),
+		PostgresHost:     getEnv("POSTGRES_HOST", "localhost"),
+		PostgresPort:     getEnvInt("POSTGRES_PORT", 5432),
+		PostgresDB:       getEnv("POSTGRES_DB", "notify"),
+		PostgresUser:     getEnv("POSTGRES_USER", "notify_user"),
+		PostgresPassword: getEnv("POSTGRES_PASSWORD", "notify_pass"),
+		KafkaBrokers:     getEnv("KAFKA_BROKERS", "localhost:9092"),
+		GRPCBookingAddr:  getEnv("GRPC_BOOKING_ADDR", "localhost:50052"),
+		GRPCVenueAddr:    getEnv("GRPC_VENUE_ADDR", "localhost:50051"),
+		JaegerEndpoint:   getEnv("JAEGER_ENDPOINT", "http://localhost:14268/api/traces"),
+		DefaultChannels:  getEnvList("DEFAULT_CHANNELS", "sms"),
+		DefaultLocale:    getEnv("DEFAULT_LOCALE", "ru"),
+		ReminderOffsets:  getEnvInt32List("REMINDER_OFFSETS", "1440,120"),
+		ReminderInterval: getEnvInt("REMINDER_POLL_SECONDS", 30),
+		ReminderAttempts: getEnvInt("REMINDER_MAX_ATTEMPTS", 5),
+		EventKeepDays:    getEnvInt("PROCESSED_EVENTS_RETENTION_DAYS", 30),
+		GuestLinkSecret:  getEnv("GUEST_LINK_SECRET", ""),
+		GuestLinkHours:   getEnvInt("GUEST_LINK_TTL_HOURS", 720),
+		PublicURL:        getEnv("PUBLIC_URL", "http://localhost:18080"),
+		WebhookInterval:  getEnvInt("WEBHOOK_POLL_SECONDS", 5),
+		WebhookAttempts:  getEnvInt("WEBHOOK_MAX_ATTEMPTS", 10),
+		WebhookTimeout:   getEnvInt("WEBHOOK_TIMEOUT_SECONDS", 10),
+		WebhookWorkers:   getEnvInt("WEBHOOK_CONCURRENCY", 8),
+		WebhookPrivate:   getEnv("WEBHOOK_ALLOW_PRIVATE", "false") == "true",
+		SMTPHost:         getEnv("SMTP_HOST", ""),
+		SMTPPort:         getEnvInt("SMTP_PORT", 587),
+		SMTPUser:         getEnv("SMTP_USER", ""),
+		SMTPPassword:     getEnv("SMTP_PASSWORD", ""),
+		SMTPFrom:         getEnv("SMTP_FROM", "noreply@booker.local"),
+		SMSGatewayURL:    getEnv("SMS_GATEWAY_URL", ""),
+		SMSAPIKey:        getEnv("SMS_API_KEY", ""),
+		SMSSender:        getEnv("SMS_SENDER", "Booker"),
+		TelegramBotToken: getEnv("TELEGRAM_BOT_TOKEN", ""),
+		TelegramAPIURL:   getEnv("TELEGRAM_API_URL", "https://api.telegram.org"),
+	}
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		var result int
+		if _, err := fmt.Sscanf(value, "%d", &result); err == nil {
+			return result
+		}
+	}
+	return defaultValue
+}
+
+// getEnvList reads a comma-separated list
+func getEnvList(key, defaultValue string) []string {
+	var list []string
+	for _, item := range strings.Split(getEnv(key, defaultValue), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// getEnvInt32List reads a comma-separated list of numbers, skipping invalid ones
+func getEnvInt32List(key, defaultValue string) []int32 {
+	var list []int32
+	for _, item := range getEnvList(key, defaultValue) {
+		var value int32
+		if _, err := fmt.Sscanf(item, "%d", &value); err == nil {
+			list = append(list, value)
+		}
+	}
+	return list
+}
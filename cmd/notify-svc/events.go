@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	"booker/cmd/notify-svc/service"
+	"booker/pkg/kafka"
+	commonpb "booker/pkg/proto/common"
+)
+
+const (
+	consumerGroup        = "notify-svc-group"
+	webhookConsumerGroup = "notify-svc-webhooks"
+)
+
+var notificationTopics = []string{"booking.confirmed", "booking.cancelled", "booking.no_show", "booking.moved",
+	"room_hire.confirmed", "room_hire.cancelled"}
+
+// BookingEventHandler notifies guests about booking and room hire events and keeps
+// booking reminders up to date. It is run by kafka.RetryHandler, which retries
+// failed events through the retry topics. Events of one booking or room hire are
+// handled one at a time, even when they come from different topics
+type BookingEventHandler struct {
+	svc   *service.Service
+	locks [64]sync.Mutex
+}
+
+func (h *BookingEventHandler) Handle(ctx context.Context, message *sarama.ConsumerMessage) error {
+	event, err := decodeEvent(message)
+	if err != nil {
+		return kafka.Permanent(err)
+	}
+
+	log.Info().
+		Str("topic", event.Topic).
+		Str("key", event.Key).
+		Str("event_id", event.ID).
+		Msg("Received booking event")
+
+	lock := h.lock(event.Key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	return h.svc.HandleEvent(ctx, event)
+}
+
+// lock returns the mutex events with the key are handled under
+func (h *BookingEventHandler) lock(key string) *sync.Mutex {
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+	return &h.locks[hash.Sum32()%uint32(len(h.locks))]
+}
+
+// decodeEvent reads the event ID, key and reason of a message. Messages published
+// before events carried an ID get one from their position in the log
+func decodeEvent(message *sarama.ConsumerMessage) (*service.Event, error) {
+	event := &service.Event{Topic: message.Topic, Key: string(message.Key)}
+
+	var id, key string
+	if strings.HasPrefix(message.Topic, "room_hire.") {
+		hire, err := kafka.DecodeRoomHireEvent(message.Value)
+		if err != nil {
+			return nil, err
+		}
+		id, key, event.Reason = hire.GetHeaders().GetEventId(), hire.RoomHireId, hire.Reason
+	} else {
+		booking, err := kafka.DecodeBookingEvent(message.Value)
+		if err != nil {
+			return nil, err
+		}
+		id, key = booking.GetHeaders().GetEventId(), booking.BookingId
+		switch {
+		case booking.GetCancelled() != nil:
+			event.Reason = booking.GetCancelled().Reason
+		case booking.GetMoved() != nil:
+			event.Reason = booking.GetMoved().Reason
+		}
+	}
+
+	if key != "" {
+		event.Key = key
+	}
+	if event.Key == "" {
+		return nil, fmt.Errorf("event has no booking or room hire ID")
+	}
+	event.ID = id
+	if event.ID == "" {
+		event.ID = fmt.Sprintf("%s/%d/%d", message.Topic, message.Partition, message.Offset)
+	}
+	return event, nil
+}
+
+// WebhookEventHandler queues webhook deliveries of booking and room hire events.
+// It runs in a consumer group of its own, so webhooks neither wait for guest
+// notifications nor make them retry
+type WebhookEventHandler struct {
+	svc *service.Service
+}
+
+func (h *WebhookEventHandler) Handle(ctx context.Context, message *sarama.ConsumerMessage) error {
+	event, err := decodeWebhookEvent(message)
+	if err != nil {
+		return kafka.Permanent(err)
+	}
+	return h.svc.HandleWebhookEvent(ctx, event)
+}
+
+// decodeWebhookEvent reads an event as webhooks pass it on: without the headers,
+// whose ID and time become the webhook event's own
+func decodeWebhookEvent(message *sarama.ConsumerMessage) (*service.WebhookEvent, error) {
+	event := &service.WebhookEvent{Type: message.Topic, CreatedAt: message.Timestamp}
+
+	var headers *commonpb.EventHeaders
+	if strings.HasPrefix(message.Topic, "room_hire.") {
+		hire, err := kafka.DecodeRoomHireEvent(message.Value)
+		if err != nil {
+			return nil, err
+		}
+		headers = hire.GetHeaders()
+		event.Key, event.VenueID = hire.RoomHireId, hire.VenueId
+		data := proto.Clone(hire).(*commonpb.RoomHireEvent)
+		data.Headers = nil
+		event.Data = data
+	} else {
+		booking, err := kafka.DecodeBookingEvent(message.Value)
+		if err != nil {
+			return nil, err
+		}
+		headers = booking.GetHeaders()
+		event.Key = booking.BookingId
+		data := proto.Clone(booking).(*commonpb.BookingEvent)
+		data.Headers = nil
+		event.Data = data
+	}
+
+	if event.Key == "" {
+		return nil, fmt.Errorf("event has no booking or room hire ID")
+	}
+	event.ID = headers.GetEventId()
+	if event.ID == "" {
+		event.ID = fmt.Sprintf("%s/%d/%d", message.Topic, message.Partition, message.Offset)
+	}
+	if ts := headers.GetTimestamp(); ts > 0 {
+		event.CreatedAt = time.Unix(ts, 0)
+	}
+	return event, nil
+}
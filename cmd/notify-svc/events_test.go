@@ -0,0 +1,51 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestDecodeWebhookEvent(t *testing.T) {
+	t.Run("booking", func(t *testing.T) {
+		event, err := decodeWebhookEvent(&sarama.ConsumerMessage{
+			Topic: "booking.cancelled",
+			Key:   []byte("booking-1"),
+			Value: []byte(`{"headers":{"trace_id":"trace-1","timestamp":1700000000,"event_id":"evt-1"},` +
+				`"booking_id":"booking-1","party_size":4,"Payload":{"Cancelled":{"reason":"guest called"}}}`),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "evt-1", event.ID)
+		assert.Equal(t, "booking.cancelled", event.Type)
+		assert.Equal(t, "booking-1", event.Key)
+		assert.Empty(t, event.VenueID, "looked up from the booking")
+		assert.Equal(t, time.Unix(1700000000, 0), event.CreatedAt)
+		data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(event.Data)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"booking_id":"booking-1","party_size":4,"cancelled":{"reason":"guest called"}}`, string(data))
+	})
+
+	t.Run("room hire", func(t *testing.T) {
+		event, err := decodeWebhookEvent(&sarama.ConsumerMessage{
+			Topic:     "room_hire.created",
+			Partition: 1,
+			Offset:    7,
+			Value:     []byte(`{"room_hire_id":"hire-1","venue_id":"venue-1","status":"tentative"}`),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "room_hire.created/1/7", event.ID, "events without an ID get their position")
+		assert.Equal(t, "hire-1", event.Key)
+		assert.Equal(t, "venue-1", event.VenueID)
+	})
+
+	t.Run("no key", func(t *testing.T) {
+		_, err := decodeWebhookEvent(&sarama.ConsumerMessage{Topic: "booking.held", Value: []byte(`{}`)})
+		assert.Error(t, err)
+	})
+}
@@ -2,53 +2,153 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"os"
 	"os/signal"
+	"slices"
 	"syscall"
 	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
+	"booker/cmd/notify-svc/config"
+	"booker/cmd/notify-svc/notifier"
+	"booker/cmd/notify-svc/repository"
+	"booker/cmd/notify-svc/service"
 	"booker/pkg/kafka"
+	bookingpb "booker/pkg/proto/booking"
+	notifypb "booker/pkg/proto/notify"
+	venuepb "booker/pkg/proto/venue"
 	"booker/pkg/tracing"
 )
 
 func main() {
+	cfg := config.Load()
+
 	// Logger
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 
 	// Tracing
-	jaegerEndpoint := os.Getenv("JAEGER_ENDPOINT")
-	shutdown, err := tracing.InitTracer("notify-svc", jaegerEndpoint)
+	shutdown, err := tracing.InitTracer("notify-svc", cfg.JaegerEndpoint)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to initialize tracer")
 	}
 	defer shutdown()
 
+	// PostgreSQL
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable",
+		cfg.PostgresUser, cfg.PostgresPassword, cfg.PostgresHost, cfg.PostgresPort, cfg.PostgresDB)
+
+	pool, err := pgxpool.New(context.Background(), dsn)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to connect to database")
+	}
+	defer pool.Close()
+
+	// Booking gRPC client
+	bookingConn, err := grpc.Dial(
+		cfg.GRPCBookingAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to connect to booking service")
+	}
+	defer bookingConn.Close()
+
+	// Venue gRPC client
+	venueConn, err := grpc.Dial(
+		cfg.GRPCVenueAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to connect to venue service")
+	}
+	defer venueConn.Close()
+
+	svc := service.New(repository.New(pool), bookingpb.NewBookingServiceClient(bookingConn), venuepb.NewVenueServiceClient(venueConn),
+		configuredNotifiers(cfg), cfg)
+
 	// Kafka consumer with retry logic
-	brokers := []string{os.Getenv("KAFKA_BROKERS")}
-	if brokers[0] == "" {
-		brokers = []string{"localhost:9092"}
+	brokers := []string{cfg.KafkaBrokers}
+
+	handler, err := kafka.NewRetryHandler(brokers, consumerGroup, kafka.DefaultRetryStages, (&BookingEventHandler{svc: svc}).Handle)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create Kafka retry producer")
+	}
+	defer handler.Close()
+	topics := slices.Concat(notificationTopics, kafka.RetryTopics(notificationTopics, kafka.DefaultRetryStages))
+
+	consumer := connectConsumer(brokers, consumerGroup, handler)
+	defer consumer.Close()
+
+	// Webhooks get every booking and room hire event in a consumer group of their own
+	webhookHandler, err := kafka.NewRetryHandler(brokers, webhookConsumerGroup, kafka.DefaultRetryStages, (&WebhookEventHandler{svc: svc}).Handle)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create Kafka retry producer")
 	}
+	defer webhookHandler.Close()
+	webhookTopics := slices.Concat(service.WebhookEventTypes, kafka.RetryTopics(service.WebhookEventTypes, kafka.DefaultRetryStages))
+
+	webhookConsumer := connectConsumer(brokers, webhookConsumerGroup, webhookHandler)
+	defer webhookConsumer.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go consume(ctx, consumer, topics)
+	go consume(ctx, webhookConsumer, webhookTopics)
+
+	go svc.RunReminders(ctx, time.Duration(cfg.ReminderInterval)*time.Second)
+	go svc.RunWebhooks(ctx, time.Duration(cfg.WebhookInterval)*time.Second)
+	go svc.RunPurge(ctx, time.Duration(cfg.EventKeepDays)*24*time.Hour)
+
+	log.Info().Strs("topics", topics).Strs("webhook_topics", webhookTopics).Msg("Notify service started")
+
+	// gRPC Server
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to listen")
+	}
+
+	s := grpc.NewServer()
+	notifypb.RegisterNotifyServiceServer(s, svc)
 
-	handler := &BookingEventHandler{}
+	go func() {
+		log.Info().Int("port", cfg.Port).Msg("Notify gRPC server started")
+		if err := s.Serve(lis); err != nil {
+			log.Fatal().Err(err).Msg("Server failed")
+		}
+	}()
+
+	<-ctx.Done()
+	log.Info().Msg("Shutting down...")
+	s.GracefulStop()
+}
+
+const consumerRetryDelay = 3 * time.Second
+
+// connectConsumer joins the consumer group, retrying while Kafka is starting up
+func connectConsumer(brokers []string, group string, handler sarama.ConsumerGroupHandler) *kafka.Consumer {
 	var consumer *kafka.Consumer
 	maxRetries := 20
-	retryDelay := 3 * time.Second
-	log.Info().Strs("brokers", brokers).Msg("Attempting to connect to Kafka...")
+	log.Info().Strs("brokers", brokers).Str("group", group).Msg("Attempting to connect to Kafka...")
 	for i := 0; i < maxRetries; i++ {
 		var err error
-		consumer, err = kafka.NewConsumer(brokers, "notify-svc-group", handler)
+		consumer, err = kafka.NewConsumer(brokers, group, handler)
 		if err == nil {
-			log.Info().Msg("Kafka consumer connected successfully")
+			log.Info().Str("group", group).Msg("Kafka consumer connected successfully")
 			break
 		}
 		if i < maxRetries-1 {
-			log.Warn().Err(err).Int("attempt", i+1).Int("max_retries", maxRetries).Dur("retry_delay", retryDelay).Msg("Failed to create Kafka consumer, retrying...")
-			time.Sleep(retryDelay)
+			log.Warn().Err(err).Int("attempt", i+1).Int("max_retries", maxRetries).Dur("retry_delay", consumerRetryDelay).Msg("Failed to create Kafka consumer, retrying...")
+			time.Sleep(consumerRetryDelay)
 		} else {
 			log.Fatal().Err(err).Int("total_attempts", maxRetries).Msg("Failed to create Kafka consumer after all retries")
 		}
@@ -56,45 +156,36 @@ func main() {
 	if consumer == nil {
 		log.Fatal().Msg("Kafka consumer is nil after retry loop")
 	}
-	defer consumer.Close()
-
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-	defer stop()
-
-	topics := []string{"booking.confirmed", "booking.cancelled", "booking.no_show"}
+	return consumer
+}
 
-	go func() {
-		for {
-			if err := consumer.Consume(ctx, topics); err != nil {
-				log.Error().Err(err).Msg("Consumer error")
-			}
+// consume runs consumer over topics until ctx is done
+func consume(ctx context.Context, consumer *kafka.Consumer, topics []string) {
+	for ctx.Err() == nil {
+		if err := consumer.Consume(ctx, topics); err != nil {
+			log.Error().Err(err).Msg("Consumer error")
+			time.Sleep(consumerRetryDelay)
 		}
-	}()
-
-	log.Info().Strs("topics", topics).Msg("Notify service started")
-
-	<-ctx.Done()
-	log.Info().Msg("Shutting down...")
+	}
 }
 
-type BookingEventHandler struct{}
-
-func (h *BookingEventHandler) Setup(sarama.ConsumerGroupSession) error   { return nil }
-func (h *BookingEventHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
-
-func (h *BookingEventHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
-	for message := range claim.Messages() {
-		log.Info().
-			Str("topic", message.Topic).
-			Str("key", string(message.Key)).
-			Msg("Received booking event")
-
-		// TODO: Send notification (email/SMS/Telegram)
-		// For MVP, just log
+// configuredNotifiers returns a notifier for every channel that has its settings
+func configuredNotifiers(cfg *config.Config) []notifier.Notifier {
+	var notifiers []notifier.Notifier
+	if cfg.SMTPHost != "" {
+		notifiers = append(notifiers, notifier.NewSMTPNotifier(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPassword, cfg.SMTPFrom))
+	}
+	if cfg.SMSGatewayURL != "" {
+		notifiers = append(notifiers, notifier.NewSMSNotifier(cfg.SMSGatewayURL, cfg.SMSAPIKey, cfg.SMSSender))
+	}
+	if cfg.TelegramBotToken != "" {
+		notifiers = append(notifiers, notifier.NewTelegramNotifier(cfg.TelegramAPIURL, cfg.TelegramBotToken))
+	}
 
-		session.MarkMessage(message, "")
+	channels := make([]string, len(notifiers))
+	for i, n := range notifiers {
+		channels[i] = n.Channel()
 	}
-	return nil
+	log.Info().Strs("channels", channels).Msg("Notification channels configured")
+	return notifiers
 }
-
-
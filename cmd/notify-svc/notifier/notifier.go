@@ -0,0 +1,46 @@
+// Package notifier delivers guest notifications over email, SMS and Telegram
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Channel names, as stored in venue settings and delivery records
+const (
+	ChannelEmail    = "email"
+	ChannelSMS      = "sms"
+	ChannelTelegram = "telegram"
+)
+
+// Channels lists every supported channel
+var Channels = []string{ChannelEmail, ChannelSMS, ChannelTelegram}
+
+// Message is one notification to one recipient: an email address, a phone number
+// or a Telegram chat ID depending on the channel
+type Message struct {
+	Recipient string
+	Subject   string // used by email only
+	Text      string
+	HTML      bool // Text is HTML, used by email only
+}
+
+// Notifier sends messages over one channel
+type Notifier interface {
+	Channel() string
+	// Send delivers the message and returns the provider's message ID, if it reports one
+	Send(ctx context.Context, msg *Message) (string, error)
+}
+
+// defaultHTTPClient is shared by the HTTP based channels
+var defaultHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// responseError describes a failed HTTP call with the start of the response body
+func responseError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
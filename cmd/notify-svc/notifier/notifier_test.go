@@ -0,0 +1,86 @@
+package notifier
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"booker/cmd/notify-svc/notifier/notifiertest"
+)
+
+func TestSMTPNotifier(t *testing.T) {
+	server, err := notifiertest.NewSMTPServer()
+	require.NoError(t, err)
+	defer server.Close()
+
+	n := NewSMTPNotifier(server.Host(), server.Port(), "", "", "booker@example.com")
+	id, err := n.Send(context.Background(), &Message{
+		Recipient: "anna@example.com",
+		Subject:   "Бронь подтверждена",
+		Text:      "Ждем вас 6 марта в 19:00",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, id)
+
+	emails := server.Emails()
+	require.Len(t, emails, 1)
+	assert.Equal(t, "booker@example.com", emails[0].From)
+	assert.Equal(t, []string{"anna@example.com"}, emails[0].To)
+	assert.Contains(t, emails[0].Data, "Subject: =?utf-8?q?")
+	assert.Contains(t, emails[0].Data, "Message-ID: "+id)
+	assert.True(t, strings.HasSuffix(emails[0].Data, "Ждем вас 6 марта в 19:00"))
+}
+
+func TestSMSNotifier(t *testing.T) {
+	server := notifiertest.NewSMSServer()
+	defer server.Close()
+
+	n := NewSMSNotifier(server.URL, "secret", "Booker")
+	id, err := n.Send(context.Background(), &Message{Recipient: "+79161234567", Text: "Бронь подтверждена"})
+	require.NoError(t, err)
+	assert.Equal(t, "sms-1", id)
+
+	messages := server.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, notifiertest.SMS{
+		To:            "+79161234567",
+		Text:          "Бронь подтверждена",
+		Sender:        "Booker",
+		Authorization: "Bearer secret",
+	}, messages[0])
+
+	server.FailStatus = http.StatusServiceUnavailable
+	_, err = n.Send(context.Background(), &Message{Recipient: "+79161234567", Text: "again"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+}
+
+func TestTelegramNotifier(t *testing.T) {
+	server := notifiertest.NewTelegramServer()
+	defer server.Close()
+
+	n := NewTelegramNotifier(server.URL+"/", "123:abc")
+	id, err := n.Send(context.Background(), &Message{Recipient: "-1001", Text: "Новая бронь"})
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+
+	messages := server.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, notifiertest.TelegramMessage{Token: "123:abc", ChatID: "-1001", Text: "Новая бронь"}, messages[0])
+
+	server.BlockedChats["42"] = true
+	_, err = n.Send(context.Background(), &Message{Recipient: "42", Text: "Новая бронь"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "blocked")
+}
+
+func TestTelegramNotifier_HidesToken(t *testing.T) {
+	n := NewTelegramNotifier("http://127.0.0.1:1", "123:secret-token")
+	_, err := n.Send(context.Background(), &Message{Recipient: "1", Text: "x"})
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret-token")
+}
@@ -0,0 +1,121 @@
+// Package notifiertest provides local stand-ins for the SMTP server, the SMS
+// gateway and the Telegram Bot API that record what they receive
+package notifiertest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// SMS is a message received by SMSServer
+type SMS struct {
+	To            string `json:"to"`
+	Text          string `json:"text"`
+	Sender        string `json:"sender"`
+	Authorization string `json:"-"`
+}
+
+// SMSServer is a fake HTTP SMS gateway. Set FailStatus to make it reject requests
+type SMSServer struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	messages   []SMS
+	FailStatus int
+}
+
+func NewSMSServer() *SMSServer {
+	s := &SMSServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *SMSServer) handle(w http.ResponseWriter, r *http.Request) {
+	var sms SMS
+	if err := json.NewDecoder(r.Body).Decode(&sms); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	sms.Authorization = r.Header.Get("Authorization")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.FailStatus != 0 {
+		http.Error(w, "gateway unavailable", s.FailStatus)
+		return
+	}
+	s.messages = append(s.messages, sms)
+	json.NewEncoder(w).Encode(map[string]string{"id": fmt.Sprintf("sms-%d", len(s.messages))})
+}
+
+// Messages returns the messages received so far
+func (s *SMSServer) Messages() []SMS {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SMS(nil), s.messages...)
+}
+
+// TelegramMessage is a message received by TelegramServer
+type TelegramMessage struct {
+	Token  string
+	ChatID string `json:"chat_id"`
+	Text   string `json:"text"`
+}
+
+// TelegramServer is a fake Telegram Bot API serving sendMessage. Chats listed
+// in BlockedChats are answered the way Telegram answers for a bot blocked by the user
+type TelegramServer struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	messages     []TelegramMessage
+	BlockedChats map[string]bool
+}
+
+func NewTelegramServer() *TelegramServer {
+	s := &TelegramServer{BlockedChats: map[string]bool{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *TelegramServer) handle(w http.ResponseWriter, r *http.Request) {
+	// Path is /bot<token>/sendMessage
+	path := strings.TrimPrefix(r.URL.Path, "/bot")
+	token, method, ok := strings.Cut(path, "/")
+	if !ok || method != "sendMessage" {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{"ok": false, "description": "Not Found"})
+		return
+	}
+
+	msg := TelegramMessage{Token: token}
+	if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]interface{}{"ok": false, "description": "Bad Request: " + err.Error()})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.BlockedChats[msg.ChatID] {
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]interface{}{"ok": false, "description": "Forbidden: bot was blocked by the user"})
+		return
+	}
+	s.messages = append(s.messages, msg)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"ok":     true,
+		"result": map[string]interface{}{"message_id": len(s.messages)},
+	})
+}
+
+// Messages returns the messages received so far
+func (s *TelegramServer) Messages() []TelegramMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]TelegramMessage(nil), s.messages...)
+}
@@ -0,0 +1,122 @@
+package notifiertest
+
+import (
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+)
+
+// Email is a message received by SMTPServer
+type Email struct {
+	From string
+	To   []string
+	Data string // headers and body as sent
+}
+
+// SMTPServer is a minimal SMTP server on a local port. It offers neither
+// STARTTLS nor AUTH and accepts every message
+type SMTPServer struct {
+	listener net.Listener
+
+	mu     sync.Mutex
+	emails []Email
+}
+
+func NewSMTPServer() (*SMTPServer, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &SMTPServer{listener: l}
+	go s.serve()
+	return s, nil
+}
+
+// Host and Port are where clients connect
+func (s *SMTPServer) Host() string {
+	return s.listener.Addr().(*net.TCPAddr).IP.String()
+}
+
+func (s *SMTPServer) Port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *SMTPServer) Close() error {
+	return s.listener.Close()
+}
+
+// Emails returns the messages received so far
+func (s *SMTPServer) Emails() []Email {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Email(nil), s.emails...)
+}
+
+func (s *SMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *SMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost fake SMTP")
+
+	var email Email
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			tp.PrintfLine("250-localhost")
+			tp.PrintfLine("250 8BITMIME")
+		case "HELO", "NOOP":
+			tp.PrintfLine("250 OK")
+		case "RSET":
+			email = Email{}
+			tp.PrintfLine("250 OK")
+		case "MAIL":
+			email = Email{From: addressArg(arg)}
+			tp.PrintfLine("250 OK")
+		case "RCPT":
+			email.To = append(email.To, addressArg(arg))
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			lines, err := tp.ReadDotLines()
+			if err != nil {
+				return
+			}
+			email.Data = strings.Join(lines, "\r\n")
+			s.mu.Lock()
+			s.emails = append(s.emails, email)
+			s.mu.Unlock()
+			email = Email{}
+			tp.PrintfLine("250 OK queued")
+		case "QUIT":
+			tp.PrintfLine("221 Bye")
+			return
+		default:
+			tp.PrintfLine("502 Command not implemented")
+		}
+	}
+}
+
+// addressArg extracts the address from "FROM:<a@b> BODY=8BITMIME" or "TO:<a@b>"
+func addressArg(arg string) string {
+	start := strings.Index(arg, "<")
+	end := strings.Index(arg, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return arg[start+1 : end]
+}
@@ -0,0 +1,62 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SMSNotifier posts messages to a generic HTTP SMS gateway as
+// {"to": ..., "text": ..., "sender": ...} with a bearer API key. A JSON
+// response with an "id" is taken as the provider's message ID
+type SMSNotifier struct {
+	url    string
+	apiKey string
+	sender string
+	client *http.Client
+}
+
+func NewSMSNotifier(url, apiKey, sender string) *SMSNotifier {
+	return &SMSNotifier{url: url, apiKey: apiKey, sender: sender, client: defaultHTTPClient}
+}
+
+func (n *SMSNotifier) Channel() string { return ChannelSMS }
+
+func (n *SMSNotifier) Send(ctx context.Context, msg *Message) (string, error) {
+	body, err := json.Marshal(map[string]string{
+		"to":     msg.Recipient,
+		"text":   msg.Text,
+		"sender": n.sender,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if n.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+n.apiKey)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("sms gateway: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("sms gateway: %w", responseError(resp))
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	// Gateways that answer without a body are fine too
+	json.NewDecoder(resp.Body).Decode(&result)
+	return result.ID, nil
+}
@@ -0,0 +1,103 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SMTPNotifier sends plain text emails, upgrading to TLS when the server offers
+// STARTTLS and authenticating when a user is configured
+type SMTPNotifier struct {
+	host     string
+	port     int
+	user     string
+	password string
+	from     string
+}
+
+func NewSMTPNotifier(host string, port int, user, password, from string) *SMTPNotifier {
+	return &SMTPNotifier{host: host, port: port, user: user, password: password, from: from}
+}
+
+func (n *SMTPNotifier) Channel() string { return ChannelEmail }
+
+func (n *SMTPNotifier) Send(ctx context.Context, msg *Message) (string, error) {
+	addr := net.JoinHostPort(n.host, strconv.Itoa(n.port))
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return "", fmt.Errorf("smtp: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, n.host)
+	if err != nil {
+		conn.Close()
+		return "", fmt.Errorf("smtp: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.host}); err != nil {
+			return "", fmt.Errorf("smtp starttls: %w", err)
+		}
+	}
+	if n.user != "" {
+		if err := client.Auth(smtp.PlainAuth("", n.user, n.password, n.host)); err != nil {
+			return "", fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	messageID := fmt.Sprintf("<%s@%s>", uuid.New().String(), n.host)
+	if err := client.Mail(n.from); err != nil {
+		return "", fmt.Errorf("smtp: %w", err)
+	}
+	if err := client.Rcpt(msg.Recipient); err != nil {
+		return "", fmt.Errorf("smtp: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return "", fmt.Errorf("smtp: %w", err)
+	}
+	if _, err := w.Write(n.buildMessage(msg, messageID)); err != nil {
+		return "", fmt.Errorf("smtp: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("smtp: %w", err)
+	}
+
+	client.Quit()
+	return messageID, nil
+}
+
+// buildMessage renders a UTF-8 plain text or HTML email with an encoded subject
+func (n *SMTPNotifier) buildMessage(msg *Message, messageID string) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", n.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.Recipient)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: %s\r\n", messageID)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	if msg.HTML {
+		buf.WriteString("Content-Type: text/html; charset=utf-8\r\n")
+	} else {
+		buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	}
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(msg.Text)
+	buf.WriteString("\r\n")
+	return buf.Bytes()
+}
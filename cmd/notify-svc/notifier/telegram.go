@@ -0,0 +1,70 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// TelegramNotifier sends messages through the Telegram Bot API sendMessage
+// method; the recipient is a chat ID
+type TelegramNotifier struct {
+	apiURL string
+	token  string
+	client *http.Client
+}
+
+func NewTelegramNotifier(apiURL, token string) *TelegramNotifier {
+	return &TelegramNotifier{apiURL: strings.TrimRight(apiURL, "/"), token: token, client: defaultHTTPClient}
+}
+
+func (n *TelegramNotifier) Channel() string { return ChannelTelegram }
+
+func (n *TelegramNotifier) Send(ctx context.Context, msg *Message) (string, error) {
+	body, err := json.Marshal(map[string]string{
+		"chat_id": msg.Recipient,
+		"text":    msg.Text,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := fmt.Sprintf("%s/bot%s/sendMessage", n.apiURL, n.token)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		// The URL holds the bot token, keep it out of logs and delivery records
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return "", fmt.Errorf("telegram: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		OK          bool   `json:"ok"`
+		Description string `json:"description"`
+		Result      struct {
+			MessageID int64 `json:"message_id"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("telegram: unexpected status %d", resp.StatusCode)
+	}
+	if !result.OK {
+		return "", fmt.Errorf("telegram: %s", result.Description)
+	}
+	return strconv.FormatInt(result.Result.MessageID, 10), nil
+}
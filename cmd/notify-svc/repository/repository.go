@@ -0,0 +1,881 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// Channel settings
+
+// GetVenueChannels returns the channels a venue has chosen, none if it uses the defaults
+func (r *Repository) GetVenueChannels(ctx context.Context, venueID string) ([]*VenueChannel, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT venue_id, channel, enabled, recipient, updated_at
+		 FROM venue_channels WHERE venue_id = $1 ORDER BY channel`,
+		venueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var channels []*VenueChannel
+	for rows.Next() {
+		var c VenueChannel
+		if err := rows.Scan(&c.VenueID, &c.Channel, &c.Enabled, &c.Recipient, &c.UpdatedAt); err != nil {
+			return nil, err
+		}
+		channels = append(channels, &c)
+	}
+
+	return channels, rows.Err()
+}
+
+// SetVenueChannels replaces the channels of a venue
+func (r *Repository) SetVenueChannels(ctx context.Context, venueID string, channels []*VenueChannel) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM venue_channels WHERE venue_id = $1`, venueID); err != nil {
+		return err
+	}
+	for _, c := range channels {
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO venue_channels (venue_id, channel, enabled, recipient, updated_at)
+			 VALUES ($1, $2, $3, $4, NOW())`,
+			venueID, c.Channel, c.Enabled, c.Recipient); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetVenueLocale returns the locale a venue has chosen, empty if it uses the default
+func (r *Repository) GetVenueLocale(ctx context.Context, venueID string) (string, error) {
+	var locale string
+	err := r.db.QueryRow(ctx, `SELECT locale FROM venue_settings WHERE venue_id = $1`, venueID).Scan(&locale)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	return locale, err
+}
+
+// SetVenueLocale stores the locale of a venue; an empty locale returns it to the default
+func (r *Repository) SetVenueLocale(ctx context.Context, venueID, locale string) error {
+	if locale == "" {
+		_, err := r.db.Exec(ctx, `DELETE FROM venue_settings WHERE venue_id = $1`, venueID)
+		return err
+	}
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO venue_settings (venue_id, locale, updated_at) VALUES ($1, $2, NOW())
+		 ON CONFLICT (venue_id) DO UPDATE SET locale = EXCLUDED.locale, updated_at = NOW()`,
+		venueID, locale)
+	return err
+}
+
+// Templates
+
+// ListTemplates returns the service-wide templates and, if filters.VenueID is set,
+// the templates of that venue
+func (r *Repository) ListTemplates(ctx context.Context, filters *TemplateFilters) ([]*MessageTemplate, error) {
+	args := []interface{}{filters.VenueID}
+	where := []string{"venue_id IN ('', $1)"}
+	add := func(cond string, value interface{}) {
+		args = append(args, value)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+	if filters.Event != "" {
+		add("event_type = $%d", filters.Event)
+	}
+	if filters.Channel != "" {
+		add("channel = $%d", filters.Channel)
+	}
+	if filters.Locale != "" {
+		add("locale = $%d", filters.Locale)
+	}
+
+	rows, err := r.db.Query(ctx,
+		`SELECT venue_id, event_type, channel, locale, subject, body, updated_by, updated_at
+		 FROM message_templates WHERE `+strings.Join(where, " AND ")+`
+		 ORDER BY event_type, channel, locale, venue_id`,
+		args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*MessageTemplate
+	for rows.Next() {
+		var t MessageTemplate
+		if err := rows.Scan(&t.VenueID, &t.Event, &t.Channel, &t.Locale, &t.Subject, &t.Body, &t.UpdatedBy, &t.UpdatedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, &t)
+	}
+
+	return list, rows.Err()
+}
+
+// SetTemplate creates or replaces a template
+func (r *Repository) SetTemplate(ctx context.Context, t *MessageTemplate) error {
+	return r.db.QueryRow(ctx,
+		`INSERT INTO message_templates (venue_id, event_type, channel, locale, subject, body, updated_by, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+		 ON CONFLICT (venue_id, event_type, channel, locale) DO UPDATE
+		 SET subject = EXCLUDED.subject, body = EXCLUDED.body, updated_by = EXCLUDED.updated_by, updated_at = NOW()
+		 RETURNING updated_at`,
+		t.VenueID, t.Event, t.Channel, t.Locale, t.Subject, t.Body, t.UpdatedBy).Scan(&t.UpdatedAt)
+}
+
+func (r *Repository) DeleteTemplate(ctx context.Context, venueID, event, channel, locale string) error {
+	_, err := r.db.Exec(ctx,
+		`DELETE FROM message_templates WHERE venue_id = $1 AND event_type = $2 AND channel = $3 AND locale = $4`,
+		venueID, event, channel, locale)
+	return err
+}
+
+// Reminders
+
+// GetVenueReminderOffsets returns the offsets a venue has chosen; found is false if
+// it uses the defaults
+func (r *Repository) GetVenueReminderOffsets(ctx context.Context, venueID string) (offsets []int32, found bool, err error) {
+	err = r.db.QueryRow(ctx, `SELECT offsets FROM venue_reminders WHERE venue_id = $1`, venueID).Scan(&offsets)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return offsets, true, nil
+}
+
+// SetVenueReminderOffsets stores the offsets of a venue; nil returns it to the defaults
+func (r *Repository) SetVenueReminderOffsets(ctx context.Context, venueID string, offsets []int32) error {
+	if offsets == nil {
+		_, err := r.db.Exec(ctx, `DELETE FROM venue_reminders WHERE venue_id = $1`, venueID)
+		return err
+	}
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO venue_reminders (venue_id, offsets, updated_at) VALUES ($1, $2, NOW())
+		 ON CONFLICT (venue_id) DO UPDATE SET offsets = EXCLUDED.offsets, updated_at = NOW()`,
+		venueID, offsets)
+	return err
+}
+
+// ScheduleReminders makes the pending reminders of a booking match the given ones:
+// pending reminders for another slot start or offset are cancelled and missing ones
+// added, reviving cancelled ones if the booking moved back. Reminders already sent
+// for the same start and offset are not repeated
+func (r *Repository) ScheduleReminders(ctx context.Context, bookingID, venueID string, slotStart time.Time, due map[int32]time.Time) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	offsets := make([]int32, 0, len(due))
+	for offset := range due {
+		offsets = append(offsets, offset)
+	}
+	if _, err := tx.Exec(ctx,
+		`UPDATE reminders SET status = 'cancelled', error = 'booking rescheduled', updated_at = NOW()
+		 WHERE booking_id = $1 AND status = 'pending' AND (slot_start <> $2 OR NOT offset_minutes = ANY($3))`,
+		bookingID, slotStart, offsets); err != nil {
+		return err
+	}
+	for offset, dueAt := range due {
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO reminders (id, venue_id, booking_id, offset_minutes, slot_start, due_at, status, created_at, updated_at)
+			 VALUES ($1, $2, $3, $4, $5, $6, 'pending', NOW(), NOW())
+			 ON CONFLICT (booking_id, offset_minutes, slot_start) DO UPDATE
+			 SET status = 'pending', error = '', attempts = 0, due_at = EXCLUDED.due_at, updated_at = NOW()
+			 WHERE reminders.status = 'cancelled'`,
+			uuid.New().String(), venueID, bookingID, offset, slotStart, dueAt); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// CancelReminders cancels the pending reminders of a booking
+func (r *Repository) CancelReminders(ctx context.Context, bookingID, reason string) error {
+	_, err := r.db.Exec(ctx,
+		`UPDATE reminders SET status = 'cancelled', error = $2, updated_at = NOW()
+		 WHERE booking_id = $1 AND status = 'pending'`,
+		bookingID, reason)
+	return err
+}
+
+// ListPendingReminderBookings returns the bookings of a venue with pending reminders
+func (r *Repository) ListPendingReminderBookings(ctx context.Context, venueID string) ([]string, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT DISTINCT booking_id FROM reminders WHERE venue_id = $1 AND status = 'pending'`,
+		venueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// ReminderClaim holds the row lock of a due reminder until it is finished or
+// released. Other replicas skip locked reminders, and a claim lost with its
+// process is rolled back and picked up again
+type ReminderClaim struct {
+	tx       pgx.Tx
+	Reminder *Reminder
+}
+
+// ClaimDueReminder locks the earliest due pending reminder; it returns nil if
+// none is due
+func (r *Repository) ClaimDueReminder(ctx context.Context) (*ReminderClaim, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rm Reminder
+	err = tx.QueryRow(ctx,
+		`SELECT id, venue_id, booking_id, offset_minutes, slot_start, due_at, status, error, attempts, updated_at
+		 FROM reminders WHERE status = 'pending' AND due_at <= NOW()
+		 ORDER BY due_at LIMIT 1
+		 FOR UPDATE SKIP LOCKED`).
+		Scan(&rm.ID, &rm.VenueID, &rm.BookingID, &rm.OffsetMinutes, &rm.SlotStart, &rm.DueAt, &rm.Status, &rm.Error, &rm.Attempts, &rm.UpdatedAt)
+	if err != nil {
+		tx.Rollback(ctx)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &ReminderClaim{tx: tx, Reminder: &rm}, nil
+}
+
+// Finish stores the status, attempts and next due time of the claimed reminder
+// and releases its lock
+func (c *ReminderClaim) Finish(ctx context.Context) error {
+	rm := c.Reminder
+	if _, err := c.tx.Exec(ctx,
+		`UPDATE reminders SET status = $2, error = $3, attempts = $4, due_at = $5, updated_at = NOW() WHERE id = $1`,
+		rm.ID, rm.Status, rm.Error, rm.Attempts, rm.DueAt); err != nil {
+		c.tx.Rollback(ctx)
+		return err
+	}
+	return c.tx.Commit(ctx)
+}
+
+// Release gives the claimed reminder back untouched
+func (c *ReminderClaim) Release(ctx context.Context) {
+	c.tx.Rollback(ctx)
+}
+
+func (r *Repository) ListReminders(ctx context.Context, filters *ReminderFilters) ([]*Reminder, int32, error) {
+	where := []string{}
+	args := []interface{}{}
+	add := func(cond string, value interface{}) {
+		args = append(args, value)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+	if filters.VenueID != "" {
+		add("venue_id = $%d", filters.VenueID)
+	}
+	if filters.BookingID != "" {
+		add("booking_id = $%d", filters.BookingID)
+	}
+	if filters.Status != "" {
+		add("status = $%d", filters.Status)
+	}
+	whereClause := ""
+	if len(where) > 0 {
+		whereClause = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	var total int32
+	if err := r.db.QueryRow(ctx, "SELECT COUNT(*) FROM reminders "+whereClause, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, filters.Limit, filters.Offset)
+	rows, err := r.db.Query(ctx,
+		fmt.Sprintf(`SELECT id, venue_id, booking_id, offset_minutes, slot_start, due_at, status, error, attempts, updated_at
+		 FROM reminders %s ORDER BY due_at DESC, id LIMIT $%d OFFSET $%d`,
+			whereClause, len(args)-1, len(args)),
+		args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var reminders []*Reminder
+	for rows.Next() {
+		var rm Reminder
+		if err := rows.Scan(&rm.ID, &rm.VenueID, &rm.BookingID, &rm.OffsetMinutes, &rm.SlotStart, &rm.DueAt,
+			&rm.Status, &rm.Error, &rm.Attempts, &rm.UpdatedAt); err != nil {
+			return nil, 0, err
+		}
+		reminders = append(reminders, &rm)
+	}
+
+	return reminders, total, rows.Err()
+}
+
+// Processed events
+
+func (r *Repository) IsEventProcessed(ctx context.Context, eventID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM processed_events WHERE event_id = $1)`, eventID).Scan(&exists)
+	return exists, err
+}
+
+func (r *Repository) MarkEventProcessed(ctx context.Context, eventID, topic, key string) error {
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO processed_events (event_id, topic, event_key, processed_at) VALUES ($1, $2, $3, NOW())
+		 ON CONFLICT (event_id) DO NOTHING`,
+		eventID, topic, key)
+	return err
+}
+
+// PurgeProcessedEvents forgets events processed longer than retention ago
+func (r *Repository) PurgeProcessedEvents(ctx context.Context, retention time.Duration) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM processed_events WHERE processed_at < $1`, time.Now().Add(-retention))
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// Deliveries
+
+// DoneChannels returns the channels an event was already sent or skipped on
+func (r *Repository) DoneChannels(ctx context.Context, eventID string) (map[string]bool, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT DISTINCT channel FROM deliveries WHERE event_id = $1 AND status IN ('sent', 'skipped')`,
+		eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	done := make(map[string]bool)
+	for rows.Next() {
+		var channel string
+		if err := rows.Scan(&channel); err != nil {
+			return nil, err
+		}
+		done[channel] = true
+	}
+
+	return done, rows.Err()
+}
+
+func (r *Repository) CreateDelivery(ctx context.Context, d *Delivery) error {
+	if d.ID == "" {
+		d.ID = uuid.New().String()
+	}
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO deliveries (id, venue_id, topic, event_key, event_id, channel, recipient, status, error, provider_message_id, created_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW())`,
+		d.ID, d.VenueID, d.Topic, d.EventKey, d.EventID, d.Channel, d.Recipient, d.Status, d.Error, d.ProviderMessageID)
+	return err
+}
+
+func (r *Repository) ListDeliveries(ctx context.Context, filters *DeliveryFilters) ([]*Delivery, int32, error) {
+	where := []string{}
+	args := []interface{}{}
+	add := func(cond string, value interface{}) {
+		args = append(args, value)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+	if filters.VenueID != "" {
+		add("venue_id = $%d", filters.VenueID)
+	}
+	if filters.EventKey != "" {
+		add("event_key = $%d", filters.EventKey)
+	}
+	if filters.Channel != "" {
+		add("channel = $%d", filters.Channel)
+	}
+	if filters.Status != "" {
+		add("status = $%d", filters.Status)
+	}
+	whereClause := ""
+	if len(where) > 0 {
+		whereClause = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	var total int32
+	if err := r.db.QueryRow(ctx, "SELECT COUNT(*) FROM deliveries "+whereClause, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, filters.Limit, filters.Offset)
+	rows, err := r.db.Query(ctx,
+		fmt.Sprintf(`SELECT id, venue_id, topic, event_key, event_id, channel, recipient, status, error, provider_message_id, created_at
+		 FROM deliveries %s ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d`,
+			whereClause, len(args)-1, len(args)),
+		args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var deliveries []*Delivery
+	for rows.Next() {
+		var d Delivery
+		if err := rows.Scan(&d.ID, &d.VenueID, &d.Topic, &d.EventKey, &d.EventID, &d.Channel, &d.Recipient,
+			&d.Status, &d.Error, &d.ProviderMessageID, &d.CreatedAt); err != nil {
+			return nil, 0, err
+		}
+		deliveries = append(deliveries, &d)
+	}
+
+	return deliveries, total, rows.Err()
+}
+
+// Webhooks
+
+func (r *Repository) ListWebhooks(ctx context.Context, venueID string) ([]*Webhook, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+webhookColumns+` FROM webhooks WHERE venue_id = $1 ORDER BY created_at, id`,
+		venueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []*Webhook
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, w)
+	}
+
+	return webhooks, rows.Err()
+}
+
+// MatchingWebhooks returns the enabled webhooks of a venue subscribed to the event type
+func (r *Repository) MatchingWebhooks(ctx context.Context, venueID, eventType string) ([]*Webhook, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+webhookColumns+` FROM webhooks
+		 WHERE venue_id = $1 AND enabled AND (event_types = '{}' OR $2 = ANY(event_types))`,
+		venueID, eventType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []*Webhook
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, w)
+	}
+
+	return webhooks, rows.Err()
+}
+
+// HasWebhooks reports whether any venue has an enabled webhook subscribed to the event type
+func (r *Repository) HasWebhooks(ctx context.Context, eventType string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM webhooks WHERE enabled AND (event_types = '{}' OR $1 = ANY(event_types)))`,
+		eventType).Scan(&exists)
+	return exists, err
+}
+
+func (r *Repository) GetWebhook(ctx context.Context, id string) (*Webhook, error) {
+	w, err := scanWebhook(r.db.QueryRow(ctx, `SELECT `+webhookColumns+` FROM webhooks WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("webhook %s not found", id)
+	}
+	return w, err
+}
+
+func (r *Repository) CreateWebhook(ctx context.Context, w *Webhook) error {
+	if w.ID == "" {
+		w.ID = uuid.New().String()
+	}
+	return r.db.QueryRow(ctx,
+		`INSERT INTO webhooks (id, venue_id, url, secret, event_types, enabled, description, updated_by, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		 RETURNING created_at, updated_at`,
+		w.ID, w.VenueID, w.URL, w.Secret, w.EventTypes, w.Enabled, w.Description, w.UpdatedBy).
+		Scan(&w.CreatedAt, &w.UpdatedAt)
+}
+
+func (r *Repository) UpdateWebhook(ctx context.Context, w *Webhook) error {
+	err := r.db.QueryRow(ctx,
+		`UPDATE webhooks SET url = $2, secret = $3, event_types = $4, enabled = $5, description = $6, updated_by = $7, updated_at = NOW()
+		 WHERE id = $1
+		 RETURNING created_at, updated_at`,
+		w.ID, w.URL, w.Secret, w.EventTypes, w.Enabled, w.Description, w.UpdatedBy).
+		Scan(&w.CreatedAt, &w.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("webhook %s not found", w.ID)
+	}
+	return err
+}
+
+// DeleteWebhook removes a webhook along with its deliveries
+func (r *Repository) DeleteWebhook(ctx context.Context, id string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM webhooks WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("webhook %s not found", id)
+	}
+	return nil
+}
+
+const webhookColumns = `id, venue_id, url, secret, event_types, enabled, description, updated_by, created_at, updated_at`
+
+func scanWebhook(row pgx.Row) (*Webhook, error) {
+	var w Webhook
+	if err := row.Scan(&w.ID, &w.VenueID, &w.URL, &w.Secret, &w.EventTypes, &w.Enabled, &w.Description,
+		&w.UpdatedBy, &w.CreatedAt, &w.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// Webhook deliveries
+
+// CreateWebhookDelivery stores a delivery; it returns false if the webhook already
+// has one for the event
+func (r *Repository) CreateWebhookDelivery(ctx context.Context, d *WebhookDelivery) (bool, error) {
+	if d.ID == "" {
+		d.ID = uuid.New().String()
+	}
+	if d.Status == "" {
+		d.Status = "pending"
+	}
+	tag, err := r.db.Exec(ctx,
+		`INSERT INTO webhook_deliveries (id, webhook_id, venue_id, event_id, event_type, payload, status, attempts,
+		 next_attempt_at, response_status, error, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), $9, $10, NOW(), NOW())
+		 ON CONFLICT (webhook_id, event_id) DO NOTHING`,
+		d.ID, d.WebhookID, d.VenueID, d.EventID, d.EventType, d.Payload, d.Status, d.Attempts, d.ResponseStatus, d.Error)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// WebhookDeliveryClaim is a due delivery leased to one sender. Unlike reminders no
+// row lock is held while the request is out: the lease moves next_attempt_at past
+// the request timeout, so other replicas skip the delivery, and one lost with its
+// process is picked up again once the lease runs out
+type WebhookDeliveryClaim struct {
+	db       *pgxpool.Pool
+	Delivery *WebhookDelivery
+	URL      string
+	Secret   string
+}
+
+// ClaimDueWebhookDelivery leases the earliest due pending delivery of an enabled
+// webhook not in skipWebhooks for the lease duration; it returns nil if none is due
+func (r *Repository) ClaimDueWebhookDelivery(ctx context.Context, skipWebhooks []string, lease time.Duration) (*WebhookDeliveryClaim, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if skipWebhooks == nil {
+		skipWebhooks = []string{} // NULL would match nothing
+	}
+	claim := &WebhookDeliveryClaim{db: r.db}
+	d, err := scanWebhookDelivery(tx.QueryRow(ctx,
+		`SELECT `+webhookDeliveryColumns("d")+`, w.url, w.secret
+		 FROM webhook_deliveries d JOIN webhooks w ON w.id = d.webhook_id
+		 WHERE d.status = 'pending' AND d.next_attempt_at <= NOW() AND w.enabled
+		   AND NOT d.webhook_id = ANY($1)
+		 ORDER BY d.next_attempt_at LIMIT 1
+		 FOR UPDATE OF d SKIP LOCKED`, skipWebhooks), &claim.URL, &claim.Secret)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx,
+		`UPDATE webhook_deliveries SET next_attempt_at = NOW() + make_interval(secs => $2) WHERE id = $1`,
+		d.ID, lease.Seconds()); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	claim.Delivery = d
+
+	return claim, nil
+}
+
+// Finish stores the outcome of an attempt at the claimed delivery, ending the lease
+func (c *WebhookDeliveryClaim) Finish(ctx context.Context) error {
+	d := c.Delivery
+	_, err := c.db.Exec(ctx,
+		`UPDATE webhook_deliveries SET status = $2, attempts = $3, next_attempt_at = $4, response_status = $5, error = $6, updated_at = NOW()
+		 WHERE id = $1`,
+		d.ID, d.Status, d.Attempts, d.NextAttemptAt, d.ResponseStatus, d.Error)
+	return err
+}
+
+// Release gives the claimed delivery back untouched, due at once
+func (c *WebhookDeliveryClaim) Release(ctx context.Context) error {
+	_, err := c.db.Exec(ctx, `UPDATE webhook_deliveries SET next_attempt_at = NOW() WHERE id = $1 AND status = 'pending'`, c.Delivery.ID)
+	return err
+}
+
+func (r *Repository) GetWebhookDelivery(ctx context.Context, id string) (*WebhookDelivery, error) {
+	d, err := scanWebhookDelivery(r.db.QueryRow(ctx,
+		`SELECT `+webhookDeliveryColumns("")+` FROM webhook_deliveries WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("webhook delivery %s not found", id)
+	}
+	return d, err
+}
+
+// ReplayWebhookDelivery queues a delivery to be sent again at once, with a fresh
+// set of attempts
+func (r *Repository) ReplayWebhookDelivery(ctx context.Context, id string) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE webhook_deliveries SET status = 'pending', attempts = 0, next_attempt_at = NOW(), updated_at = NOW()
+		 WHERE id = $1`,
+		id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("webhook delivery %s not found", id)
+	}
+	return nil
+}
+
+func (r *Repository) ListWebhookDeliveries(ctx context.Context, filters *WebhookDeliveryFilters) ([]*WebhookDelivery, int32, error) {
+	where := []string{}
+	args := []interface{}{}
+	add := func(cond string, value interface{}) {
+		args = append(args, value)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+	if filters.VenueID != "" {
+		add("venue_id = $%d", filters.VenueID)
+	}
+	if filters.WebhookID != "" {
+		add("webhook_id = $%d", filters.WebhookID)
+	}
+	if filters.EventType != "" {
+		add("event_type = $%d", filters.EventType)
+	}
+	if filters.Status != "" {
+		add("status = $%d", filters.Status)
+	}
+	whereClause := ""
+	if len(where) > 0 {
+		whereClause = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	var total int32
+	if err := r.db.QueryRow(ctx, "SELECT COUNT(*) FROM webhook_deliveries "+whereClause, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, filters.Limit, filters.Offset)
+	rows, err := r.db.Query(ctx,
+		fmt.Sprintf(`SELECT %s FROM webhook_deliveries %s ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d`,
+			webhookDeliveryColumns(""), whereClause, len(args)-1, len(args)),
+		args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var deliveries []*WebhookDelivery
+	for rows.Next() {
+		d, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, total, rows.Err()
+}
+
+// webhookDeliveryColumns lists the columns scanWebhookDelivery reads, qualified
+// with the table alias if given
+func webhookDeliveryColumns(alias string) string {
+	columns := []string{"id", "webhook_id", "venue_id", "event_id", "event_type", "payload", "status", "attempts",
+		"next_attempt_at", "response_status", "error", "created_at", "updated_at"}
+	if alias != "" {
+		for i, c := range columns {
+			columns[i] = alias + "." + c
+		}
+	}
+	return strings.Join(columns, ", ")
+}
+
+func scanWebhookDelivery(row pgx.Row, extra ...interface{}) (*WebhookDelivery, error) {
+	var d WebhookDelivery
+	dest := []interface{}{&d.ID, &d.WebhookID, &d.VenueID, &d.EventID, &d.EventType, &d.Payload, &d.Status, &d.Attempts,
+		&d.NextAttemptAt, &d.ResponseStatus, &d.Error, &d.CreatedAt, &d.UpdatedAt}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// Models
+
+// VenueChannel is a channel chosen by a venue. An empty Recipient means the
+// guest's own contact for the channel
+type VenueChannel struct {
+	VenueID   string
+	Channel   string
+	Enabled   bool
+	Recipient string
+	UpdatedAt time.Time
+}
+
+// Delivery records one attempt to notify over one channel
+type Delivery struct {
+	ID                string
+	VenueID           string
+	Topic             string
+	EventKey          string
+	EventID           string
+	Channel           string
+	Recipient         string
+	Status            string // sent, failed, skipped
+	Error             string
+	ProviderMessageID string
+	CreatedAt         time.Time
+}
+
+// MessageTemplate overrides a built-in template for one venue, or for all of
+// them if VenueID is empty
+type MessageTemplate struct {
+	VenueID   string
+	Event     string
+	Channel   string
+	Locale    string
+	Subject   string
+	Body      string
+	UpdatedBy string
+	UpdatedAt time.Time
+}
+
+type TemplateFilters struct {
+	VenueID string
+	Event   string
+	Channel string
+	Locale  string
+}
+
+// Reminder is a reminder of a booking due OffsetMinutes before SlotStart
+type Reminder struct {
+	ID            string
+	VenueID       string
+	BookingID     string
+	OffsetMinutes int32
+	SlotStart     time.Time
+	DueAt         time.Time
+	Status        string // pending, sent, failed, cancelled, expired
+	Error         string
+	Attempts      int32
+	UpdatedAt     time.Time
+}
+
+type ReminderFilters struct {
+	VenueID   string
+	BookingID string
+	Status    string
+	Limit     int32
+	Offset    int32
+}
+
+type DeliveryFilters struct {
+	VenueID  string
+	EventKey string
+	Channel  string
+	Status   string
+	Limit    int32
+	Offset   int32
+}
+
+// Webhook pushes the events of a venue to a partner endpoint. An empty
+// EventTypes subscribes to every event
+type Webhook struct {
+	ID          string
+	VenueID     string
+	URL         string
+	Secret      string
+	EventTypes  []string
+	Enabled     bool
+	Description string
+	UpdatedBy   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// WebhookDelivery is one event for one webhook
+type WebhookDelivery struct {
+	ID             string
+	WebhookID      string
+	VenueID        string
+	EventID        string
+	EventType      string
+	Payload        string
+	Status         string // pending, sent, failed
+	Attempts       int32
+	NextAttemptAt  time.Time
+	ResponseStatus int32
+	Error          string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type WebhookDeliveryFilters struct {
+	VenueID   string
+	WebhookID string
+	EventType string
+	Status    string
+	Limit     int32
+	Offset    int32
+}
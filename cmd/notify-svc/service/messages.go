@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"booker/cmd/notify-svc/templates"
+	"booker/pkg/guestlink"
+	bookingpb "booker/pkg/proto/booking"
+	commonpb "booker/pkg/proto/common"
+	venuepb "booker/pkg/proto/venue"
+)
+
+// bookingData collects what templates show about a booking
+func (s *Service) bookingData(ctx context.Context, event string, b *bookingpb.Booking) (*templates.Data, error) {
+	venue, loc, err := s.venueData(ctx, b.VenueId)
+	if err != nil {
+		return nil, err
+	}
+	start, end := slotTimes(b.Slot, loc)
+
+	booking := &templates.Booking{
+		ID:         b.Id,
+		GuestName:  b.CustomerName,
+		GuestPhone: b.CustomerPhone,
+		PartySize:  b.PartySize,
+		Status:     b.Status,
+		Comment:    b.Comment,
+		Start:      start,
+		End:        end,
+	}
+
+	tableIDs := make([]string, 0, 1+len(b.CombinedTables))
+	if id := b.Table.GetTableId(); id != "" {
+		tableIDs = append(tableIDs, id)
+	}
+	for _, ref := range b.CombinedTables {
+		tableIDs = append(tableIDs, ref.TableId)
+	}
+	names := make([]string, 0, len(tableIDs))
+	for i, id := range tableIDs {
+		table, err := s.venueClient.GetTable(ctx, &venuepb.GetTableRequest{Id: id})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get table %s: %w", id, err)
+		}
+		names = append(names, table.Name)
+		if i == 0 {
+			booking.Table = templates.Table{ID: table.Id, Name: table.Name, Zone: table.Zone}
+		}
+	}
+	booking.Tables = strings.Join(names, ", ")
+
+	if booking.Room, err = s.roomName(ctx, b.Table.GetRoomId()); err != nil {
+		return nil, err
+	}
+	booking.ManageURL = s.guestLink(b)
+
+	return &templates.Data{Event: event, Venue: *venue, Booking: booking}, nil
+}
+
+// roomHireData collects what templates show about a room hire
+func (s *Service) roomHireData(ctx context.Context, event string, h *bookingpb.RoomHire) (*templates.Data, error) {
+	venue, loc, err := s.venueData(ctx, h.VenueId)
+	if err != nil {
+		return nil, err
+	}
+	start, end := slotTimes(h.Slot, loc)
+
+	room, err := s.roomName(ctx, h.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &templates.Data{
+		Event: event,
+		Venue: *venue,
+		RoomHire: &templates.RoomHire{
+			ID:           h.Id,
+			EventName:    h.EventName,
+			Headcount:    h.Headcount,
+			ContactName:  h.ContactName,
+			ContactPhone: h.ContactPhone,
+			ContactEmail: h.ContactEmail,
+			Status:       h.Status,
+			Comment:      h.Comment,
+			Start:        start,
+			End:          end,
+			Room:         room,
+		},
+	}, nil
+}
+
+// guestLink returns the self-service link of a booking, empty if links are off.
+// The link is bound to the current revision of the booking, so every message
+// carries a fresh one and older ones stop working
+func (s *Service) guestLink(b *bookingpb.Booking) string {
+	if s.cfg.GuestLinkSecret == "" || (b.Status != "held" && b.Status != "confirmed") {
+		return ""
+	}
+	token := guestlink.Sign(s.cfg.GuestLinkSecret, &guestlink.Claims{
+		BookingID: b.Id,
+		Sequence:  b.Sequence,
+		ExpiresAt: time.Now().Add(time.Duration(s.cfg.GuestLinkHours) * time.Hour),
+	})
+	return guestlink.URL(s.cfg.PublicURL, token)
+}
+
+// venueData loads the venue and its time zone, UTC if the zone is unknown
+func (s *Service) venueData(ctx context.Context, venueID string) (*templates.Venue, *time.Location, error) {
+	venue, err := s.venueClient.GetVenue(ctx, &venuepb.GetVenueRequest{Id: venueID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get venue %s: %w", venueID, err)
+	}
+	loc, err := time.LoadLocation(venue.Timezone)
+	if err != nil {
+		log.Warn().Err(err).Str("venue_id", venueID).Str("timezone", venue.Timezone).Msg("Unknown venue timezone, using UTC")
+		loc = time.UTC
+	}
+	return &templates.Venue{ID: venue.Id, Name: venue.Name, Address: venue.Address, Timezone: loc.String()}, loc, nil
+}
+
+func (s *Service) roomName(ctx context.Context, roomID string) (string, error) {
+	if roomID == "" {
+		return "", nil
+	}
+	room, err := s.venueClient.GetRoom(ctx, &venuepb.GetRoomRequest{Id: roomID})
+	if err != nil {
+		return "", fmt.Errorf("failed to get room %s: %w", roomID, err)
+	}
+	return room.Name, nil
+}
+
+// slotTimes turns a slot, which holds the venue's wall clock time, into its
+// start and end in the venue's time zone
+func slotTimes(slot *commonpb.Slot, loc *time.Location) (start, end time.Time) {
+	startTime := slot.GetStartTime()
+	if len(startTime) > 5 {
+		startTime = startTime[:5]
+	}
+	start, err := time.ParseInLocation("2006-01-02 15:04", slot.GetDate()+" "+startTime, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}
+	}
+	return start, start.Add(time.Duration(slot.GetDurationMinutes()) * time.Minute)
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"booker/cmd/notify-svc/notifier"
+	"booker/cmd/notify-svc/repository"
+	"booker/cmd/notify-svc/templates"
+	bookingpb "booker/pkg/proto/booking"
+	"booker/pkg/tracing"
+)
+
+const (
+	bookingTopicPrefix  = "booking."
+	roomHireTopicPrefix = "room_hire."
+)
+
+// Event is a decoded booking or room hire event
+type Event struct {
+	ID     string // the same every time Kafka delivers the event
+	Topic  string
+	Key    string // booking or room hire ID
+	Reason string // why the booking or room hire was cancelled or moved, if given
+}
+
+// eventStatuses lists the statuses a booking or room hire may have for its event
+// to still be told to the guest. Events of one booking arrive on different topics
+// in no particular order, and one that a later change has overtaken is stale
+var eventStatuses = map[string][]string{
+	"booking.confirmed":   {"confirmed"},
+	"booking.cancelled":   {"cancelled"},
+	"booking.no_show":     {"no_show"},
+	"booking.moved":       {"held", "confirmed"},
+	"room_hire.confirmed": {"confirmed"},
+	"room_hire.cancelled": {"cancelled"},
+}
+
+// notification is what a guest should be told about an event and how to reach them
+type notification struct {
+	venueID  string
+	status   string // current status of the booking or room hire
+	data     *templates.Data
+	contacts map[string]string // guest's recipient per channel
+}
+
+// deliveryTarget is one channel a notification goes out on; skip explains why
+// it cannot be sent
+type deliveryTarget struct {
+	channel   string
+	recipient string
+	skip      string
+}
+
+// HandleEvent reacts to a booking or room hire event: it keeps the reminders of
+// the booking up to date and notifies the guest. Handling is idempotent: an event
+// handled before is skipped, and a retried one only goes out on channels that
+// have not been done yet. The event is recorded as processed once all of it succeeded
+func (s *Service) HandleEvent(ctx context.Context, e *Event) error {
+	ctx, span := tracing.StartSpan(ctx, "HandleEvent")
+	defer span.End()
+
+	processed, err := s.repo.IsEventProcessed(ctx, e.ID)
+	if err != nil {
+		return fmt.Errorf("failed to check event %s: %w", e.ID, err)
+	}
+	if processed {
+		log.Info().Str("event_id", e.ID).Str("topic", e.Topic).Str("key", e.Key).Msg("Skipping event processed before")
+		return nil
+	}
+
+	var errs []error
+	if strings.HasPrefix(e.Topic, bookingTopicPrefix) {
+		if err := s.syncReminders(ctx, e.Key); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update reminders of booking %s: %w", e.Key, err))
+		}
+	}
+	if slices.Contains(templates.Events, e.Topic) {
+		if err := s.notify(ctx, e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if err := s.repo.MarkEventProcessed(ctx, e.ID, e.Topic, e.Key); err != nil {
+		return fmt.Errorf("failed to record event %s: %w", e.ID, err)
+	}
+	return nil
+}
+
+// notify tells the guest about an event unless it is stale
+func (s *Service) notify(ctx context.Context, e *Event) error {
+	n, err := s.loadNotification(ctx, e.Topic, e.Key)
+	if err != nil {
+		return err
+	}
+	if !eventIsCurrent(e.Topic, n.status) {
+		log.Info().Str("event_id", e.ID).Str("topic", e.Topic).Str("key", e.Key).Str("status", n.status).Msg("Skipping stale event")
+		return nil
+	}
+	n.data.Reason = e.Reason
+	return s.send(ctx, e, n)
+}
+
+// eventIsCurrent tells if an event still matches the status of its booking or room hire
+func eventIsCurrent(topic, status string) bool {
+	statuses, ok := eventStatuses[topic]
+	return !ok || slices.Contains(statuses, status)
+}
+
+// send delivers a notification over every channel of the venue and records each
+// attempt. Channels the event was already sent or skipped on are left out. It
+// fails if any channel failed to deliver
+func (s *Service) send(ctx context.Context, e *Event, n *notification) error {
+	channels, _, err := s.venueChannels(ctx, n.venueID)
+	if err != nil {
+		return err
+	}
+	locale, err := s.venueLocale(ctx, n.venueID)
+	if err != nil {
+		return err
+	}
+	done, err := s.repo.DoneChannels(ctx, e.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load deliveries of event %s: %w", e.ID, err)
+	}
+
+	var failed []string
+	for _, t := range deliveryTargets(channels, n.contacts) {
+		if done[t.channel] {
+			continue
+		}
+		d := &repository.Delivery{
+			VenueID:   n.venueID,
+			Topic:     e.Topic,
+			EventKey:  e.Key,
+			EventID:   e.ID,
+			Channel:   t.channel,
+			Recipient: t.recipient,
+		}
+		s.deliver(ctx, d, t, n, locale)
+		if err := s.repo.CreateDelivery(ctx, d); err != nil {
+			log.Error().Err(err).Str("event_key", e.Key).Str("channel", t.channel).Msg("Failed to record delivery")
+		}
+		if d.Status == "failed" {
+			failed = append(failed, t.channel)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("delivery of %s %s failed over %s", e.Topic, e.Key, strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// deliver renders the notification for one channel, sends it and fills in the outcome
+func (s *Service) deliver(ctx context.Context, d *repository.Delivery, t deliveryTarget, n *notification, locale string) {
+	sender, ok := s.notifiers[t.channel]
+	switch {
+	case t.skip != "":
+		d.Status, d.Error = "skipped", t.skip
+		return
+	case !ok:
+		d.Status, d.Error = "skipped", "channel is not configured"
+		return
+	}
+
+	subject, text, err := s.renderMessage(ctx, n.venueID, templates.Key{Event: d.Topic, Channel: t.channel, Locale: locale}, n.data)
+	if err != nil {
+		d.Status, d.Error = "failed", err.Error()
+		log.Error().Err(err).Str("event_key", d.EventKey).Str("channel", t.channel).Msg("Failed to render notification")
+		return
+	}
+
+	id, err := sender.Send(ctx, &notifier.Message{
+		Recipient: t.recipient,
+		Subject:   subject,
+		Text:      text,
+		HTML:      t.channel == notifier.ChannelEmail,
+	})
+	if err != nil {
+		d.Status, d.Error = "failed", err.Error()
+		log.Warn().Err(err).Str("event_key", d.EventKey).Str("channel", t.channel).Msg("Notification failed")
+		return
+	}
+	d.Status, d.ProviderMessageID = "sent", id
+	log.Info().Str("event_key", d.EventKey).Str("channel", t.channel).Str("topic", d.Topic).Msg("Notification sent")
+}
+
+// deliveryTargets picks a recipient for every enabled channel: the venue's own
+// recipient if set, otherwise the guest's contact for that channel
+func deliveryTargets(channels []*repository.VenueChannel, contacts map[string]string) []deliveryTarget {
+	var targets []deliveryTarget
+	for _, c := range channels {
+		if !c.Enabled {
+			continue
+		}
+		t := deliveryTarget{channel: c.Channel, recipient: c.Recipient}
+		if t.recipient == "" {
+			t.recipient = contacts[c.Channel]
+		}
+		if t.recipient == "" {
+			t.skip = "guest has no contact for this channel"
+		}
+		targets = append(targets, t)
+	}
+	return targets
+}
+
+// loadNotification fetches the booking or room hire behind an event along with
+// everything its templates show
+func (s *Service) loadNotification(ctx context.Context, topic, key string) (*notification, error) {
+	if strings.HasPrefix(topic, roomHireTopicPrefix) {
+		hire, err := s.bookingClient.GetRoomHire(ctx, &bookingpb.GetRoomHireRequest{Id: key})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get room hire %s: %w", key, err)
+		}
+		data, err := s.roomHireData(ctx, topic, hire)
+		if err != nil {
+			return nil, err
+		}
+		return &notification{
+			venueID: hire.VenueId,
+			status:  hire.Status,
+			data:    data,
+			contacts: map[string]string{
+				notifier.ChannelSMS:   hire.ContactPhone,
+				notifier.ChannelEmail: hire.ContactEmail,
+			},
+		}, nil
+	}
+
+	booking, err := s.bookingClient.GetBooking(ctx, &bookingpb.GetBookingRequest{Id: key})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking %s: %w", key, err)
+	}
+	return s.bookingNotification(ctx, topic, booking)
+}
+
+func (s *Service) bookingNotification(ctx context.Context, topic string, booking *bookingpb.Booking) (*notification, error) {
+	data, err := s.bookingData(ctx, topic, booking)
+	if err != nil {
+		return nil, err
+	}
+	return &notification{
+		venueID: booking.VenueId,
+		status:  booking.Status,
+		data:    data,
+		contacts: map[string]string{
+			notifier.ChannelSMS: booking.CustomerPhone,
+		},
+	}, nil
+}
+
+// RunPurge forgets processed events older than retention once an hour until ctx
+// is done. Kafka does not redeliver events that old, so their IDs are not needed
+func (s *Service) RunPurge(ctx context.Context, retention time.Duration) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		purged, err := s.repo.PurgeProcessedEvents(ctx, retention)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to purge processed events")
+		} else if purged > 0 {
+			log.Info().Int64("purged", purged).Msg("Processed events purged")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
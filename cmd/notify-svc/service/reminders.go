@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"booker/cmd/notify-svc/repository"
+	bookingpb "booker/pkg/proto/booking"
+	notifypb "booker/pkg/proto/notify"
+)
+
+// reminderEvent is the template event type of reminders
+const reminderEvent = "booking.reminder"
+
+const (
+	maxReminderOffsets       = 5
+	maxReminderOffsetMinutes = 7 * 24 * 60
+)
+
+func (s *Service) GetReminderSettings(ctx context.Context, req *notifypb.GetReminderSettingsRequest) (*notifypb.ReminderSettings, error) {
+	if req.VenueId == "" {
+		return nil, fmt.Errorf("venue_id is required")
+	}
+
+	offsets, isDefault, err := s.venueReminderOffsets(ctx, req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+	return &notifypb.ReminderSettings{VenueId: req.VenueId, OffsetMinutes: offsets, IsDefault: isDefault}, nil
+}
+
+// SetReminderSettings stores the offsets of a venue and reschedules the reminders
+// of its upcoming bookings
+func (s *Service) SetReminderSettings(ctx context.Context, req *notifypb.SetReminderSettingsRequest) (*notifypb.ReminderSettings, error) {
+	if req.VenueId == "" {
+		return nil, fmt.Errorf("venue_id is required")
+	}
+
+	var offsets []int32
+	if !req.UseDefault {
+		if err := validateReminderOffsets(req.OffsetMinutes); err != nil {
+			return nil, err
+		}
+		offsets = append([]int32{}, req.OffsetMinutes...)
+		slices.Sort(offsets)
+		slices.Reverse(offsets)
+	}
+	if err := s.repo.SetVenueReminderOffsets(ctx, req.VenueId, offsets); err != nil {
+		return nil, fmt.Errorf("failed to save reminder offsets: %w", err)
+	}
+
+	log.Info().
+		Str("venue_id", req.VenueId).
+		Ints32("offsets", offsets).
+		Bool("use_default", req.UseDefault).
+		Str("admin_id", req.AdminId).
+		Msg("Reminder offsets updated")
+
+	s.rescheduleVenueReminders(ctx, req.VenueId)
+
+	return s.GetReminderSettings(ctx, &notifypb.GetReminderSettingsRequest{VenueId: req.VenueId})
+}
+
+func (s *Service) ListReminders(ctx context.Context, req *notifypb.ListRemindersRequest) (*notifypb.ListRemindersResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultDeliveriesLimit
+	}
+	if limit > maxDeliveriesLimit {
+		limit = maxDeliveriesLimit
+	}
+
+	reminders, total, err := s.repo.ListReminders(ctx, &repository.ReminderFilters{
+		VenueID:   req.VenueId,
+		BookingID: req.BookingId,
+		Status:    req.Status,
+		Limit:     limit,
+		Offset:    req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &notifypb.ListRemindersResponse{Reminders: make([]*notifypb.Reminder, len(reminders)), Total: total}
+	for i, r := range reminders {
+		resp.Reminders[i] = &notifypb.Reminder{
+			Id:            r.ID,
+			VenueId:       r.VenueID,
+			BookingId:     r.BookingID,
+			OffsetMinutes: r.OffsetMinutes,
+			SlotStart:     r.SlotStart.Unix(),
+			DueAt:         r.DueAt.Unix(),
+			Status:        r.Status,
+			Error:         r.Error,
+			UpdatedAt:     r.UpdatedAt.Unix(),
+			Attempts:      r.Attempts,
+		}
+	}
+	return resp, nil
+}
+
+// RunReminders sends due reminders every interval until ctx is done. Each reminder
+// is claimed with a row lock, so replicas running side by side never send the
+// same one, and reminders that came due while the service was down go out on start
+func (s *Service) RunReminders(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.sendDueReminders(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) sendDueReminders(ctx context.Context) {
+	for ctx.Err() == nil {
+		claim, err := s.repo.ClaimDueReminder(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to claim due reminder")
+			return
+		}
+		if claim == nil {
+			return
+		}
+
+		r := claim.Reminder
+		status, reason, err := s.sendReminder(ctx, r)
+		if err != nil && ctx.Err() != nil {
+			claim.Release(ctx)
+			return
+		}
+		// A failed reminder moves back in the queue, so the next due one is not held up
+		finishReminderAttempt(r, status, reason, err, s.cfg.ReminderAttempts, time.Now())
+		if err := claim.Finish(ctx); err != nil {
+			log.Error().Err(err).Str("reminder_id", r.ID).Msg("Failed to finish reminder")
+			return
+		}
+
+		logEvent := log.Info()
+		if err != nil {
+			logEvent = log.Warn().Err(err)
+		}
+		logEvent.
+			Str("reminder_id", r.ID).
+			Str("booking_id", r.BookingID).
+			Int32("offset_minutes", r.OffsetMinutes).
+			Int32("attempts", r.Attempts).
+			Str("status", r.Status).
+			Msg("Reminder processed")
+	}
+}
+
+// sendReminder checks the booking still starts when the reminder was scheduled for
+// and notifies the guest. It returns the final status of the reminder, or an error
+// if it should be tried again later. A retry only repeats the channels that failed
+func (s *Service) sendReminder(ctx context.Context, r *repository.Reminder) (status, reason string, err error) {
+	if !time.Now().Before(r.SlotStart) {
+		return "expired", "slot has started", nil
+	}
+
+	booking, err := s.bookingClient.GetBooking(ctx, &bookingpb.GetBookingRequest{Id: r.BookingID})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get booking %s: %w", r.BookingID, err)
+	}
+	if booking.Status != "confirmed" {
+		return "cancelled", "booking " + booking.Status, nil
+	}
+	start, err := s.bookingStart(ctx, booking)
+	if err != nil {
+		return "", "", err
+	}
+	if !start.Equal(r.SlotStart) {
+		return "cancelled", "booking rescheduled", nil
+	}
+
+	n, err := s.bookingNotification(ctx, reminderEvent, booking)
+	if err != nil {
+		return "", "", err
+	}
+	if err := s.send(ctx, &Event{ID: r.ID, Topic: reminderEvent, Key: booking.Id}, n); err != nil {
+		return "", "", err
+	}
+	return "sent", "", nil
+}
+
+// finishReminderAttempt records the outcome of an attempt: the status sendReminder
+// decided on, pending until the backoff is over, or failed once maxAttempts are used
+// up. A reminder retried past the slot start expires on its next attempt
+func finishReminderAttempt(r *repository.Reminder, status, reason string, err error, maxAttempts int, now time.Time) {
+	if err == nil {
+		r.Status = status
+		r.Error = reason
+		return
+	}
+	r.Attempts++
+	r.Error = err.Error()
+	if int(r.Attempts) >= maxAttempts {
+		r.Status = "failed"
+		return
+	}
+	r.Status = "pending"
+	r.DueAt = now.Add(backoff(r.Attempts))
+}
+
+// syncReminders brings the reminders of a booking in line with its current state
+func (s *Service) syncReminders(ctx context.Context, bookingID string) error {
+	booking, err := s.bookingClient.GetBooking(ctx, &bookingpb.GetBookingRequest{Id: bookingID})
+	if err != nil {
+		return fmt.Errorf("failed to get booking %s: %w", bookingID, err)
+	}
+	return s.scheduleReminders(ctx, booking)
+}
+
+// scheduleReminders schedules the reminders of a confirmed booking at the venue's
+// offsets before its start, and cancels pending ones of any other booking
+func (s *Service) scheduleReminders(ctx context.Context, booking *bookingpb.Booking) error {
+	if booking.Status != "confirmed" {
+		return s.repo.CancelReminders(ctx, booking.Id, "booking "+booking.Status)
+	}
+
+	start, err := s.bookingStart(ctx, booking)
+	if err != nil {
+		return err
+	}
+	offsets, _, err := s.venueReminderOffsets(ctx, booking.VenueId)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.ScheduleReminders(ctx, booking.Id, booking.VenueId, start, reminderTimes(start, offsets, time.Now()))
+}
+
+// rescheduleVenueReminders applies changed offsets to the upcoming bookings of a venue
+func (s *Service) rescheduleVenueReminders(ctx context.Context, venueID string) {
+	upcoming, err := s.bookingClient.ListUpcomingBookings(ctx, &bookingpb.ListUpcomingBookingsRequest{VenueId: venueID})
+	if err != nil {
+		log.Error().Err(err).Str("venue_id", venueID).Msg("Failed to list upcoming bookings, reminders keep their old offsets")
+		return
+	}
+
+	for _, b := range upcoming.Bookings {
+		if err := s.scheduleReminders(ctx, b); err != nil {
+			log.Error().Err(err).Str("booking_id", b.Id).Msg("Failed to reschedule reminders")
+		}
+	}
+}
+
+// bookingStart returns when a booking starts in the venue's time zone
+func (s *Service) bookingStart(ctx context.Context, booking *bookingpb.Booking) (time.Time, error) {
+	_, loc, err := s.venueData(ctx, booking.VenueId)
+	if err != nil {
+		return time.Time{}, err
+	}
+	start, _ := slotTimes(booking.Slot, loc)
+	if start.IsZero() {
+		return time.Time{}, fmt.Errorf("booking %s has an invalid slot", booking.Id)
+	}
+	return start, nil
+}
+
+// venueReminderOffsets returns the offsets of a venue, or the default ones if it
+// has not chosen any
+func (s *Service) venueReminderOffsets(ctx context.Context, venueID string) ([]int32, bool, error) {
+	offsets, found, err := s.repo.GetVenueReminderOffsets(ctx, venueID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to load reminder offsets: %w", err)
+	}
+	if !found {
+		return s.cfg.ReminderOffsets, true, nil
+	}
+	return offsets, false, nil
+}
+
+// reminderTimes returns when the reminder for each offset is due, leaving out the
+// ones that are already past
+func reminderTimes(start time.Time, offsets []int32, now time.Time) map[int32]time.Time {
+	due := make(map[int32]time.Time, len(offsets))
+	for _, offset := range offsets {
+		at := start.Add(-time.Duration(offset) * time.Minute)
+		if at.After(now) {
+			due[offset] = at
+		}
+	}
+	return due
+}
+
+func validateReminderOffsets(offsets []int32) error {
+	if len(offsets) > maxReminderOffsets {
+		return fmt.Errorf("at most %d reminders are allowed", maxReminderOffsets)
+	}
+	seen := make(map[int32]bool, len(offsets))
+	for _, offset := range offsets {
+		if offset <= 0 || offset > maxReminderOffsetMinutes {
+			return fmt.Errorf("reminder offset must be between 1 and %d minutes, got %d", maxReminderOffsetMinutes, offset)
+		}
+		if seen[offset] {
+			return fmt.Errorf("reminder offset %d is listed more than once", offset)
+		}
+		seen[offset] = true
+	}
+	return nil
+}
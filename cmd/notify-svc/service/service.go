@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"booker/cmd/notify-svc/config"
+	"booker/cmd/notify-svc/notifier"
+	"booker/cmd/notify-svc/repository"
+	"booker/cmd/notify-svc/templates"
+	"booker/cmd/notify-svc/webhook"
+	bookingpb "booker/pkg/proto/booking"
+	notifypb "booker/pkg/proto/notify"
+	venuepb "booker/pkg/proto/venue"
+)
+
+type Service struct {
+	notifypb.UnimplementedNotifyServiceServer
+	repo          *repository.Repository
+	bookingClient bookingpb.BookingServiceClient
+	venueClient   venuepb.VenueServiceClient
+	notifiers     map[string]notifier.Notifier
+	webhooks      *webhook.Client
+	cfg           *config.Config
+}
+
+// New creates the service with the notifiers of configured channels; channels
+// without a notifier are recorded as skipped
+func New(repo *repository.Repository, bookingClient bookingpb.BookingServiceClient, venueClient venuepb.VenueServiceClient,
+	notifiers []notifier.Notifier, cfg *config.Config) *Service {
+	byChannel := make(map[string]notifier.Notifier, len(notifiers))
+	for _, n := range notifiers {
+		byChannel[n.Channel()] = n
+	}
+	return &Service{
+		repo:          repo,
+		bookingClient: bookingClient,
+		venueClient:   venueClient,
+		notifiers:     byChannel,
+		webhooks:      webhook.NewClient(time.Duration(cfg.WebhookTimeout)*time.Second, cfg.WebhookPrivate),
+		cfg:           cfg,
+	}
+}
+
+func (s *Service) GetChannelSettings(ctx context.Context, req *notifypb.GetChannelSettingsRequest) (*notifypb.ChannelSettings, error) {
+	if req.VenueId == "" {
+		return nil, fmt.Errorf("venue_id is required")
+	}
+
+	channels, isDefault, err := s.venueChannels(ctx, req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+	locale, err := s.venueLocale(ctx, req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &notifypb.ChannelSettings{VenueId: req.VenueId, IsDefault: isDefault, Locale: locale, Channels: make([]*notifypb.Channel, len(channels))}
+	for i, c := range channels {
+		settings.Channels[i] = &notifypb.Channel{Channel: c.Channel, Enabled: c.Enabled, Recipient: c.Recipient}
+	}
+	return settings, nil
+}
+
+func (s *Service) SetChannelSettings(ctx context.Context, req *notifypb.SetChannelSettingsRequest) (*notifypb.ChannelSettings, error) {
+	if req.VenueId == "" {
+		return nil, fmt.Errorf("venue_id is required")
+	}
+	if req.Locale != "" && !slices.Contains(templates.Locales, req.Locale) {
+		return nil, fmt.Errorf("unknown locale %q, expected one of %v", req.Locale, templates.Locales)
+	}
+
+	channels := make([]*repository.VenueChannel, 0, len(req.Channels))
+	seen := make(map[string]bool, len(req.Channels))
+	for _, c := range req.Channels {
+		if !slices.Contains(notifier.Channels, c.Channel) {
+			return nil, fmt.Errorf("unknown channel %q, expected one of %v", c.Channel, notifier.Channels)
+		}
+		if seen[c.Channel] {
+			return nil, fmt.Errorf("channel %s is listed more than once", c.Channel)
+		}
+		seen[c.Channel] = true
+		channels = append(channels, &repository.VenueChannel{Channel: c.Channel, Enabled: c.Enabled, Recipient: c.Recipient})
+	}
+
+	if err := s.repo.SetVenueChannels(ctx, req.VenueId, channels); err != nil {
+		return nil, fmt.Errorf("failed to save channels: %w", err)
+	}
+	if err := s.repo.SetVenueLocale(ctx, req.VenueId, req.Locale); err != nil {
+		return nil, fmt.Errorf("failed to save locale: %w", err)
+	}
+
+	log.Info().Str("venue_id", req.VenueId).Str("admin_id", req.AdminId).Int("channels", len(channels)).Str("locale", req.Locale).Msg("Notification channels updated")
+	return s.GetChannelSettings(ctx, &notifypb.GetChannelSettingsRequest{VenueId: req.VenueId})
+}
+
+const (
+	defaultDeliveriesLimit = 50
+	maxDeliveriesLimit     = 200
+)
+
+func (s *Service) ListDeliveries(ctx context.Context, req *notifypb.ListDeliveriesRequest) (*notifypb.ListDeliveriesResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultDeliveriesLimit
+	}
+	if limit > maxDeliveriesLimit {
+		limit = maxDeliveriesLimit
+	}
+
+	deliveries, total, err := s.repo.ListDeliveries(ctx, &repository.DeliveryFilters{
+		VenueID:  req.VenueId,
+		EventKey: req.EventKey,
+		Channel:  req.Channel,
+		Status:   req.Status,
+		Limit:    limit,
+		Offset:   req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &notifypb.ListDeliveriesResponse{Deliveries: make([]*notifypb.Delivery, len(deliveries)), Total: total}
+	for i, d := range deliveries {
+		resp.Deliveries[i] = &notifypb.Delivery{
+			Id:                d.ID,
+			VenueId:           d.VenueID,
+			Topic:             d.Topic,
+			EventKey:          d.EventKey,
+			Channel:           d.Channel,
+			Recipient:         d.Recipient,
+			Status:            d.Status,
+			Error:             d.Error,
+			ProviderMessageId: d.ProviderMessageID,
+			CreatedAt:         d.CreatedAt.Unix(),
+			EventId:           d.EventID,
+		}
+	}
+	return resp, nil
+}
+
+// venueChannels returns the channels of a venue, or the default channels, enabled
+// and addressed to the guest, if the venue has not chosen any
+func (s *Service) venueChannels(ctx context.Context, venueID string) ([]*repository.VenueChannel, bool, error) {
+	channels, err := s.repo.GetVenueChannels(ctx, venueID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to load channels: %w", err)
+	}
+	if len(channels) > 0 {
+		return channels, false, nil
+	}
+
+	defaults := make([]*repository.VenueChannel, len(s.cfg.DefaultChannels))
+	for i, channel := range s.cfg.DefaultChannels {
+		defaults[i] = &repository.VenueChannel{VenueID: venueID, Channel: channel, Enabled: true}
+	}
+	return defaults, true, nil
+}
+
+// venueLocale returns the locale guests of a venue are notified in
+func (s *Service) venueLocale(ctx context.Context, venueID string) (string, error) {
+	locale, err := s.repo.GetVenueLocale(ctx, venueID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load locale: %w", err)
+	}
+	if locale == "" {
+		return s.cfg.DefaultLocale, nil
+	}
+	return locale, nil
+}
+
+// Retries of failed webhook deliveries and reminders: the first one after backoffBase,
+// doubling up to backoffMax
+const (
+	backoffBase = 30 * time.Second
+	backoffMax  = 6 * time.Hour
+)
+
+// backoff returns how long to wait before the next attempt after the given
+// number of failed ones
+func backoff(attempts int32) time.Duration {
+	wait := backoffBase
+	for i := int32(1); i < attempts; i++ {
+		wait *= 2
+		if wait >= backoffMax {
+			return backoffMax
+		}
+	}
+	return wait
+}
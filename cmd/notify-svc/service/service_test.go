@@ -0,0 +1,171 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"booker/cmd/notify-svc/repository"
+	commonpb "booker/pkg/proto/common"
+)
+
+func TestDeliveryTargets(t *testing.T) {
+	channels := []*repository.VenueChannel{
+		{Channel: "sms", Enabled: true},
+		{Channel: "email", Enabled: true},
+		{Channel: "telegram", Enabled: true, Recipient: "-100123"},
+		{Channel: "email", Enabled: false},
+	}
+	contacts := map[string]string{"sms": "+79161234567"}
+
+	targets := deliveryTargets(channels, contacts)
+
+	assert.Equal(t, []deliveryTarget{
+		{channel: "sms", recipient: "+79161234567"},
+		{channel: "email", skip: "guest has no contact for this channel"},
+		{channel: "telegram", recipient: "-100123"},
+	}, targets)
+}
+
+func TestSlotTimes(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	start, end := slotTimes(&commonpb.Slot{Date: "2026-03-06", StartTime: "19:00:00", DurationMinutes: 90}, loc)
+	assert.Equal(t, time.Date(2026, time.March, 6, 19, 0, 0, 0, loc), start)
+	assert.Equal(t, time.Date(2026, time.March, 6, 20, 30, 0, 0, loc), end)
+	assert.Equal(t, "2026-03-06T16:00:00Z", start.UTC().Format(time.RFC3339))
+
+	start, _ = slotTimes(&commonpb.Slot{Date: "bad"}, loc)
+	assert.True(t, start.IsZero())
+}
+
+func TestReminderTimes(t *testing.T) {
+	start := time.Date(2026, time.March, 6, 19, 0, 0, 0, time.UTC)
+
+	due := reminderTimes(start, []int32{1440, 120}, start.Add(-48*time.Hour))
+	assert.Equal(t, map[int32]time.Time{
+		1440: start.Add(-24 * time.Hour),
+		120:  start.Add(-2 * time.Hour),
+	}, due)
+
+	// Confirmed 3 hours ahead: the day-before reminder is already past
+	due = reminderTimes(start, []int32{1440, 120}, start.Add(-3*time.Hour))
+	assert.Equal(t, map[int32]time.Time{120: start.Add(-2 * time.Hour)}, due)
+
+	assert.Empty(t, reminderTimes(start, nil, start.Add(-48*time.Hour)))
+}
+
+func TestValidateReminderOffsets(t *testing.T) {
+	assert.NoError(t, validateReminderOffsets([]int32{1440, 120}))
+	assert.NoError(t, validateReminderOffsets(nil))
+	assert.Error(t, validateReminderOffsets([]int32{0}))
+	assert.Error(t, validateReminderOffsets([]int32{maxReminderOffsetMinutes + 1}))
+	assert.Error(t, validateReminderOffsets([]int32{120, 120}))
+	assert.Error(t, validateReminderOffsets([]int32{10, 20, 30, 40, 50, 60}))
+}
+
+func TestEventIsCurrent(t *testing.T) {
+	assert.True(t, eventIsCurrent("booking.confirmed", "confirmed"))
+	assert.False(t, eventIsCurrent("booking.confirmed", "cancelled"), "confirmation overtaken by a cancellation")
+	assert.True(t, eventIsCurrent("booking.moved", "held"))
+	assert.True(t, eventIsCurrent("booking.moved", "confirmed"))
+	assert.False(t, eventIsCurrent("booking.moved", "no_show"))
+	assert.False(t, eventIsCurrent("room_hire.cancelled", "confirmed"))
+	assert.True(t, eventIsCurrent("booking.reminder", "confirmed"), "events without statuses are always current")
+}
+
+func TestFinishWebhookAttempt(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	d := &repository.WebhookDelivery{Status: "pending", Error: "timeout"}
+	finishWebhookAttempt(d, 204, nil, 3, now)
+	assert.Equal(t, "sent", d.Status)
+	assert.Equal(t, int32(1), d.Attempts)
+	assert.Equal(t, int32(204), d.ResponseStatus)
+	assert.Empty(t, d.Error)
+
+	d = &repository.WebhookDelivery{Status: "pending", Attempts: 1}
+	finishWebhookAttempt(d, 503, errors.New("unexpected status 503"), 3, now)
+	assert.Equal(t, "pending", d.Status)
+	assert.Equal(t, int32(2), d.Attempts)
+	assert.Equal(t, now.Add(time.Minute), d.NextAttemptAt, "backoff doubles after each failure")
+	assert.Equal(t, "unexpected status 503", d.Error)
+
+	finishWebhookAttempt(d, 0, errors.New("connection refused"), 3, now)
+	assert.Equal(t, "failed", d.Status)
+	assert.Equal(t, int32(3), d.Attempts)
+	assert.Equal(t, "connection refused", d.Error)
+}
+
+func TestFinishReminderAttempt(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	due := now.Add(-time.Minute)
+
+	r := &repository.Reminder{Status: "pending", DueAt: due}
+	finishReminderAttempt(r, "", "", errors.New("template not found"), 3, now)
+	assert.Equal(t, "pending", r.Status)
+	assert.Equal(t, int32(1), r.Attempts)
+	assert.Equal(t, now.Add(30*time.Second), r.DueAt, "a failed reminder moves back in the queue")
+	assert.Equal(t, "template not found", r.Error)
+
+	finishReminderAttempt(r, "", "", errors.New("delivery failed over sms"), 3, now)
+	assert.Equal(t, "pending", r.Status)
+	assert.Equal(t, now.Add(time.Minute), r.DueAt, "backoff doubles after each failure")
+
+	finishReminderAttempt(r, "sent", "", nil, 3, now)
+	assert.Equal(t, "sent", r.Status)
+	assert.Empty(t, r.Error)
+	assert.Equal(t, int32(2), r.Attempts)
+
+	r = &repository.Reminder{Status: "pending", Attempts: 2, DueAt: due}
+	finishReminderAttempt(r, "", "", errors.New("booking not found"), 3, now)
+	assert.Equal(t, "failed", r.Status)
+	assert.Equal(t, int32(3), r.Attempts)
+	assert.Equal(t, due, r.DueAt)
+	assert.Equal(t, "booking not found", r.Error)
+
+	r = &repository.Reminder{Status: "pending", DueAt: due}
+	finishReminderAttempt(r, "cancelled", "booking cancelled", nil, 3, now)
+	assert.Equal(t, "cancelled", r.Status)
+	assert.Equal(t, "booking cancelled", r.Error)
+	assert.Zero(t, r.Attempts)
+}
+
+func TestWebhookPayload(t *testing.T) {
+	payload, err := webhookPayload("evt-1", "booking.confirmed", time.Date(2026, time.March, 6, 16, 0, 0, 0, time.UTC), "venue-1",
+		[]byte(`{"booking_id":"booking-1"}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": "evt-1",
+		"type": "booking.confirmed",
+		"created_at": "2026-03-06T16:00:00Z",
+		"venue_id": "venue-1",
+		"data": {"booking_id": "booking-1"}
+	}`, payload)
+}
+
+func TestValidateWebhook(t *testing.T) {
+	assert.NoError(t, validateWebhook("https://partner.example.com/hooks", nil, false))
+	assert.NoError(t, validateWebhook("https://partner.example.com/hooks", []string{"booking.confirmed", "room_hire.cancelled"}, false))
+	assert.Error(t, validateWebhook("partner.example.com/hooks", nil, false))
+	assert.Error(t, validateWebhook("ftp://partner.example.com", nil, false))
+	assert.Error(t, validateWebhook("https://partner.example.com", []string{"booking.reminder"}, false))
+
+	// Internal targets only with WEBHOOK_ALLOW_PRIVATE
+	for _, u := range []string{"http://localhost:8080/hooks", "http://booking-svc:8080/", "http://169.254.169.254/latest/meta-data", "http://[::1]/", "http://10.0.0.5/"} {
+		assert.Error(t, validateWebhook(u, nil, false), u)
+		assert.NoError(t, validateWebhook(u, nil, true), u)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, backoff(1))
+	assert.Equal(t, time.Minute, backoff(2))
+	assert.Equal(t, 4*time.Minute, backoff(4))
+	assert.Equal(t, backoffMax, backoff(20))
+	assert.Equal(t, backoffMax, backoff(1000))
+}
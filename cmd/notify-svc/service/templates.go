@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/rs/zerolog/log"
+
+	"booker/cmd/notify-svc/notifier"
+	"booker/cmd/notify-svc/repository"
+	"booker/cmd/notify-svc/templates"
+	notifypb "booker/pkg/proto/notify"
+)
+
+// Where the template in effect comes from
+const (
+	templateSourceBuiltin = "builtin"
+	templateSourceService = "service"
+	templateSourceVenue   = "venue"
+)
+
+func (s *Service) ListTemplates(ctx context.Context, req *notifypb.ListTemplatesRequest) (*notifypb.ListTemplatesResponse, error) {
+	list, err := s.effectiveTemplates(ctx, req.VenueId, &repository.TemplateFilters{
+		VenueID: req.VenueId,
+		Event:   req.EventType,
+		Channel: req.Channel,
+		Locale:  req.Locale,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &notifypb.ListTemplatesResponse{Templates: list}, nil
+}
+
+func (s *Service) SetTemplate(ctx context.Context, req *notifypb.SetTemplateRequest) (*notifypb.MessageTemplate, error) {
+	key := templates.Key{Event: req.EventType, Channel: req.Channel, Locale: req.Locale}
+	if err := validateTemplateKey(key); err != nil {
+		return nil, err
+	}
+	if req.Body == "" {
+		return nil, fmt.Errorf("body is required")
+	}
+	t := &templates.Template{Subject: req.Subject, Body: req.Body}
+	if _, _, err := templates.Render(t, key.Channel, key.Locale, templates.Sample(key.Event)); err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+
+	stored := &repository.MessageTemplate{
+		VenueID:   req.VenueId,
+		Event:     key.Event,
+		Channel:   key.Channel,
+		Locale:    key.Locale,
+		Subject:   req.Subject,
+		Body:      req.Body,
+		UpdatedBy: req.AdminId,
+	}
+	if err := s.repo.SetTemplate(ctx, stored); err != nil {
+		return nil, fmt.Errorf("failed to save template: %w", err)
+	}
+
+	log.Info().
+		Str("venue_id", req.VenueId).
+		Str("event_type", key.Event).
+		Str("channel", key.Channel).
+		Str("locale", key.Locale).
+		Str("admin_id", req.AdminId).
+		Msg("Message template updated")
+
+	return toTemplateProto(stored), nil
+}
+
+func (s *Service) DeleteTemplate(ctx context.Context, req *notifypb.DeleteTemplateRequest) (*notifypb.MessageTemplate, error) {
+	key := templates.Key{Event: req.EventType, Channel: req.Channel, Locale: req.Locale}
+	if err := validateTemplateKey(key); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.DeleteTemplate(ctx, req.VenueId, key.Event, key.Channel, key.Locale); err != nil {
+		return nil, fmt.Errorf("failed to delete template: %w", err)
+	}
+
+	log.Info().
+		Str("venue_id", req.VenueId).
+		Str("event_type", key.Event).
+		Str("channel", key.Channel).
+		Str("locale", key.Locale).
+		Msg("Message template reset")
+
+	return s.resolveTemplate(ctx, req.VenueId, key)
+}
+
+// PreviewTemplate renders the given template, or the one in effect if the body is
+// empty, with a real booking or room hire or with sample data
+func (s *Service) PreviewTemplate(ctx context.Context, req *notifypb.PreviewTemplateRequest) (*notifypb.PreviewTemplateResponse, error) {
+	venueID := req.VenueId
+	data := templates.Sample(req.EventType)
+	if req.EventKey != "" {
+		n, err := s.loadNotification(ctx, req.EventType, req.EventKey)
+		if err != nil {
+			return nil, err
+		}
+		data = n.data
+		if venueID == "" {
+			venueID = n.venueID
+		}
+	}
+
+	key := templates.Key{Event: req.EventType, Channel: req.Channel, Locale: req.Locale}
+	if key.Locale == "" && venueID != "" {
+		locale, err := s.venueLocale(ctx, venueID)
+		if err != nil {
+			return nil, err
+		}
+		key.Locale = locale
+	}
+	if err := validateTemplateKey(key); err != nil {
+		return nil, err
+	}
+
+	t := &templates.Template{Subject: req.Subject, Body: req.Body}
+	if t.Body == "" {
+		current, err := s.resolveTemplate(ctx, venueID, key)
+		if err != nil {
+			return nil, err
+		}
+		t = &templates.Template{Subject: current.Subject, Body: current.Body}
+	}
+
+	subject, body, err := templates.Render(t, key.Channel, key.Locale, data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return &notifypb.PreviewTemplateResponse{Subject: subject, Body: body}, nil
+}
+
+// renderMessage renders the template in effect for a venue
+func (s *Service) renderMessage(ctx context.Context, venueID string, key templates.Key, data *templates.Data) (string, string, error) {
+	current, err := s.resolveTemplate(ctx, venueID, key)
+	if err != nil {
+		return "", "", err
+	}
+	subject, body, err := templates.Render(&templates.Template{Subject: current.Subject, Body: current.Body}, key.Channel, key.Locale, data)
+	if err != nil {
+		return "", "", fmt.Errorf("template %s/%s/%s (%s): %w", key.Event, key.Channel, key.Locale, current.Source, err)
+	}
+	return subject, body, nil
+}
+
+func (s *Service) resolveTemplate(ctx context.Context, venueID string, key templates.Key) (*notifypb.MessageTemplate, error) {
+	list, err := s.effectiveTemplates(ctx, venueID, &repository.TemplateFilters{
+		VenueID: venueID,
+		Event:   key.Event,
+		Channel: key.Channel,
+		Locale:  key.Locale,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, fmt.Errorf("no template for %s/%s/%s", key.Event, key.Channel, key.Locale)
+	}
+	return list[0], nil
+}
+
+// effectiveTemplates returns the template in effect for every event, channel and
+// locale matching the filters: the venue's own, else the service-wide override,
+// else the built-in one
+func (s *Service) effectiveTemplates(ctx context.Context, venueID string, filters *repository.TemplateFilters) ([]*notifypb.MessageTemplate, error) {
+	overrides, err := s.repo.ListTemplates(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load templates: %w", err)
+	}
+	stored := make(map[templates.Key]*repository.MessageTemplate, len(overrides))
+	for _, t := range overrides {
+		key := templates.Key{Event: t.Event, Channel: t.Channel, Locale: t.Locale}
+		if prev, ok := stored[key]; ok && prev.VenueID != "" {
+			continue
+		}
+		stored[key] = t
+	}
+
+	var list []*notifypb.MessageTemplate
+	for _, event := range templates.Events {
+		if filters.Event != "" && filters.Event != event {
+			continue
+		}
+		for _, channel := range notifier.Channels {
+			if filters.Channel != "" && filters.Channel != channel {
+				continue
+			}
+			for _, locale := range templates.Locales {
+				if filters.Locale != "" && filters.Locale != locale {
+					continue
+				}
+				key := templates.Key{Event: event, Channel: channel, Locale: locale}
+				if t, ok := stored[key]; ok {
+					list = append(list, toTemplateProto(t))
+					continue
+				}
+				if t, ok := templates.Builtin(key); ok {
+					list = append(list, &notifypb.MessageTemplate{
+						VenueId:   venueID,
+						EventType: event,
+						Channel:   channel,
+						Locale:    locale,
+						Subject:   t.Subject,
+						Body:      t.Body,
+						Source:    templateSourceBuiltin,
+					})
+				}
+			}
+		}
+	}
+	return list, nil
+}
+
+func validateTemplateKey(key templates.Key) error {
+	if !slices.Contains(templates.Events, key.Event) {
+		return fmt.Errorf("unknown event type %q, expected one of %v", key.Event, templates.Events)
+	}
+	if !slices.Contains(notifier.Channels, key.Channel) {
+		return fmt.Errorf("unknown channel %q, expected one of %v", key.Channel, notifier.Channels)
+	}
+	if !slices.Contains(templates.Locales, key.Locale) {
+		return fmt.Errorf("unknown locale %q, expected one of %v", key.Locale, templates.Locales)
+	}
+	return nil
+}
+
+func toTemplateProto(t *repository.MessageTemplate) *notifypb.MessageTemplate {
+	source := templateSourceVenue
+	if t.VenueID == "" {
+		source = templateSourceService
+	}
+	return &notifypb.MessageTemplate{
+		VenueId:   t.VenueID,
+		EventType: t.Event,
+		Channel:   t.Channel,
+		Locale:    t.Locale,
+		Subject:   t.Subject,
+		Body:      t.Body,
+		Source:    source,
+		UpdatedBy: t.UpdatedBy,
+		UpdatedAt: t.UpdatedAt.Unix(),
+	}
+}
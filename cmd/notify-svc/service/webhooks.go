@@ -0,0 +1,488 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"booker/cmd/notify-svc/repository"
+	"booker/cmd/notify-svc/webhook"
+	bookingpb "booker/pkg/proto/booking"
+	notifypb "booker/pkg/proto/notify"
+)
+
+// WebhookEventTypes lists the events webhooks can subscribe to; each is a Kafka topic
+var WebhookEventTypes = []string{
+	"booking.held", "booking.confirmed", "booking.cancelled", "booking.expired",
+	"booking.seated", "booking.finished", "booking.no_show", "booking.moved",
+	"room_hire.created", "room_hire.confirmed", "room_hire.cancelled", "room_hire.completed",
+}
+
+// pingEvent is the event type of test requests
+const pingEvent = "ping"
+
+const maxWebhooksPerVenue = 10
+
+// WebhookEvent is a booking or room hire event to pass on to webhooks. VenueID is
+// looked up from the booking if the event does not carry it
+type WebhookEvent struct {
+	ID        string
+	Type      string
+	Key       string
+	VenueID   string
+	CreatedAt time.Time
+	Data      proto.Message
+}
+
+// webhookBody is the JSON body of webhook requests
+type webhookBody struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt string          `json:"created_at"`
+	VenueID   string          `json:"venue_id"`
+	Data      json.RawMessage `json:"data"`
+}
+
+func (s *Service) ListWebhooks(ctx context.Context, req *notifypb.ListWebhooksRequest) (*notifypb.ListWebhooksResponse, error) {
+	if req.VenueId == "" {
+		return nil, fmt.Errorf("venue_id is required")
+	}
+
+	webhooks, err := s.repo.ListWebhooks(ctx, req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &notifypb.ListWebhooksResponse{Webhooks: make([]*notifypb.Webhook, len(webhooks))}
+	for i, w := range webhooks {
+		resp.Webhooks[i] = toWebhookProto(w)
+	}
+	return resp, nil
+}
+
+// CreateWebhook subscribes a URL to events of a venue, generating its secret if
+// none is given
+func (s *Service) CreateWebhook(ctx context.Context, req *notifypb.CreateWebhookRequest) (*notifypb.Webhook, error) {
+	if req.VenueId == "" {
+		return nil, fmt.Errorf("venue_id is required")
+	}
+	if err := validateWebhook(req.Url, req.EventTypes, s.cfg.WebhookPrivate); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.ListWebhooks(ctx, req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= maxWebhooksPerVenue {
+		return nil, fmt.Errorf("a venue can have at most %d webhooks", maxWebhooksPerVenue)
+	}
+
+	w := &repository.Webhook{
+		VenueID:     req.VenueId,
+		URL:         req.Url,
+		Secret:      req.Secret,
+		EventTypes:  uniqueStrings(req.EventTypes),
+		Enabled:     req.Enabled,
+		Description: req.Description,
+		UpdatedBy:   req.AdminId,
+	}
+	if w.Secret == "" {
+		w.Secret = newWebhookSecret()
+	}
+	if err := s.repo.CreateWebhook(ctx, w); err != nil {
+		return nil, fmt.Errorf("failed to save webhook: %w", err)
+	}
+
+	log.Info().Str("webhook_id", w.ID).Str("venue_id", w.VenueID).Str("url", w.URL).Str("admin_id", req.AdminId).Msg("Webhook created")
+	return toWebhookProto(w), nil
+}
+
+// UpdateWebhook replaces the settings of a webhook. The secret is kept unless a
+// new one is given or rotation is asked for
+func (s *Service) UpdateWebhook(ctx context.Context, req *notifypb.UpdateWebhookRequest) (*notifypb.Webhook, error) {
+	if req.Id == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+	if err := validateWebhook(req.Url, req.EventTypes, s.cfg.WebhookPrivate); err != nil {
+		return nil, err
+	}
+
+	w, err := s.repo.GetWebhook(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	w.URL = req.Url
+	w.EventTypes = uniqueStrings(req.EventTypes)
+	w.Enabled = req.Enabled
+	w.Description = req.Description
+	w.UpdatedBy = req.AdminId
+	switch {
+	case req.RotateSecret:
+		w.Secret = newWebhookSecret()
+	case req.Secret != "":
+		w.Secret = req.Secret
+	}
+	if err := s.repo.UpdateWebhook(ctx, w); err != nil {
+		return nil, fmt.Errorf("failed to save webhook: %w", err)
+	}
+
+	log.Info().Str("webhook_id", w.ID).Str("venue_id", w.VenueID).Bool("enabled", w.Enabled).Str("admin_id", req.AdminId).Msg("Webhook updated")
+	return toWebhookProto(w), nil
+}
+
+func (s *Service) DeleteWebhook(ctx context.Context, req *notifypb.DeleteWebhookRequest) (*notifypb.DeleteWebhookResponse, error) {
+	if req.Id == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+	if err := s.repo.DeleteWebhook(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	log.Info().Str("webhook_id", req.Id).Str("admin_id", req.AdminId).Msg("Webhook deleted")
+	return &notifypb.DeleteWebhookResponse{Success: true}, nil
+}
+
+func (s *Service) ListWebhookDeliveries(ctx context.Context, req *notifypb.ListWebhookDeliveriesRequest) (*notifypb.ListWebhookDeliveriesResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultDeliveriesLimit
+	}
+	if limit > maxDeliveriesLimit {
+		limit = maxDeliveriesLimit
+	}
+
+	deliveries, total, err := s.repo.ListWebhookDeliveries(ctx, &repository.WebhookDeliveryFilters{
+		VenueID:   req.VenueId,
+		WebhookID: req.WebhookId,
+		EventType: req.EventType,
+		Status:    req.Status,
+		Limit:     limit,
+		Offset:    req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &notifypb.ListWebhookDeliveriesResponse{Deliveries: make([]*notifypb.WebhookDelivery, len(deliveries)), Total: total}
+	for i, d := range deliveries {
+		resp.Deliveries[i] = toWebhookDeliveryProto(d)
+	}
+	return resp, nil
+}
+
+// ReplayWebhookDelivery sends a delivery again, whatever its status, with a fresh
+// set of attempts. The worker picks it up on its next round
+func (s *Service) ReplayWebhookDelivery(ctx context.Context, req *notifypb.ReplayWebhookDeliveryRequest) (*notifypb.WebhookDelivery, error) {
+	if req.Id == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+	if err := s.repo.ReplayWebhookDelivery(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	d, err := s.repo.GetWebhookDelivery(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().Str("delivery_id", d.ID).Str("webhook_id", d.WebhookID).Str("event_id", d.EventID).Str("admin_id", req.AdminId).Msg("Webhook delivery replayed")
+	return toWebhookDeliveryProto(d), nil
+}
+
+// PingWebhook sends a ping event to a webhook at once, enabled or not, and records
+// it in the delivery log. It is not retried
+func (s *Service) PingWebhook(ctx context.Context, req *notifypb.PingWebhookRequest) (*notifypb.WebhookDelivery, error) {
+	if req.Id == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+	w, err := s.repo.GetWebhook(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	data, err := json.Marshal(map[string]string{"webhook_id": w.ID, "admin_id": req.AdminId})
+	if err != nil {
+		return nil, err
+	}
+	payload, err := webhookPayload(uuid.New().String(), pingEvent, now, w.VenueID, data)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &repository.WebhookDelivery{
+		ID:        uuid.New().String(),
+		WebhookID: w.ID,
+		VenueID:   w.VenueID,
+		EventID:   pingEvent + "/" + uuid.New().String(),
+		EventType: pingEvent,
+		Payload:   payload,
+		Attempts:  1,
+		Status:    "sent",
+	}
+	status, err := s.webhooks.Send(ctx, w.URL, w.Secret, &webhook.Request{DeliveryID: d.ID, EventType: pingEvent, Body: []byte(payload)}, now)
+	d.ResponseStatus = int32(status)
+	if err != nil {
+		d.Status = "failed"
+		d.Error = err.Error()
+	}
+	if _, err := s.repo.CreateWebhookDelivery(ctx, d); err != nil {
+		return nil, fmt.Errorf("failed to save delivery: %w", err)
+	}
+	if d, err = s.repo.GetWebhookDelivery(ctx, d.ID); err != nil {
+		return nil, err
+	}
+
+	log.Info().Str("webhook_id", w.ID).Str("status", d.Status).Int32("response_status", d.ResponseStatus).Str("admin_id", req.AdminId).Msg("Webhook pinged")
+	return toWebhookDeliveryProto(d), nil
+}
+
+// HandleWebhookEvent queues a delivery of the event for every enabled webhook of
+// its venue subscribed to it. An event handled again queues nothing new
+func (s *Service) HandleWebhookEvent(ctx context.Context, e *WebhookEvent) error {
+	// Spares looking up the venue of bookings while no one listens to the event
+	subscribed, err := s.repo.HasWebhooks(ctx, e.Type)
+	if err != nil {
+		return fmt.Errorf("failed to load webhooks: %w", err)
+	}
+	if !subscribed {
+		return nil
+	}
+
+	if e.VenueID == "" {
+		booking, err := s.bookingClient.GetBooking(ctx, &bookingpb.GetBookingRequest{Id: e.Key})
+		if err != nil {
+			return fmt.Errorf("failed to get booking %s: %w", e.Key, err)
+		}
+		e.VenueID = booking.VenueId
+	}
+
+	webhooks, err := s.repo.MatchingWebhooks(ctx, e.VenueID, e.Type)
+	if err != nil {
+		return fmt.Errorf("failed to load webhooks: %w", err)
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(e.Data)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	payload, err := webhookPayload(e.ID, e.Type, e.CreatedAt, e.VenueID, data)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range webhooks {
+		created, err := s.repo.CreateWebhookDelivery(ctx, &repository.WebhookDelivery{
+			WebhookID: w.ID,
+			VenueID:   e.VenueID,
+			EventID:   e.ID,
+			EventType: e.Type,
+			Payload:   payload,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to queue webhook delivery: %w", err)
+		}
+		if created {
+			log.Info().Str("webhook_id", w.ID).Str("event_id", e.ID).Str("event_type", e.Type).Msg("Webhook delivery queued")
+		}
+	}
+	return nil
+}
+
+// RunWebhooks sends due webhook deliveries every interval until ctx is done. Each
+// delivery is leased so replicas never send the same one twice at a time
+func (s *Service) RunWebhooks(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.sendDueWebhooks(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendDueWebhooks sends due deliveries until none is left, to up to WebhookWorkers
+// webhooks at a time and one delivery per webhook at a time, so a slow endpoint
+// only holds up its own deliveries
+func (s *Service) sendDueWebhooks(ctx context.Context) {
+	workers := max(s.cfg.WebhookWorkers, 1)
+	// The lease outlasts the request, so no other sender takes the delivery meanwhile
+	lease := time.Duration(s.cfg.WebhookTimeout)*time.Second + time.Minute
+
+	done := make(chan string, workers)
+	busy := make(map[string]bool, workers)
+	claiming := true
+	for {
+		if claiming && ctx.Err() == nil && len(busy) < workers {
+			claim, err := s.repo.ClaimDueWebhookDelivery(ctx, slices.Collect(maps.Keys(busy)), lease)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to claim due webhook delivery")
+				claiming = false
+			}
+			if claim != nil {
+				busy[claim.Delivery.WebhookID] = true
+				go func() {
+					s.sendWebhookDelivery(ctx, claim)
+					done <- claim.Delivery.WebhookID
+				}()
+				continue
+			}
+		}
+		// Nothing more to claim until a sender is done
+		if len(busy) == 0 {
+			return
+		}
+		delete(busy, <-done)
+	}
+}
+
+func (s *Service) sendWebhookDelivery(ctx context.Context, claim *repository.WebhookDeliveryClaim) {
+	d := claim.Delivery
+	now := time.Now()
+	status, err := s.webhooks.Send(ctx, claim.URL, claim.Secret, &webhook.Request{DeliveryID: d.ID, EventType: d.EventType, Body: []byte(d.Payload)}, now)
+	if err != nil && ctx.Err() != nil {
+		if err := claim.Release(context.WithoutCancel(ctx)); err != nil {
+			log.Warn().Err(err).Str("delivery_id", d.ID).Msg("Failed to release webhook delivery, it is retried when its lease runs out")
+		}
+		return
+	}
+	finishWebhookAttempt(d, status, err, s.cfg.WebhookAttempts, now)
+	// The outcome is stored even if the service is stopping, so a sent delivery is not repeated
+	if err := claim.Finish(context.WithoutCancel(ctx)); err != nil {
+		log.Error().Err(err).Str("delivery_id", d.ID).Msg("Failed to finish webhook delivery")
+		return
+	}
+
+	logEvent := log.Info()
+	if err != nil {
+		logEvent = log.Warn().Err(err)
+	}
+	logEvent.
+		Str("delivery_id", d.ID).
+		Str("webhook_id", d.WebhookID).
+		Str("event_type", d.EventType).
+		Int32("attempts", d.Attempts).
+		Int("response_status", status).
+		Str("status", d.Status).
+		Msg("Webhook delivery attempted")
+}
+
+// finishWebhookAttempt records the outcome of an attempt: sent, pending until the
+// backoff is over, or failed once maxAttempts are used up
+func finishWebhookAttempt(d *repository.WebhookDelivery, status int, err error, maxAttempts int, now time.Time) {
+	d.Attempts++
+	d.ResponseStatus = int32(status)
+	d.Error = ""
+	switch {
+	case err == nil:
+		d.Status = "sent"
+	case int(d.Attempts) >= maxAttempts:
+		d.Status = "failed"
+		d.Error = err.Error()
+	default:
+		d.Status = "pending"
+		d.Error = err.Error()
+		d.NextAttemptAt = now.Add(backoff(d.Attempts))
+	}
+}
+
+// webhookPayload builds the request body of an event
+func webhookPayload(id, eventType string, createdAt time.Time, venueID string, data []byte) (string, error) {
+	body, err := json.Marshal(&webhookBody{
+		ID:        id,
+		Type:      eventType,
+		CreatedAt: createdAt.UTC().Format(time.RFC3339),
+		VenueID:   venueID,
+		Data:      data,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+	return string(body), nil
+}
+
+func validateWebhook(rawURL string, eventTypes []string, allowPrivate bool) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url must be an absolute http or https URL")
+	}
+	if !allowPrivate && !webhook.PublicHost(u.Hostname()) {
+		return fmt.Errorf("url must point to a public host")
+	}
+	for _, t := range eventTypes {
+		if !slices.Contains(WebhookEventTypes, t) {
+			return fmt.Errorf("unknown event type %q, expected one of %s", t, strings.Join(WebhookEventTypes, ", "))
+		}
+	}
+	return nil
+}
+
+// uniqueStrings returns the strings without duplicates, in their first order
+func uniqueStrings(list []string) []string {
+	unique := []string{}
+	for _, s := range list {
+		if !slices.Contains(unique, s) {
+			unique = append(unique, s)
+		}
+	}
+	return unique
+}
+
+func newWebhookSecret() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func toWebhookProto(w *repository.Webhook) *notifypb.Webhook {
+	return &notifypb.Webhook{
+		Id:          w.ID,
+		VenueId:     w.VenueID,
+		Url:         w.URL,
+		Secret:      w.Secret,
+		EventTypes:  w.EventTypes,
+		Enabled:     w.Enabled,
+		Description: w.Description,
+		UpdatedBy:   w.UpdatedBy,
+		CreatedAt:   w.CreatedAt.Unix(),
+		UpdatedAt:   w.UpdatedAt.Unix(),
+	}
+}
+
+func toWebhookDeliveryProto(d *repository.WebhookDelivery) *notifypb.WebhookDelivery {
+	return &notifypb.WebhookDelivery{
+		Id:             d.ID,
+		WebhookId:      d.WebhookID,
+		VenueId:        d.VenueID,
+		EventId:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt.Unix(),
+		ResponseStatus: d.ResponseStatus,
+		Error:          d.Error,
+		CreatedAt:      d.CreatedAt.Unix(),
+		UpdatedAt:      d.UpdatedAt.Unix(),
+	}
+}
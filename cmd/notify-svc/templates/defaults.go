@@ -0,0 +1,75 @@
+package templates
+
+// builtin holds the templates used when neither the venue nor the service has
+// its own. They are shared by all channels; email gets the body as a paragraph
+var builtin = map[Key]Template{
+	{Event: "booking.confirmed", Locale: LocaleRU}: {
+		Subject: "Бронь подтверждена",
+		Body:    `{{.Venue.Name}}: ваша бронь на {{date .Booking.Start}} в {{time .Booking.Start}} подтверждена, {{.Booking.PartySize}} {{plural .Booking.PartySize "гость" "гостя" "гостей"}}. Ждем вас!{{if .Booking.ManageURL}} Управление бронью: {{.Booking.ManageURL}}{{end}}`,
+	},
+	{Event: "booking.cancelled", Locale: LocaleRU}: {
+		Subject: "Бронь отменена",
+		Body:    `{{.Venue.Name}}: ваша бронь на {{date .Booking.Start}} в {{time .Booking.Start}} отменена.{{if .Reason}} Причина: {{.Reason}}.{{end}}`,
+	},
+	{Event: "booking.no_show", Locale: LocaleRU}: {
+		Subject: "Бронь закрыта",
+		Body:    `{{.Venue.Name}}: мы не дождались вас {{date .Booking.Start}} в {{time .Booking.Start}}, бронь закрыта.`,
+	},
+	{Event: "booking.moved", Locale: LocaleRU}: {
+		Subject: "Бронь перенесена",
+		Body:    `{{.Venue.Name}}: ваша бронь на {{date .Booking.Start}} в {{time .Booking.Start}} перенесена, ваш стол: {{.Booking.Tables}}.{{if .Booking.ManageURL}} Управление бронью: {{.Booking.ManageURL}}{{end}}`,
+	},
+	{Event: "booking.reminder", Locale: LocaleRU}: {
+		Subject: "Напоминание о брони",
+		Body:    `{{.Venue.Name}}: напоминаем, что ждем вас {{date .Booking.Start}} ({{weekday .Booking.Start}}) в {{time .Booking.Start}}, {{.Booking.PartySize}} {{plural .Booking.PartySize "гость" "гостя" "гостей"}}.{{if .Venue.Address}} Адрес: {{.Venue.Address}}.{{end}}{{if .Booking.ManageURL}} Управление бронью: {{.Booking.ManageURL}}{{end}}`,
+	},
+	{Event: "room_hire.confirmed", Locale: LocaleRU}: {
+		Subject: "Аренда зала подтверждена",
+		Body:    `{{.Venue.Name}}: аренда зала «{{.RoomHire.Room}}» для «{{.RoomHire.EventName}}» {{date .RoomHire.Start}} с {{time .RoomHire.Start}} до {{time .RoomHire.End}} подтверждена, {{.RoomHire.Headcount}} {{plural .RoomHire.Headcount "гость" "гостя" "гостей"}}.`,
+	},
+	{Event: "room_hire.cancelled", Locale: LocaleRU}: {
+		Subject: "Аренда зала отменена",
+		Body:    `{{.Venue.Name}}: аренда зала «{{.RoomHire.Room}}» для «{{.RoomHire.EventName}}» {{date .RoomHire.Start}} отменена.{{if .Reason}} Причина: {{.Reason}}.{{end}}`,
+	},
+
+	{Event: "booking.confirmed", Locale: LocaleEN}: {
+		Subject: "Booking confirmed",
+		Body:    `{{.Venue.Name}}: your booking for {{.Booking.PartySize}} {{plural .Booking.PartySize "guest" "guests"}} on {{date .Booking.Start}} at {{time .Booking.Start}} is confirmed. See you soon!{{if .Booking.ManageURL}} Manage your booking: {{.Booking.ManageURL}}{{end}}`,
+	},
+	{Event: "booking.cancelled", Locale: LocaleEN}: {
+		Subject: "Booking cancelled",
+		Body:    `{{.Venue.Name}}: your booking on {{date .Booking.Start}} at {{time .Booking.Start}} has been cancelled.{{if .Reason}} Reason: {{.Reason}}.{{end}}`,
+	},
+	{Event: "booking.no_show", Locale: LocaleEN}: {
+		Subject: "Booking closed",
+		Body:    `{{.Venue.Name}}: we missed you on {{date .Booking.Start}} at {{time .Booking.Start}}, the booking has been closed.`,
+	},
+	{Event: "booking.moved", Locale: LocaleEN}: {
+		Subject: "Booking moved",
+		Body:    `{{.Venue.Name}}: your booking on {{date .Booking.Start}} at {{time .Booking.Start}} has been moved, your table: {{.Booking.Tables}}.{{if .Booking.ManageURL}} Manage your booking: {{.Booking.ManageURL}}{{end}}`,
+	},
+	{Event: "booking.reminder", Locale: LocaleEN}: {
+		Subject: "Booking reminder",
+		Body:    `{{.Venue.Name}}: a reminder that we are expecting {{.Booking.PartySize}} {{plural .Booking.PartySize "guest" "guests"}} on {{weekday .Booking.Start}}, {{date .Booking.Start}} at {{time .Booking.Start}}.{{if .Venue.Address}} Address: {{.Venue.Address}}.{{end}}{{if .Booking.ManageURL}} Manage your booking: {{.Booking.ManageURL}}{{end}}`,
+	},
+	{Event: "room_hire.confirmed", Locale: LocaleEN}: {
+		Subject: "Room hire confirmed",
+		Body:    `{{.Venue.Name}}: the hire of {{.RoomHire.Room}} for {{.RoomHire.EventName}} on {{date .RoomHire.Start}} from {{time .RoomHire.Start}} to {{time .RoomHire.End}} for {{.RoomHire.Headcount}} {{plural .RoomHire.Headcount "guest" "guests"}} is confirmed.`,
+	},
+	{Event: "room_hire.cancelled", Locale: LocaleEN}: {
+		Subject: "Room hire cancelled",
+		Body:    `{{.Venue.Name}}: the hire of {{.RoomHire.Room}} for {{.RoomHire.EventName}} on {{date .RoomHire.Start}} has been cancelled.{{if .Reason}} Reason: {{.Reason}}.{{end}}`,
+	},
+}
+
+// Builtin returns the built-in template for a key
+func Builtin(key Key) (*Template, bool) {
+	t, ok := builtin[Key{Event: key.Event, Locale: key.Locale}]
+	if !ok {
+		return nil, false
+	}
+	if key.Channel == channelEmail {
+		t.Body = "<p>" + t.Body + "</p>"
+	}
+	return &t, true
+}
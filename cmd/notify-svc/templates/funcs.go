@@ -0,0 +1,83 @@
+package templates
+
+import (
+	"fmt"
+	texttemplate "text/template"
+	"time"
+)
+
+var ruMonths = [...]string{"января", "февраля", "марта", "апреля", "мая", "июня",
+	"июля", "августа", "сентября", "октября", "ноября", "декабря"}
+
+var ruWeekdays = [...]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"}
+
+// funcMap returns the functions templates format values with in a locale:
+//
+//	{{date .Booking.Start}}     6 марта 2026 / March 6, 2026
+//	{{time .Booking.Start}}     19:00 / 7:00 PM
+//	{{weekday .Booking.Start}}  пятница / Friday
+//	{{plural .Booking.PartySize "гость" "гостя" "гостей"}}  ru takes three forms, en two
+func funcMap(locale string) texttemplate.FuncMap {
+	if locale == LocaleEN {
+		return texttemplate.FuncMap{
+			"date":    func(t time.Time) string { return t.Format("January 2, 2006") },
+			"time":    func(t time.Time) string { return t.Format("3:04 PM") },
+			"weekday": func(t time.Time) string { return t.Weekday().String() },
+			"plural":  pluralEN,
+		}
+	}
+	return texttemplate.FuncMap{
+		"date":    func(t time.Time) string { return fmt.Sprintf("%d %s %d", t.Day(), ruMonths[t.Month()-1], t.Year()) },
+		"time":    func(t time.Time) string { return t.Format("15:04") },
+		"weekday": func(t time.Time) string { return ruWeekdays[t.Weekday()] },
+		"plural":  pluralRU,
+	}
+}
+
+// pluralRU picks the form for one, few (2-4) or many
+func pluralRU(n any, forms ...string) (string, error) {
+	if len(forms) != 3 {
+		return "", fmt.Errorf("plural needs 3 forms in ru, got %d", len(forms))
+	}
+	v, err := toInt(n)
+	if err != nil {
+		return "", err
+	}
+	if v < 0 {
+		v = -v
+	}
+	switch {
+	case v%10 == 1 && v%100 != 11:
+		return forms[0], nil
+	case v%10 >= 2 && v%10 <= 4 && (v%100 < 12 || v%100 > 14):
+		return forms[1], nil
+	}
+	return forms[2], nil
+}
+
+// pluralEN picks the form for one or other
+func pluralEN(n any, forms ...string) (string, error) {
+	if len(forms) != 2 {
+		return "", fmt.Errorf("plural needs 2 forms in en, got %d", len(forms))
+	}
+	v, err := toInt(n)
+	if err != nil {
+		return "", err
+	}
+	if v == 1 {
+		return forms[0], nil
+	}
+	return forms[1], nil
+}
+
+func toInt(n any) (int64, error) {
+	switch v := n.(type) {
+	case int:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	}
+	return 0, fmt.Errorf("plural expects a number, got %T", n)
+}
@@ -0,0 +1,178 @@
+// Package templates renders guest notifications from templates keyed by event
+// type, channel and locale
+package templates
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// Supported locales
+const (
+	LocaleRU = "ru"
+	LocaleEN = "en"
+)
+
+// Locales lists every supported locale
+var Locales = []string{LocaleRU, LocaleEN}
+
+// Events lists the event types guests are notified about
+var Events = []string{
+	"booking.confirmed",
+	"booking.cancelled",
+	"booking.no_show",
+	"booking.moved",
+	"booking.reminder",
+	"room_hire.confirmed",
+	"room_hire.cancelled",
+}
+
+// channelEmail matches notifier.ChannelEmail; email bodies are HTML
+const channelEmail = "email"
+
+// Key identifies a template
+type Key struct {
+	Event   string
+	Channel string
+	Locale  string
+}
+
+// Template is the source of a message. The subject is used by email only
+type Template struct {
+	Subject string
+	Body    string
+}
+
+// Data is what templates see. All times are in the venue's time zone
+type Data struct {
+	Event    string
+	Venue    Venue
+	Booking  *Booking  // set for booking events
+	RoomHire *RoomHire // set for room hire events
+	Reason   string    // why the booking or room hire was cancelled or moved, if given
+}
+
+type Venue struct {
+	ID       string
+	Name     string
+	Address  string
+	Timezone string
+}
+
+type Booking struct {
+	ID         string
+	GuestName  string
+	GuestPhone string
+	PartySize  int32
+	Status     string
+	Comment    string
+	Start      time.Time
+	End        time.Time
+	Room       string
+	Table      Table
+	Tables     string // names of all tables of the booking, comma separated
+	ManageURL  string // link the guest can view, confirm, cancel or ask to change the booking at, if enabled
+}
+
+type Table struct {
+	ID   string
+	Name string
+	Zone string
+}
+
+type RoomHire struct {
+	ID           string
+	EventName    string
+	Headcount    int32
+	ContactName  string
+	ContactPhone string
+	ContactEmail string
+	Status       string
+	Comment      string
+	Start        time.Time
+	End          time.Time
+	Room         string
+}
+
+// Render executes a template for a channel. Email bodies are HTML with the data
+// escaped, every other channel and the subject are plain text
+func Render(t *Template, channel, locale string, data *Data) (subject, body string, err error) {
+	funcs := funcMap(locale)
+
+	subjectTmpl, err := texttemplate.New("subject").Funcs(funcs).Parse(t.Subject)
+	if err != nil {
+		return "", "", err
+	}
+	var buf bytes.Buffer
+	if err := subjectTmpl.Execute(&buf, data); err != nil {
+		return "", "", err
+	}
+	subject = strings.TrimSpace(buf.String())
+
+	buf.Reset()
+	if channel == channelEmail {
+		bodyTmpl, err := htmltemplate.New("body").Funcs(htmltemplate.FuncMap(funcs)).Parse(t.Body)
+		if err != nil {
+			return "", "", err
+		}
+		if err := bodyTmpl.Execute(&buf, data); err != nil {
+			return "", "", err
+		}
+	} else {
+		bodyTmpl, err := texttemplate.New("body").Funcs(funcs).Parse(t.Body)
+		if err != nil {
+			return "", "", err
+		}
+		if err := bodyTmpl.Execute(&buf, data); err != nil {
+			return "", "", err
+		}
+	}
+
+	return subject, strings.TrimSpace(buf.String()), nil
+}
+
+// Sample returns made-up data for previewing and validating templates of an event
+func Sample(event string) *Data {
+	loc, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		loc = time.UTC
+	}
+	start := time.Date(2026, time.March, 6, 19, 0, 0, 0, loc)
+
+	data := &Data{
+		Event: event,
+		Venue: Venue{ID: "sample-venue", Name: "Пример", Address: "ул. Пушкина, 1", Timezone: loc.String()},
+	}
+	if strings.HasPrefix(event, "room_hire.") {
+		data.RoomHire = &RoomHire{
+			ID:           "sample-room-hire",
+			EventName:    "Юбилей",
+			Headcount:    40,
+			ContactName:  "Анна",
+			ContactPhone: "+79161234567",
+			ContactEmail: "anna@example.com",
+			Status:       "confirmed",
+			Start:        start,
+			End:          start.Add(4 * time.Hour),
+			Room:         "Банкетный зал",
+		}
+		return data
+	}
+	data.Booking = &Booking{
+		ID:         "sample-booking",
+		GuestName:  "Анна",
+		GuestPhone: "+79161234567",
+		PartySize:  4,
+		Status:     "confirmed",
+		Start:      start,
+		End:        start.Add(2 * time.Hour),
+		Room:       "Основной зал",
+		Table:      Table{ID: "sample-table", Name: "5", Zone: "У окна"},
+		Tables:     "5",
+		ManageURL:  "http://localhost:18080/api/v1/guest/bookings/sample",
+	}
+	return data
+}
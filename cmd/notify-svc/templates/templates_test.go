@@ -0,0 +1,76 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender_Builtin(t *testing.T) {
+	for _, event := range Events {
+		for _, locale := range Locales {
+			for _, channel := range []string{"sms", "email"} {
+				tmpl, ok := Builtin(Key{Event: event, Channel: channel, Locale: locale})
+				require.True(t, ok, "%s/%s/%s", event, channel, locale)
+				_, _, err := Render(tmpl, channel, locale, Sample(event))
+				assert.NoError(t, err, "%s/%s/%s", event, channel, locale)
+			}
+		}
+	}
+
+	tmpl, _ := Builtin(Key{Event: "booking.confirmed", Channel: "sms", Locale: LocaleRU})
+	subject, body, err := Render(tmpl, "sms", LocaleRU, Sample("booking.confirmed"))
+	require.NoError(t, err)
+	assert.Equal(t, "Бронь подтверждена", subject)
+	assert.Equal(t, "Пример: ваша бронь на 6 марта 2026 в 19:00 подтверждена, 4 гостя. Ждем вас! Управление бронью: http://localhost:18080/api/v1/guest/bookings/sample", body)
+
+	tmpl, _ = Builtin(Key{Event: "booking.confirmed", Channel: "telegram", Locale: LocaleEN})
+	_, body, err = Render(tmpl, "telegram", LocaleEN, Sample("booking.confirmed"))
+	require.NoError(t, err)
+	assert.Equal(t, "Пример: your booking for 4 guests on March 6, 2026 at 7:00 PM is confirmed. See you soon! Manage your booking: http://localhost:18080/api/v1/guest/bookings/sample", body)
+
+	data := Sample("booking.confirmed")
+	data.Booking.ManageURL = ""
+	_, body, err = Render(tmpl, "telegram", LocaleEN, data)
+	require.NoError(t, err)
+	assert.Equal(t, "Пример: your booking for 4 guests on March 6, 2026 at 7:00 PM is confirmed. See you soon!", body, "no link when links are off")
+}
+
+func TestRender_EmailEscapesData(t *testing.T) {
+	data := Sample("booking.cancelled")
+	data.Venue.Name = "<b>Bar & Grill</b>"
+	tmpl := &Template{Subject: "{{.Venue.Name}}", Body: "<h1>{{.Venue.Name}}</h1>"}
+
+	subject, body, err := Render(tmpl, "email", LocaleEN, data)
+	require.NoError(t, err)
+	assert.Equal(t, "<b>Bar & Grill</b>", subject)
+	assert.Equal(t, "<h1>&lt;b&gt;Bar &amp; Grill&lt;/b&gt;</h1>", body)
+
+	_, body, err = Render(tmpl, "sms", LocaleEN, data)
+	require.NoError(t, err)
+	assert.Equal(t, "<h1><b>Bar & Grill</b></h1>", body)
+}
+
+func TestRender_Errors(t *testing.T) {
+	_, _, err := Render(&Template{Body: "{{.RoomHire.EventName}}"}, "sms", LocaleRU, Sample("booking.confirmed"))
+	assert.Error(t, err)
+
+	_, _, err = Render(&Template{Body: "{{.Booking.Name}}"}, "sms", LocaleRU, Sample("booking.confirmed"))
+	assert.Error(t, err)
+
+	_, _, err = Render(&Template{Body: `{{plural 2 "guest" "guests"}}`}, "sms", LocaleRU, Sample("booking.confirmed"))
+	assert.Error(t, err)
+
+	_, _, err = Render(&Template{Body: "{{if}}"}, "sms", LocaleRU, Sample("booking.confirmed"))
+	assert.Error(t, err)
+}
+
+func TestPluralRU(t *testing.T) {
+	forms := []string{"гость", "гостя", "гостей"}
+	for n, want := range map[int]string{1: "гость", 2: "гостя", 4: "гостя", 5: "гостей", 11: "гостей", 12: "гостей", 21: "гость", 22: "гостя", 111: "гостей"} {
+		got, err := pluralRU(n, forms...)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "n=%d", n)
+	}
+}
@@ -0,0 +1,151 @@
+// Package webhook posts booking events to partner endpoints. Every request is
+// signed with the webhook's secret: X-Booker-Signature is
+// sha256=hex(HMAC-SHA256(secret, timestamp + "." + body)), where timestamp is the
+// X-Booker-Timestamp header, so receivers can reject old or replayed requests
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Request headers
+const (
+	HeaderEvent     = "X-Booker-Event"
+	HeaderDelivery  = "X-Booker-Delivery"
+	HeaderTimestamp = "X-Booker-Timestamp"
+	HeaderSignature = "X-Booker-Signature"
+)
+
+// ErrNotPublic is returned for targets on loopback, private, link-local and other
+// addresses that are not reachable from the internet
+var ErrNotPublic = errors.New("webhook target is not a public address")
+
+// Request is one delivery of an event
+type Request struct {
+	DeliveryID string
+	EventType  string
+	Body       []byte
+}
+
+// Client sends webhook requests
+type Client struct {
+	http *http.Client
+}
+
+// NewClient returns a client that gives up on a request after timeout. Unless
+// allowPrivate is set it only connects to public addresses, checked after DNS
+// resolution so a public name pointing at an internal host is refused too. It
+// never uses a proxy, which would hide the target address from that check
+func NewClient(timeout time.Duration, allowPrivate bool) *Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if !PublicIP(net.ParseIP(host)) {
+				return fmt.Errorf("%w: %s", ErrNotPublic, host)
+			}
+			return nil
+		}
+	}
+	transport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: timeout,
+		IdleConnTimeout:     90 * time.Second,
+	}
+	return &Client{http: &http.Client{Timeout: timeout, Transport: transport}}
+}
+
+// PublicIP reports whether ip is a public unicast address
+func PublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		// 0.0.0.0/8 "this network" and 100.64.0.0/10 carrier-grade NAT
+		if ip4[0] == 0 || (ip4[0] == 100 && ip4[1]&0xc0 == 64) {
+			return false
+		}
+		ip = ip4
+	}
+	return ip.IsGlobalUnicast() && !ip.IsPrivate()
+}
+
+// PublicHost reports whether a URL host may be public: an IP literal must be a
+// public address, and a name must not be localhost or an internal name such as a
+// single-label service name. Names are resolved only when a request is sent
+func PublicHost(host string) bool {
+	if ip := net.ParseIP(host); ip != nil {
+		return PublicIP(ip)
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if !strings.Contains(host, ".") {
+		return false
+	}
+	for _, suffix := range []string{".localhost", ".local", ".internal"} {
+		if strings.HasSuffix(host, suffix) {
+			return false
+		}
+	}
+	return true
+}
+
+// Send posts the request to url signed with secret and returns the response
+// status. Any status other than 2xx is an error
+func (c *Client) Send(ctx context.Context, url, secret string, r *Request, now time.Time) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(r.Body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := now.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Booker-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, r.EventType)
+	req.Header.Set(HeaderDelivery, r.DeliveryID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(secret, timestamp, r.Body))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	return resp.StatusCode, nil
+}
+
+// Sign returns the X-Booker-Signature of a body sent at timestamp
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a signature the way receivers should: it must match the body and
+// the timestamp must be within tolerance of now
+func Verify(secret, signature string, timestamp int64, body []byte, now time.Time, tolerance time.Duration) bool {
+	sent := time.Unix(timestamp, 0)
+	if sent.Before(now.Add(-tolerance)) || sent.After(now.Add(tolerance)) {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body)))
+}
@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":"evt-1","type":"booking.confirmed"}`)
+	signature := Sign("secret", 1700000000, body)
+
+	// echo -n '1700000000.{"id":"evt-1","type":"booking.confirmed"}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "sha256=ade8c4bb24e879dc1e3604085b90018592fed5cf4b0dd737c0f996835b0df2d9", signature)
+
+	now := time.Unix(1700000100, 0)
+	assert.True(t, Verify("secret", signature, 1700000000, body, now, 5*time.Minute))
+	assert.False(t, Verify("other", signature, 1700000000, body, now, 5*time.Minute), "wrong secret")
+	assert.False(t, Verify("secret", signature, 1700000001, body, now, 5*time.Minute), "timestamp is signed")
+	assert.False(t, Verify("secret", signature, 1700000000, []byte(`{}`), now, 5*time.Minute), "body is signed")
+	assert.False(t, Verify("secret", signature, 1700000000, body, now.Add(time.Hour), 5*time.Minute), "too old")
+}
+
+func TestClientSend(t *testing.T) {
+	var got *http.Request
+	var gotBody []byte
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+		w.Write([]byte("try later"))
+	}))
+	defer server.Close()
+
+	c := NewClient(time.Second, true)
+	now := time.Unix(1700000000, 0)
+	req := &Request{DeliveryID: "delivery-1", EventType: "booking.confirmed", Body: []byte(`{"id":"evt-1"}`)}
+
+	code, err := c.Send(context.Background(), server.URL, "secret", req, now)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, code)
+	assert.Equal(t, "booking.confirmed", got.Header.Get(HeaderEvent))
+	assert.Equal(t, "delivery-1", got.Header.Get(HeaderDelivery))
+	assert.Equal(t, strconv.FormatInt(now.Unix(), 10), got.Header.Get(HeaderTimestamp))
+	assert.Equal(t, Sign("secret", now.Unix(), gotBody), got.Header.Get(HeaderSignature))
+	assert.Equal(t, `{"id":"evt-1"}`, string(gotBody))
+
+	status = http.StatusServiceUnavailable
+	code, err = c.Send(context.Background(), server.URL, "secret", req, now)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Contains(t, err.Error(), "try later")
+}
+
+func TestClientSend_PrivateTarget(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	c := NewClient(time.Second, false)
+	_, err := c.Send(context.Background(), server.URL, "secret", &Request{DeliveryID: "delivery-1", EventType: "ping"}, time.Now())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNotPublic)
+	assert.False(t, called)
+}
+
+func TestPublicIP(t *testing.T) {
+	for _, ip := range []string{"93.184.216.34", "2606:2800:220:1:248:1893:25c8:1946"} {
+		assert.True(t, PublicIP(net.ParseIP(ip)), ip)
+	}
+	for _, ip := range []string{
+		"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "100.64.0.1",
+		"0.0.0.0", "0.1.2.3", "224.0.0.1", "255.255.255.255", "::1", "::", "fe80::1", "fd00:ec2::254",
+		"::ffff:127.0.0.1", "::ffff:10.0.0.1",
+	} {
+		assert.False(t, PublicIP(net.ParseIP(ip)), ip)
+	}
+	assert.False(t, PublicIP(nil))
+}
+
+func TestPublicHost(t *testing.T) {
+	assert.True(t, PublicHost("partner.example.com"))
+	assert.True(t, PublicHost("93.184.216.34"))
+	assert.False(t, PublicHost("localhost"))
+	assert.False(t, PublicHost("api.localhost"))
+	assert.False(t, PublicHost("booking-svc"))
+	assert.False(t, PublicHost("metadata.google.internal"))
+	assert.False(t, PublicHost("printer.local."))
+	assert.False(t, PublicHost("169.254.169.254"))
+	assert.False(t, PublicHost("::1"))
+}
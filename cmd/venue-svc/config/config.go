@@ -19,6 +19,11 @@ type Config struct {
 	KafkaBrokers     string
 	JaegerEndpoint   string
 	BookingSvcAddr   string
+	DefaultTurnTimeMinutes int
+	CacheTTLSeconds        int
+	// Deleted venues, rooms and tables are purged after this many days
+	DeletedRetentionDays int
+	PurgeIntervalMinutes int
 }
 
 func Load() *Config {
@@ -36,6 +41,10 @@ func Load() *Config {
 		KafkaBrokers:     getEnv("KAFKA_BROKERS", "localhost:9092"),
 		JaegerEndpoint:   getEnv("JAEGER_ENDPOINT", "http://localhost:15268/api/traces"),
 		BookingSvcAddr:   getEnv("BOOKING_SVC_ADDR", "localhost:50152"),
+		DefaultTurnTimeMinutes: getEnvInt("DEFAULT_TURN_TIME_MINUTES", 120),
+		CacheTTLSeconds:        getEnvInt("CACHE_TTL_SECONDS", 600),
+		DeletedRetentionDays:   getEnvInt("DELETED_RETENTION_DAYS", 30),
+		PurgeIntervalMinutes:   getEnvInt("PURGE_INTERVAL_MINUTES", 60),
 	}
 }
 
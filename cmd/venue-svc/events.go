@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/rs/zerolog/log"
+
+	"booker/cmd/venue-svc/repository"
+	"booker/pkg/kafka"
+	commonpb "booker/pkg/proto/common"
+)
+
+var cacheInvalidationTopics = []string{"table.layout.updated", "venue.schedule.updated"}
+
+// cacheInvalidator is the part of the repository the handler needs
+type cacheInvalidator interface {
+	InvalidateLayout(ctx context.Context, venueID, roomID string)
+	InvalidateSchedule(ctx context.Context, venueID, date string)
+}
+
+var _ cacheInvalidator = (*repository.Repository)(nil)
+
+// CacheInvalidationHandler drops cached layouts and schedules when they change
+type CacheInvalidationHandler struct {
+	repo cacheInvalidator
+}
+
+func (h *CacheInvalidationHandler) Setup(sarama.ConsumerGroupSession) error   { return nil }
+func (h *CacheInvalidationHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *CacheInvalidationHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for message := range claim.Messages() {
+		event, err := kafka.DecodeVenueEvent(message.Value)
+		if err != nil {
+			log.Error().Err(err).Str("topic", message.Topic).Msg("Failed to decode venue event")
+			session.MarkMessage(message, "")
+			continue
+		}
+
+		h.invalidate(session.Context(), message.Topic, event)
+		session.MarkMessage(message, "")
+	}
+	return nil
+}
+
+func (h *CacheInvalidationHandler) invalidate(ctx context.Context, topic string, event *commonpb.VenueEvent) {
+	switch topic {
+	case "table.layout.updated":
+		h.repo.InvalidateLayout(ctx, event.VenueId, event.GetLayoutUpdated().GetRoomId())
+	case "venue.schedule.updated":
+		h.repo.InvalidateSchedule(ctx, event.VenueId, event.GetScheduleUpdated().GetDate())
+	}
+
+	log.Debug().
+		Str("topic", topic).
+		Str("venue_id", event.VenueId).
+		Msg("Cache invalidated")
+}
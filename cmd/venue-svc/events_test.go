@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"booker/pkg/kafka"
+	commonpb "booker/pkg/proto/common"
+)
+
+type invalidation struct {
+	kind, venueID, key string
+}
+
+type fakeInvalidator struct {
+	calls []invalidation
+}
+
+func (f *fakeInvalidator) InvalidateLayout(_ context.Context, venueID, roomID string) {
+	f.calls = append(f.calls, invalidation{"layout", venueID, roomID})
+}
+
+func (f *fakeInvalidator) InvalidateSchedule(_ context.Context, venueID, date string) {
+	f.calls = append(f.calls, invalidation{"schedule", venueID, date})
+}
+
+// decodeVenueEvent encodes an event the way the producer does and decodes it the way the handler does
+func decodeVenueEvent(t *testing.T, event *commonpb.VenueEvent) *commonpb.VenueEvent {
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+	decoded, err := kafka.DecodeVenueEvent(data)
+	require.NoError(t, err)
+	return decoded
+}
+
+func TestCacheInvalidationHandler_Invalidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		topic string
+		event *commonpb.VenueEvent
+		want  []invalidation
+	}{
+		{
+			name:  "room layout",
+			topic: "table.layout.updated",
+			event: &commonpb.VenueEvent{
+				VenueId: "v1",
+				Payload: &commonpb.VenueEvent_LayoutUpdated{
+					LayoutUpdated: &commonpb.TableLayoutUpdated{RoomId: "r1", TableIds: []string{"t1"}},
+				},
+			},
+			want: []invalidation{{"layout", "v1", "r1"}},
+		},
+		{
+			name:  "venue layout",
+			topic: "table.layout.updated",
+			event: &commonpb.VenueEvent{
+				VenueId: "v1",
+				Payload: &commonpb.VenueEvent_LayoutUpdated{LayoutUpdated: &commonpb.TableLayoutUpdated{}},
+			},
+			want: []invalidation{{"layout", "v1", ""}},
+		},
+		{
+			name:  "special hours",
+			topic: "venue.schedule.updated",
+			event: &commonpb.VenueEvent{
+				VenueId: "v1",
+				Payload: &commonpb.VenueEvent_ScheduleUpdated{
+					ScheduleUpdated: &commonpb.VenueScheduleUpdated{Date: "2024-12-31"},
+				},
+			},
+			want: []invalidation{{"schedule", "v1", "2024-12-31"}},
+		},
+		{
+			name:  "weekly schedule",
+			topic: "venue.schedule.updated",
+			event: &commonpb.VenueEvent{
+				VenueId: "v1",
+				Payload: &commonpb.VenueEvent_ScheduleUpdated{ScheduleUpdated: &commonpb.VenueScheduleUpdated{}},
+			},
+			want: []invalidation{{"schedule", "v1", ""}},
+		},
+		{
+			name:  "other topic",
+			topic: "venue.created",
+			event: &commonpb.VenueEvent{VenueId: "v1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeInvalidator{}
+			h := &CacheInvalidationHandler{repo: repo}
+			h.invalidate(context.Background(), tt.topic, decodeVenueEvent(t, tt.event))
+			assert.Equal(t, tt.want, repo.calls)
+		})
+	}
+}
+
+func TestCacheInvalidationTopics(t *testing.T) {
+	event := &commonpb.VenueEvent{
+		VenueId: "v1",
+		Payload: &commonpb.VenueEvent_LayoutUpdated{LayoutUpdated: &commonpb.TableLayoutUpdated{RoomId: "r1"}},
+	}
+	for _, topic := range cacheInvalidationTopics {
+		repo := &fakeInvalidator{}
+		h := &CacheInvalidationHandler{repo: repo}
+		h.invalidate(context.Background(), topic, decodeVenueEvent(t, event))
+		assert.Len(t, repo.calls, 1, "topic %s is subscribed but invalidates nothing", topic)
+	}
+}
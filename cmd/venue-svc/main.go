@@ -81,7 +81,7 @@ func main() {
 	defer producer.Close()
 
 	// Repository
-	repo := repository.New(pool, redisClient)
+	repo := repository.New(pool, redisClient, time.Duration(cfg.CacheTTLSeconds)*time.Second)
 
 	// Booking service client
 	bookingConn, err := grpc.Dial(
@@ -100,6 +100,28 @@ func main() {
 	// Start metrics server
 	startMetricsServer(cfg.MetricsPort)
 
+	// Graceful shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Cache invalidation consumer
+	consumer, err := kafka.NewConsumer(kafkaBrokers, "venue-svc-cache", &CacheInvalidationHandler{repo: repo})
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create Kafka consumer")
+	}
+	defer consumer.Close()
+	go func() {
+		for ctx.Err() == nil {
+			if err := consumer.Consume(ctx, cacheInvalidationTopics); err != nil {
+				log.Error().Err(err).Msg("Cache invalidation consumer error")
+				time.Sleep(retryDelay)
+			}
+		}
+	}()
+
+	// Purge of soft-deleted venues, rooms and tables
+	go svc.RunPurge(ctx)
+
 	// gRPC Server
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
 	if err != nil {
@@ -111,10 +133,6 @@ func main() {
 	)
 	venuepb.RegisterVenueServiceServer(s, svc)
 
-	// Graceful shutdown
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-	defer stop()
-
 	go func() {
 		log.Info().Int("port", cfg.Port).Msg("Venue service started")
 		if err := s.Serve(lis); err != nil {
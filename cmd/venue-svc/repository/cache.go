@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+
+	"booker/pkg/metrics"
+)
+
+// Layouts and schedules are cached in Redis as JSON. Writes in this package drop
+// the keys they change; venue-svc drops them again on table.layout.updated and
+// venue.schedule.updated events, which also removes an entry put back by a read
+// that raced the write.
+
+const cacheService = "venue-svc"
+
+// Layout is a room or a whole venue with all of its tables
+type Layout struct {
+	Room   *Room // nil for a venue layout
+	Tables []*Table
+}
+
+// specialHoursEntry lets a date without special hours be cached too
+type specialHoursEntry struct {
+	Hours *SpecialHours
+}
+
+func roomLayoutKey(roomID string) string {
+	return fmt.Sprintf("layout:%s", roomID)
+}
+
+func venueLayoutKey(venueID string) string {
+	return fmt.Sprintf("layout:venue:%s", venueID)
+}
+
+func openingHoursKey(venueID string) string {
+	return fmt.Sprintf("schedule:%s", venueID)
+}
+
+func specialHoursKey(venueID, date string) string {
+	return fmt.Sprintf("schedule:%s:%s", venueID, date)
+}
+
+// GetRoomLayout returns a room and all of its tables
+func (r *Repository) GetRoomLayout(ctx context.Context, roomID string) (*Layout, error) {
+	var layout Layout
+	if r.cacheGet(ctx, "layout", roomLayoutKey(roomID), &layout) {
+		return &layout, nil
+	}
+
+	room, err := r.GetRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := r.listAllTables(ctx, `t.room_id = $1`, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	layout = Layout{Room: room, Tables: tables}
+	r.cacheSet(ctx, roomLayoutKey(roomID), &layout)
+	return &layout, nil
+}
+
+// GetVenueLayout returns all tables of a venue, without any limit
+func (r *Repository) GetVenueLayout(ctx context.Context, venueID string) (*Layout, error) {
+	var layout Layout
+	if r.cacheGet(ctx, "layout", venueLayoutKey(venueID), &layout) {
+		return &layout, nil
+	}
+
+	tables, err := r.listAllTables(ctx, `r.venue_id = $1`, venueID)
+	if err != nil {
+		return nil, err
+	}
+
+	layout = Layout{Tables: tables}
+	r.cacheSet(ctx, venueLayoutKey(venueID), &layout)
+	return &layout, nil
+}
+
+// GetCachedOpeningHours is ListOpeningHours served from the cache
+func (r *Repository) GetCachedOpeningHours(ctx context.Context, venueID string) ([]*DayHours, error) {
+	var days []*DayHours
+	if r.cacheGet(ctx, "schedule", openingHoursKey(venueID), &days) {
+		return days, nil
+	}
+
+	days, err := r.ListOpeningHours(ctx, venueID)
+	if err != nil {
+		return nil, err
+	}
+	r.cacheSet(ctx, openingHoursKey(venueID), days)
+	return days, nil
+}
+
+// GetCachedSpecialHours is GetSpecialHours served from the cache
+func (r *Repository) GetCachedSpecialHours(ctx context.Context, venueID, date string) (*SpecialHours, error) {
+	var entry specialHoursEntry
+	if r.cacheGet(ctx, "schedule", specialHoursKey(venueID, date), &entry) {
+		return entry.Hours, nil
+	}
+
+	hours, err := r.GetSpecialHours(ctx, venueID, date)
+	if err != nil {
+		return nil, err
+	}
+	r.cacheSet(ctx, specialHoursKey(venueID, date), specialHoursEntry{Hours: hours})
+	return hours, nil
+}
+
+// layoutKeys returns the cached layouts of a venue and, if given, of one of its rooms
+func layoutKeys(venueID, roomID string) []string {
+	keys := make([]string, 0, 2)
+	if venueID != "" {
+		keys = append(keys, venueLayoutKey(venueID))
+	}
+	if roomID != "" {
+		keys = append(keys, roomLayoutKey(roomID))
+	}
+	return keys
+}
+
+// scheduleKey returns the cached special hours of a date, or the weekly schedule if date is empty
+func scheduleKey(venueID, date string) string {
+	if date == "" {
+		return openingHoursKey(venueID)
+	}
+	return specialHoursKey(venueID, date)
+}
+
+// InvalidateLayout drops cached layouts of a venue and, if given, of one of its rooms
+func (r *Repository) InvalidateLayout(ctx context.Context, venueID, roomID string) {
+	r.cacheDel(ctx, layoutKeys(venueID, roomID)...)
+}
+
+// InvalidateSchedule drops special hours of a date, or the weekly schedule if date is empty
+func (r *Repository) InvalidateSchedule(ctx context.Context, venueID, date string) {
+	r.cacheDel(ctx, scheduleKey(venueID, date))
+}
+
+// invalidateRoomLayout drops the layouts of a room and of the venue it belongs to
+func (r *Repository) invalidateRoomLayout(ctx context.Context, roomID string) {
+	var venueID string
+	if err := r.db.QueryRow(ctx, `SELECT venue_id FROM rooms WHERE id = $1`, roomID).Scan(&venueID); err != nil {
+		log.Warn().Err(err).Str("room_id", roomID).Msg("Failed to resolve venue of room for cache invalidation")
+	}
+	r.InvalidateLayout(ctx, venueID, roomID)
+}
+
+// cacheGet reads a cached value into dst. Redis errors count as misses, so the
+// caller falls back to Postgres
+func (r *Repository) cacheGet(ctx context.Context, cache, key string, dst interface{}) bool {
+	data, err := r.redis.Get(ctx, key).Bytes()
+	if err == nil {
+		err = json.Unmarshal(data, dst)
+	}
+	if err != nil {
+		if !errors.Is(err, goredis.Nil) {
+			log.Warn().Err(err).Str("key", key).Msg("Failed to read cache")
+		}
+		metrics.CacheMissesTotal.WithLabelValues(cache, cacheService).Inc()
+		return false
+	}
+	metrics.CacheHitsTotal.WithLabelValues(cache, cacheService).Inc()
+	return true
+}
+
+func (r *Repository) cacheSet(ctx context.Context, key string, value interface{}) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		log.Warn().Err(err).Str("key", key).Msg("Failed to encode cache entry")
+		return
+	}
+	if err := r.redis.Set(ctx, key, data, r.cacheTTL).Err(); err != nil {
+		log.Warn().Err(err).Str("key", key).Msg("Failed to write cache")
+	}
+}
+
+func (r *Repository) cacheDel(ctx context.Context, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	if err := r.redis.Del(ctx, keys...).Err(); err != nil {
+		log.Warn().Err(err).Strs("keys", keys).Msg("Failed to invalidate cache")
+	}
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"booker/pkg/metrics"
+	"booker/pkg/redis"
+)
+
+func TestLayoutKeys(t *testing.T) {
+	assert.Equal(t, []string{"layout:venue:v1", "layout:r1"}, layoutKeys("v1", "r1"))
+	assert.Equal(t, []string{"layout:venue:v1"}, layoutKeys("v1", ""))
+	assert.Equal(t, []string{"layout:r1"}, layoutKeys("", "r1"))
+	assert.Empty(t, layoutKeys("", ""))
+
+	// Invalidation has to hit the keys the read-through fills
+	assert.Equal(t, venueLayoutKey("v1"), layoutKeys("v1", "")[0])
+	assert.Equal(t, roomLayoutKey("r1"), layoutKeys("", "r1")[0])
+}
+
+func TestScheduleKey(t *testing.T) {
+	assert.Equal(t, "schedule:v1", scheduleKey("v1", ""))
+	assert.Equal(t, "schedule:v1:2024-12-31", scheduleKey("v1", "2024-12-31"))
+	assert.Equal(t, openingHoursKey("v1"), scheduleKey("v1", ""))
+	assert.Equal(t, specialHoursKey("v1", "2024-12-31"), scheduleKey("v1", "2024-12-31"))
+	assert.NotEqual(t, scheduleKey("v1", ""), scheduleKey("v1", "2024-12-31"))
+}
+
+func TestCacheGet_RedisErrorCountsAsMiss(t *testing.T) {
+	client := &redis.Client{Client: goredis.NewClient(&goredis.Options{
+		Addr:        "127.0.0.1:1",
+		DialTimeout: 100 * time.Millisecond,
+		MaxRetries:  -1,
+	})}
+	defer client.Close()
+	repo := New(nil, client, time.Minute)
+
+	misses := metrics.CacheMissesTotal.WithLabelValues("layout", cacheService)
+	hits := metrics.CacheHitsTotal.WithLabelValues("layout", cacheService)
+	missesBefore, hitsBefore := testutil.ToFloat64(misses), testutil.ToFloat64(hits)
+
+	var layout Layout
+	assert.False(t, repo.cacheGet(context.Background(), "layout", roomLayoutKey("r1"), &layout))
+	assert.Equal(t, missesBefore+1, testutil.ToFloat64(misses))
+	assert.Equal(t, hitsBefore, testutil.ToFloat64(hits))
+}
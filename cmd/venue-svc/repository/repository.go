@@ -2,59 +2,69 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"booker/pkg/redis"
 )
 
 type Repository struct {
-	db    *pgxpool.Pool
-	redis *redis.Client
+	db       *pgxpool.Pool
+	redis    *redis.Client
+	cacheTTL time.Duration
 }
 
-func New(db *pgxpool.Pool, redis *redis.Client) *Repository {
+func New(db *pgxpool.Pool, redis *redis.Client, cacheTTL time.Duration) *Repository {
 	return &Repository{
-		db:    db,
-		redis: redis,
+		db:       db,
+		redis:    redis,
+		cacheTTL: cacheTTL,
 	}
 }
 
 // Venue operations
-func (r *Repository) CreateVenue(ctx context.Context, name, timezone, address string) (string, error) {
+func (r *Repository) CreateVenue(ctx context.Context, name, timezone, address string, bufferMinutes int32) (string, error) {
 	id := uuid.New().String()
 	_, err := r.db.Exec(ctx,
-		`INSERT INTO venues (id, name, timezone, address, created_at, updated_at)
-		 VALUES ($1, $2, $3, $4, NOW(), NOW())`,
-		id, name, timezone, address)
+		`INSERT INTO venues (id, name, timezone, address, buffer_minutes, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, NOW(), NOW())`,
+		id, name, timezone, address, bufferMinutes)
 	return id, err
 }
 
 func (r *Repository) GetVenue(ctx context.Context, id string) (*Venue, error) {
 	var v Venue
 	err := r.db.QueryRow(ctx,
-		`SELECT id, name, timezone, address, created_at, updated_at
+		`SELECT id, name, timezone, address, created_at, updated_at, buffer_minutes, deleted_at
 		 FROM venues WHERE id = $1`, id).
-		Scan(&v.ID, &v.Name, &v.Timezone, &v.Address, &v.CreatedAt, &v.UpdatedAt)
+		Scan(&v.ID, &v.Name, &v.Timezone, &v.Address, &v.CreatedAt, &v.UpdatedAt, &v.BufferMinutes, &v.DeletedAt)
 	if err != nil {
 		return nil, err
 	}
 	return &v, nil
 }
 
-func (r *Repository) ListVenues(ctx context.Context, limit, offset int32) ([]*Venue, int32, error) {
+func (r *Repository) ListVenues(ctx context.Context, limit, offset int32, includeDeleted bool) ([]*Venue, int32, error) {
+	where := ` WHERE deleted_at IS NULL`
+	if includeDeleted {
+		where = ""
+	}
+
 	var total int32
-	err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM venues`).Scan(&total)
+	err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM venues`+where).Scan(&total)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	rows, err := r.db.Query(ctx,
-		`SELECT id, name, timezone, address, created_at, updated_at
-		 FROM venues ORDER BY created_at DESC LIMIT $1 OFFSET $2`,
+		`SELECT id, name, timezone, address, created_at, updated_at, buffer_minutes, deleted_at
+		 FROM venues`+where+` ORDER BY created_at DESC LIMIT $1 OFFSET $2`,
 		limit, offset)
 	if err != nil {
 		return nil, 0, err
@@ -64,7 +74,7 @@ func (r *Repository) ListVenues(ctx context.Context, limit, offset int32) ([]*Ve
 	var venues []*Venue
 	for rows.Next() {
 		var v Venue
-		if err := rows.Scan(&v.ID, &v.Name, &v.Timezone, &v.Address, &v.CreatedAt, &v.UpdatedAt); err != nil {
+		if err := rows.Scan(&v.ID, &v.Name, &v.Timezone, &v.Address, &v.CreatedAt, &v.UpdatedAt, &v.BufferMinutes, &v.DeletedAt); err != nil {
 			return nil, 0, err
 		}
 		venues = append(venues, &v)
@@ -73,16 +83,84 @@ func (r *Repository) ListVenues(ctx context.Context, limit, offset int32) ([]*Ve
 	return venues, total, nil
 }
 
-func (r *Repository) UpdateVenue(ctx context.Context, id, name, address string) error {
+func (r *Repository) UpdateVenue(ctx context.Context, id, name, address string, bufferMinutes int32) error {
 	_, err := r.db.Exec(ctx,
-		`UPDATE venues SET name = $1, address = $2, updated_at = NOW() WHERE id = $3`,
-		name, address, id)
+		`UPDATE venues SET name = $1, address = $2, buffer_minutes = $3, updated_at = NOW() WHERE id = $4`,
+		name, address, bufferMinutes, id)
 	return err
 }
 
+// DeleteVenue hides a venue together with its rooms and tables until PurgeDeleted removes them
 func (r *Repository) DeleteVenue(ctx context.Context, id string) error {
-	_, err := r.db.Exec(ctx, `DELETE FROM venues WHERE id = $1`, id)
-	return err
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// NOW() is fixed for the transaction, so everything gets the same deleted_at
+	_, err = tx.Exec(ctx,
+		`UPDATE tables SET deleted_at = NOW()
+		 WHERE deleted_at IS NULL AND room_id IN (SELECT id FROM rooms WHERE venue_id = $1)`, id)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `UPDATE rooms SET deleted_at = NOW() WHERE venue_id = $1 AND deleted_at IS NULL`, id)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `UPDATE venues SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`, id)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	r.InvalidateLayout(ctx, id, "")
+	return nil
+}
+
+// RestoreVenue brings back a deleted venue with the rooms and tables that were deleted along with it
+func (r *Repository) RestoreVenue(ctx context.Context, id string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var deletedAt *time.Time
+	err = tx.QueryRow(ctx, `SELECT deleted_at FROM venues WHERE id = $1 FOR UPDATE`, id).Scan(&deletedAt)
+	if err != nil {
+		return err
+	}
+	if deletedAt == nil {
+		return ErrNotDeleted
+	}
+
+	_, err = tx.Exec(ctx,
+		`UPDATE tables SET deleted_at = NULL
+		 WHERE room_id IN (SELECT id FROM rooms WHERE venue_id = $1)
+		 AND deleted_at = (SELECT deleted_at FROM venues WHERE id = $1)`, id)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx,
+		`UPDATE rooms SET deleted_at = NULL, layout_version = layout_version + 1
+		 WHERE venue_id = $1 AND deleted_at = (SELECT deleted_at FROM venues WHERE id = $1)`, id)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `UPDATE venues SET deleted_at = NULL, updated_at = NOW() WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	r.InvalidateLayout(ctx, id, "")
+	return nil
 }
 
 // Room operations
@@ -98,25 +176,31 @@ func (r *Repository) CreateRoom(ctx context.Context, venueID, name string) (stri
 func (r *Repository) GetRoom(ctx context.Context, id string) (*Room, error) {
 	var room Room
 	err := r.db.QueryRow(ctx,
-		`SELECT id, venue_id, name, created_at, updated_at
+		`SELECT id, venue_id, name, created_at, updated_at, width, height, background_url, layout_version, deleted_at
 		 FROM rooms WHERE id = $1`, id).
-		Scan(&room.ID, &room.VenueID, &room.Name, &room.CreatedAt, &room.UpdatedAt)
+		Scan(&room.ID, &room.VenueID, &room.Name, &room.CreatedAt, &room.UpdatedAt,
+			&room.Width, &room.Height, &room.BackgroundURL, &room.LayoutVersion, &room.DeletedAt)
 	if err != nil {
 		return nil, err
 	}
 	return &room, nil
 }
 
-func (r *Repository) ListRooms(ctx context.Context, venueID string, limit, offset int32) ([]*Room, int32, error) {
+func (r *Repository) ListRooms(ctx context.Context, venueID string, limit, offset int32, includeDeleted bool) ([]*Room, int32, error) {
+	where := ` WHERE venue_id = $1 AND deleted_at IS NULL`
+	if includeDeleted {
+		where = ` WHERE venue_id = $1`
+	}
+
 	var total int32
-	err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM rooms WHERE venue_id = $1`, venueID).Scan(&total)
+	err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM rooms`+where, venueID).Scan(&total)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	rows, err := r.db.Query(ctx,
-		`SELECT id, venue_id, name, created_at, updated_at
-		 FROM rooms WHERE venue_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3`,
+		`SELECT id, venue_id, name, created_at, updated_at, width, height, background_url, layout_version, deleted_at
+		 FROM rooms`+where+` ORDER BY created_at DESC LIMIT $2 OFFSET $3`,
 		venueID, limit, offset)
 	if err != nil {
 		return nil, 0, err
@@ -126,7 +210,8 @@ func (r *Repository) ListRooms(ctx context.Context, venueID string, limit, offse
 	var rooms []*Room
 	for rows.Next() {
 		var room Room
-		if err := rows.Scan(&room.ID, &room.VenueID, &room.Name, &room.CreatedAt, &room.UpdatedAt); err != nil {
+		if err := rows.Scan(&room.ID, &room.VenueID, &room.Name, &room.CreatedAt, &room.UpdatedAt,
+			&room.Width, &room.Height, &room.BackgroundURL, &room.LayoutVersion, &room.DeletedAt); err != nil {
 			return nil, 0, err
 		}
 		rooms = append(rooms, &room)
@@ -139,148 +224,903 @@ func (r *Repository) UpdateRoom(ctx context.Context, id, name string) error {
 	_, err := r.db.Exec(ctx,
 		`UPDATE rooms SET name = $1, updated_at = NOW() WHERE id = $2`,
 		name, id)
+	r.invalidateRoomLayout(ctx, id)
 	return err
 }
 
+// DeleteRoom hides a room together with its tables until PurgeDeleted removes them
 func (r *Repository) DeleteRoom(ctx context.Context, id string) error {
-	_, err := r.db.Exec(ctx, `DELETE FROM rooms WHERE id = $1`, id)
-	return err
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `UPDATE tables SET deleted_at = NOW() WHERE room_id = $1 AND deleted_at IS NULL`, id)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `UPDATE rooms SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`, id)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	r.invalidateRoomLayout(ctx, id)
+	return nil
+}
+
+// RestoreRoom brings back a deleted room with the tables that were deleted along with it
+func (r *Repository) RestoreRoom(ctx context.Context, id string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var roomDeletedAt, venueDeletedAt *time.Time
+	err = tx.QueryRow(ctx,
+		`SELECT r.deleted_at, v.deleted_at FROM rooms r JOIN venues v ON r.venue_id = v.id
+		 WHERE r.id = $1 FOR UPDATE OF r`, id).Scan(&roomDeletedAt, &venueDeletedAt)
+	if err != nil {
+		return err
+	}
+	if roomDeletedAt == nil {
+		return ErrNotDeleted
+	}
+	if venueDeletedAt != nil {
+		return ErrParentDeleted
+	}
+
+	_, err = tx.Exec(ctx,
+		`UPDATE tables SET deleted_at = NULL
+		 WHERE room_id = $1 AND deleted_at = (SELECT deleted_at FROM rooms WHERE id = $1)`, id)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx,
+		`UPDATE rooms SET deleted_at = NULL, layout_version = layout_version + 1, updated_at = NOW() WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	r.invalidateRoomLayout(ctx, id)
+	return nil
 }
 
 // Table operations
-func (r *Repository) CreateTable(ctx context.Context, roomID, name string, capacity int32, canMerge bool, zone string) (string, error) {
+func (r *Repository) CreateTable(ctx context.Context, roomID, name string, capacity int32, canMerge bool, zone string, bufferMinutes int32) (string, error) {
 	id := uuid.New().String()
-	_, err := r.db.Exec(ctx,
-		`INSERT INTO tables (id, room_id, name, capacity, can_merge, zone, created_at, updated_at)
-		 VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())`,
-		id, roomID, name, capacity, canMerge, zone)
-	
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO tables (id, room_id, name, capacity, can_merge, zone, buffer_minutes, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())`,
+		id, roomID, name, capacity, canMerge, zone, bufferMinutes)
+	if err != nil {
+		return "", err
+	}
+	if err := bumpLayoutVersion(ctx, tx, roomID); err != nil {
+		return "", err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return "", err
+	}
+
 	// Invalidate cache
-	r.redis.Del(ctx, fmt.Sprintf("layout:%s", roomID))
-	
-	return id, err
+	r.invalidateRoomLayout(ctx, roomID)
+
+	return id, nil
 }
 
 func (r *Repository) GetTable(ctx context.Context, id string) (*Table, error) {
 	var t Table
 	err := r.db.QueryRow(ctx,
-		`SELECT id, room_id, name, capacity, can_merge, zone, created_at, updated_at
-		 FROM tables WHERE id = $1`, id).
-		Scan(&t.ID, &t.RoomID, &t.Name, &t.Capacity, &t.CanMerge, &t.Zone, &t.CreatedAt, &t.UpdatedAt)
+		tableSelect+` WHERE t.id = $1`, id).
+		Scan(&t.ID, &t.RoomID, &t.VenueID, &t.Name, &t.Capacity, &t.CanMerge, &t.Zone, &t.CreatedAt, &t.UpdatedAt,
+			&t.X, &t.Y, &t.Width, &t.Height, &t.Shape, &t.Rotation, &t.BufferMinutes, &t.DeletedAt)
 	if err != nil {
 		return nil, err
 	}
 	return &t, nil
 }
 
-func (r *Repository) ListTables(ctx context.Context, roomID, venueID string, limit, offset int32) ([]*Table, int32, error) {
-	var query string
+func (r *Repository) ListTables(ctx context.Context, roomID, venueID string, limit, offset int32, includeDeleted bool) ([]*Table, int32, error) {
+	var conditions []string
 	var args []interface{}
 	
 	if roomID != "" {
-		query = `SELECT COUNT(*) FROM tables WHERE room_id = $1`
-		args = []interface{}{roomID}
+		conditions = append(conditions, `t.room_id = $1`)
+		args = append(args, roomID)
 	} else if venueID != "" {
-		query = `SELECT COUNT(*) FROM tables t JOIN rooms r ON t.room_id = r.id WHERE r.venue_id = $1`
-		args = []interface{}{venueID}
-	} else {
-		query = `SELECT COUNT(*) FROM tables`
-		args = []interface{}{}
+		conditions = append(conditions, `r.venue_id = $1`)
+		args = append(args, venueID)
+	}
+	if !includeDeleted {
+		conditions = append(conditions, `t.deleted_at IS NULL`)
+	}
+
+	var where string
+	if len(conditions) > 0 {
+		where = ` WHERE ` + strings.Join(conditions, ` AND `)
 	}
 	
 	var total int32
-	err := r.db.QueryRow(ctx, query, args...).Scan(&total)
+	err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM tables t JOIN rooms r ON t.room_id = r.id`+where, args...).Scan(&total)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	if roomID != "" {
-		query = `SELECT id, room_id, name, capacity, can_merge, zone, created_at, updated_at
-		 FROM tables WHERE room_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3`
-		args = []interface{}{roomID, limit, offset}
-	} else if venueID != "" {
-		query = `SELECT t.id, t.room_id, t.name, t.capacity, t.can_merge, t.zone, t.created_at, t.updated_at
-		 FROM tables t JOIN rooms r ON t.room_id = r.id WHERE r.venue_id = $1 ORDER BY t.created_at DESC LIMIT $2 OFFSET $3`
-		args = []interface{}{venueID, limit, offset}
-	} else {
-		query = `SELECT id, room_id, name, capacity, can_merge, zone, created_at, updated_at
-		 FROM tables ORDER BY created_at DESC LIMIT $1 OFFSET $2`
-		args = []interface{}{limit, offset}
+	query := tableSelect + where + fmt.Sprintf(` ORDER BY t.created_at DESC LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	tables, err := r.queryTables(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
 	}
 
+	return tables, total, nil
+}
+
+// tableSelect loads tables together with the venue of their room, so callers
+// never have to look rooms up one table at a time
+const tableSelect = `SELECT t.id, t.room_id, r.venue_id, t.name, t.capacity, t.can_merge, t.zone, t.created_at, t.updated_at,
+		 t.pos_x, t.pos_y, t.width, t.height, t.shape, t.rotation, t.buffer_minutes, t.deleted_at
+		 FROM tables t JOIN rooms r ON t.room_id = r.id`
+
+// listAllTables returns every table matching the condition, oldest first. Deleted tables are skipped
+func (r *Repository) listAllTables(ctx context.Context, where string, args ...interface{}) ([]*Table, error) {
+	return r.queryTables(ctx, tableSelect+` WHERE `+where+` AND t.deleted_at IS NULL ORDER BY t.created_at`, args...)
+}
+
+func (r *Repository) queryTables(ctx context.Context, query string, args ...interface{}) ([]*Table, error) {
 	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer rows.Close()
 
 	var tables []*Table
 	for rows.Next() {
 		var t Table
-		if err := rows.Scan(&t.ID, &t.RoomID, &t.Name, &t.Capacity, &t.CanMerge, &t.Zone, &t.CreatedAt, &t.UpdatedAt); err != nil {
-			return nil, 0, err
+		if err := rows.Scan(&t.ID, &t.RoomID, &t.VenueID, &t.Name, &t.Capacity, &t.CanMerge, &t.Zone, &t.CreatedAt, &t.UpdatedAt,
+			&t.X, &t.Y, &t.Width, &t.Height, &t.Shape, &t.Rotation, &t.BufferMinutes, &t.DeletedAt); err != nil {
+			return nil, err
 		}
 		tables = append(tables, &t)
 	}
 
-	return tables, total, nil
+	return tables, rows.Err()
 }
 
-func (r *Repository) UpdateTable(ctx context.Context, id, name string, capacity int32, zone string) error {
+func (r *Repository) UpdateTable(ctx context.Context, id, name string, capacity int32, zone string, bufferMinutes int32) error {
 	_, err := r.db.Exec(ctx,
-		`UPDATE tables SET name = $1, capacity = $2, zone = $3, updated_at = NOW() WHERE id = $4`,
-		name, capacity, zone, id)
+		`UPDATE tables SET name = $1, capacity = $2, zone = $3, buffer_minutes = $4, updated_at = NOW() WHERE id = $5`,
+		name, capacity, zone, bufferMinutes, id)
 	
 	// Invalidate cache
 	var roomID string
 	r.db.QueryRow(ctx, `SELECT room_id FROM tables WHERE id = $1`, id).Scan(&roomID)
 	if roomID != "" {
-		r.redis.Del(ctx, fmt.Sprintf("layout:%s", roomID))
+		r.invalidateRoomLayout(ctx, roomID)
 	}
 	
 	return err
 }
 
+// DeleteTable hides a table until PurgeDeleted removes it. Combinations with the
+// table are hidden as well while it is deleted
 func (r *Repository) DeleteTable(ctx context.Context, id string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
 	var roomID string
-	r.db.QueryRow(ctx, `SELECT room_id FROM tables WHERE id = $1`, id).Scan(&roomID)
-	
-	_, err := r.db.Exec(ctx, `DELETE FROM tables WHERE id = $1`, id)
-	
+	err = tx.QueryRow(ctx,
+		`UPDATE tables SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL RETURNING room_id`, id).Scan(&roomID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := bumpLayoutVersion(ctx, tx, roomID); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
 	// Invalidate cache
+	r.invalidateRoomLayout(ctx, roomID)
+
+	return nil
+}
+
+// RestoreTable brings back a deleted table
+func (r *Repository) RestoreTable(ctx context.Context, id string) error {
+	var roomID string
+	var tableDeletedAt, roomDeletedAt *time.Time
+	err := r.db.QueryRow(ctx,
+		`SELECT t.room_id, t.deleted_at, r.deleted_at FROM tables t JOIN rooms r ON t.room_id = r.id
+		 WHERE t.id = $1`, id).Scan(&roomID, &tableDeletedAt, &roomDeletedAt)
+	if err != nil {
+		return err
+	}
+	if tableDeletedAt == nil {
+		return ErrNotDeleted
+	}
+	if roomDeletedAt != nil {
+		return ErrParentDeleted
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `UPDATE tables SET deleted_at = NULL, updated_at = NOW() WHERE id = $1`, id); err != nil {
+		return err
+	}
+	if err := bumpLayoutVersion(ctx, tx, roomID); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	r.invalidateRoomLayout(ctx, roomID)
+	return nil
+}
+
+// PurgeDeleted permanently removes venues, rooms and tables deleted longer than
+// retention ago and returns how many were removed
+func (r *Repository) PurgeDeleted(ctx context.Context, retention time.Duration) (int64, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	// A combination missing one of its tables can no longer be seated
+	_, err = tx.Exec(ctx,
+		`DELETE FROM table_combinations WHERE id IN
+		 (SELECT m.combination_id FROM table_combination_members m JOIN tables t ON m.table_id = t.id
+		  WHERE t.deleted_at < NOW() - $1::interval)`, retention)
+	if err != nil {
+		return 0, err
+	}
+
+	// Children are deleted no later than their parents, and the foreign keys
+	// cascade to hours and rules of purged venues
+	var purged int64
+	for _, table := range []string{"tables", "rooms", "venues"} {
+		tag, err := tx.Exec(ctx, `DELETE FROM `+table+` WHERE deleted_at < NOW() - $1::interval`, retention)
+		if err != nil {
+			return 0, err
+		}
+		purged += tag.RowsAffected()
+	}
+
+	return purged, tx.Commit(ctx)
+}
+
+// Table combination operations
+func (r *Repository) CreateTableCombination(ctx context.Context, roomID, name string, minCapacity, maxCapacity int32, tableIDs []string) (string, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback(ctx)
+
+	var inRoom int
+	err = tx.QueryRow(ctx,
+		`SELECT COUNT(*) FROM tables WHERE id = ANY($1) AND room_id = $2 AND deleted_at IS NULL`,
+		tableIDs, roomID).Scan(&inRoom)
+	if err != nil {
+		return "", err
+	}
+	if inRoom != len(tableIDs) {
+		return "", fmt.Errorf("all tables of a combination must belong to room %s", roomID)
+	}
+
+	id := uuid.New().String()
+	_, err = tx.Exec(ctx,
+		`INSERT INTO table_combinations (id, room_id, name, min_capacity, max_capacity, created_at)
+		 VALUES ($1, $2, $3, $4, $5, NOW())`,
+		id, roomID, name, minCapacity, maxCapacity)
+	if err != nil {
+		return "", err
+	}
+
+	for i, tableID := range tableIDs {
+		_, err = tx.Exec(ctx,
+			`INSERT INTO table_combination_members (combination_id, table_id, position)
+			 VALUES ($1, $2, $3)`,
+			id, tableID, i)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return id, tx.Commit(ctx)
+}
+
+func (r *Repository) GetTableCombination(ctx context.Context, id string) (*TableCombination, error) {
+	var c TableCombination
+	err := r.db.QueryRow(ctx,
+		`SELECT c.id, c.room_id, c.name, c.min_capacity, c.max_capacity, c.created_at,
+		 ARRAY(SELECT table_id FROM table_combination_members WHERE combination_id = c.id ORDER BY position)
+		 FROM table_combinations c WHERE c.id = $1`, id).
+		Scan(&c.ID, &c.RoomID, &c.Name, &c.MinCapacity, &c.MaxCapacity, &c.CreatedAt, &c.TableIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// ListTableCombinations returns combinations of a room, or of all rooms of a venue if roomID is empty
+func (r *Repository) ListTableCombinations(ctx context.Context, roomID, venueID string) ([]*TableCombination, error) {
+	query := `SELECT c.id, c.room_id, c.name, c.min_capacity, c.max_capacity, c.created_at,
+		 ARRAY(SELECT table_id FROM table_combination_members WHERE combination_id = c.id ORDER BY position)
+		 FROM table_combinations c`
+	var args []interface{}
 	if roomID != "" {
-		r.redis.Del(ctx, fmt.Sprintf("layout:%s", roomID))
+		query += ` WHERE c.room_id = $1`
+		args = []interface{}{roomID}
+	} else {
+		query += ` JOIN rooms r ON c.room_id = r.id WHERE r.venue_id = $1`
+		args = []interface{}{venueID}
 	}
-	
+	// Combinations with a deleted table stay hidden until it is restored
+	query += ` AND NOT EXISTS (SELECT 1 FROM table_combination_members m JOIN tables t ON m.table_id = t.id
+		 WHERE m.combination_id = c.id AND t.deleted_at IS NOT NULL)
+		 ORDER BY c.created_at`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var combinations []*TableCombination
+	for rows.Next() {
+		var c TableCombination
+		if err := rows.Scan(&c.ID, &c.RoomID, &c.Name, &c.MinCapacity, &c.MaxCapacity, &c.CreatedAt, &c.TableIDs); err != nil {
+			return nil, err
+		}
+		combinations = append(combinations, &c)
+	}
+
+	return combinations, rows.Err()
+}
+
+func (r *Repository) DeleteTableCombination(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM table_combinations WHERE id = $1`, id)
 	return err
 }
 
+// Table block operations
+func (r *Repository) CreateTableBlock(ctx context.Context, b *TableBlock) (string, error) {
+	id := uuid.New().String()
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO table_blocks (id, table_id, start_date, until_date, start_time, end_time, reason, recurrence, created_by, created_at)
+		 VALUES ($1, $2, $3, NULLIF($4, '')::date, $5::time, $6::time, $7, $8, $9, NOW())`,
+		id, b.TableID, b.Date, b.UntilDate, b.StartTime, b.EndTime, b.Reason, b.Recurrence, b.CreatedBy)
+	return id, err
+}
+
+const tableBlockSelect = `SELECT b.id, r.venue_id, b.table_id, to_char(b.start_date, 'YYYY-MM-DD'),
+		 COALESCE(to_char(b.until_date, 'YYYY-MM-DD'), ''), to_char(b.start_time, 'HH24:MI'), to_char(b.end_time, 'HH24:MI'),
+		 b.reason, b.recurrence, b.created_by, b.created_at
+		 FROM table_blocks b JOIN tables t ON b.table_id = t.id JOIN rooms r ON t.room_id = r.id`
+
+func (r *Repository) GetTableBlock(ctx context.Context, id string) (*TableBlock, error) {
+	rows, err := r.db.Query(ctx, tableBlockSelect+` WHERE b.id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := scanTableBlocks(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) == 0 {
+		return nil, pgx.ErrNoRows
+	}
+	return blocks[0], nil
+}
+
+// ListTableBlocks returns blocks on tables of a venue, or of one table if tableID is set.
+// With a date only blocks whose date range covers it are returned; whether a recurring
+// block falls on that weekday is left to the caller
+func (r *Repository) ListTableBlocks(ctx context.Context, venueID, tableID, date string) ([]*TableBlock, error) {
+	query := tableBlockSelect + ` WHERE t.deleted_at IS NULL`
+	var args []interface{}
+	if tableID != "" {
+		args = append(args, tableID)
+		query += ` AND b.table_id = $1`
+	} else {
+		args = append(args, venueID)
+		query += ` AND r.venue_id = $1`
+	}
+	if date != "" {
+		args = append(args, date)
+		query += ` AND b.start_date <= $2::date AND (b.until_date IS NULL OR b.until_date >= $2::date)`
+	}
+	query += ` ORDER BY b.start_date, b.start_time`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanTableBlocks(rows)
+}
+
+func scanTableBlocks(rows pgx.Rows) ([]*TableBlock, error) {
+	defer rows.Close()
+
+	var blocks []*TableBlock
+	for rows.Next() {
+		var b TableBlock
+		if err := rows.Scan(&b.ID, &b.VenueID, &b.TableID, &b.Date, &b.UntilDate, &b.StartTime, &b.EndTime,
+			&b.Reason, &b.Recurrence, &b.CreatedBy, &b.CreatedAt); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, &b)
+	}
+
+	return blocks, rows.Err()
+}
+
+func (r *Repository) DeleteTableBlock(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM table_blocks WHERE id = $1`, id)
+	return err
+}
+
+// Turn-time rule operations
+
+// ReplaceTurnTimeRules atomically replaces all turn-time rules of a venue
+func (r *Repository) ReplaceTurnTimeRules(ctx context.Context, venueID string, rules []*TurnTimeRule) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM turn_time_rules WHERE venue_id = $1`, venueID); err != nil {
+		return err
+	}
+
+	for i, rule := range rules {
+		weekdays := rule.Weekdays
+		if weekdays == nil {
+			weekdays = []int32{}
+		}
+		_, err := tx.Exec(ctx,
+			`INSERT INTO turn_time_rules (id, venue_id, position, min_party_size, max_party_size, weekdays,
+			 period_start, period_end, duration_minutes, extra_minutes)
+			 VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, '')::time, NULLIF($8, '')::time, $9, $10)`,
+			uuid.New().String(), venueID, i, rule.MinPartySize, rule.MaxPartySize, weekdays,
+			rule.PeriodStart, rule.PeriodEnd, rule.DurationMinutes, rule.ExtraMinutes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *Repository) ListTurnTimeRules(ctx context.Context, venueID string) ([]*TurnTimeRule, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT min_party_size, max_party_size, weekdays,
+		 COALESCE(to_char(period_start, 'HH24:MI'), ''), COALESCE(to_char(period_end, 'HH24:MI'), ''),
+		 duration_minutes, extra_minutes
+		 FROM turn_time_rules WHERE venue_id = $1 ORDER BY position`,
+		venueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*TurnTimeRule
+	for rows.Next() {
+		var rule TurnTimeRule
+		if err := rows.Scan(&rule.MinPartySize, &rule.MaxPartySize, &rule.Weekdays,
+			&rule.PeriodStart, &rule.PeriodEnd, &rule.DurationMinutes, &rule.ExtraMinutes); err != nil {
+			return nil, err
+		}
+		rules = append(rules, &rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// ReplacePacingRules atomically replaces all pacing rules of a venue
+func (r *Repository) ReplacePacingRules(ctx context.Context, venueID string, rules []*PacingRule) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM pacing_rules WHERE venue_id = $1`, venueID); err != nil {
+		return err
+	}
+
+	for i, rule := range rules {
+		weekdays := rule.Weekdays
+		if weekdays == nil {
+			weekdays = []int32{}
+		}
+		_, err := tx.Exec(ctx,
+			`INSERT INTO pacing_rules (id, venue_id, position, interval_minutes, weekdays,
+			 period_start, period_end, max_covers, max_bookings)
+			 VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::time, NULLIF($7, '')::time, $8, $9)`,
+			uuid.New().String(), venueID, i, rule.IntervalMinutes, weekdays,
+			rule.PeriodStart, rule.PeriodEnd, rule.MaxCovers, rule.MaxBookings)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *Repository) ListPacingRules(ctx context.Context, venueID string) ([]*PacingRule, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT interval_minutes, weekdays,
+		 COALESCE(to_char(period_start, 'HH24:MI'), ''), COALESCE(to_char(period_end, 'HH24:MI'), ''),
+		 max_covers, max_bookings
+		 FROM pacing_rules WHERE venue_id = $1 ORDER BY position`,
+		venueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*PacingRule
+	for rows.Next() {
+		var rule PacingRule
+		if err := rows.Scan(&rule.IntervalMinutes, &rule.Weekdays, &rule.PeriodStart, &rule.PeriodEnd,
+			&rule.MaxCovers, &rule.MaxBookings); err != nil {
+			return nil, err
+		}
+		rules = append(rules, &rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// ReplaceOpeningHours atomically replaces the weekly schedule of a venue
+func (r *Repository) ReplaceOpeningHours(ctx context.Context, venueID string, days []*DayHours) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM opening_hours WHERE venue_id = $1`, venueID); err != nil {
+		return err
+	}
+
+	for _, day := range days {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO opening_hours (id, venue_id, weekday, open_time, close_time)
+			 VALUES ($1, $2, $3, $4::time, $5::time)`,
+			uuid.New().String(), venueID, day.Weekday, day.OpenTime, day.CloseTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	r.InvalidateSchedule(ctx, venueID, "")
+	return nil
+}
+
+func (r *Repository) ListOpeningHours(ctx context.Context, venueID string) ([]*DayHours, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT weekday, to_char(open_time, 'HH24:MI'), to_char(close_time, 'HH24:MI')
+		 FROM opening_hours WHERE venue_id = $1 ORDER BY weekday`,
+		venueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []*DayHours
+	for rows.Next() {
+		var day DayHours
+		if err := rows.Scan(&day.Weekday, &day.OpenTime, &day.CloseTime); err != nil {
+			return nil, err
+		}
+		days = append(days, &day)
+	}
+
+	return days, rows.Err()
+}
+
+const upsertSpecialHoursQuery = `INSERT INTO special_hours (id, venue_id, date, open_time, close_time, is_closed)
+		 VALUES ($1, $2, $3, NULLIF($4, '')::time, NULLIF($5, '')::time, $6)
+		 ON CONFLICT (venue_id, date) DO UPDATE
+		 SET open_time = EXCLUDED.open_time, close_time = EXCLUDED.close_time, is_closed = EXCLUDED.is_closed`
+
+func (r *Repository) UpsertSpecialHours(ctx context.Context, hours *SpecialHours) error {
+	_, err := r.db.Exec(ctx, upsertSpecialHoursQuery,
+		uuid.New().String(), hours.VenueID, hours.Date, hours.OpenTime, hours.CloseTime, hours.IsClosed)
+	if err == nil {
+		r.InvalidateSchedule(ctx, hours.VenueID, hours.Date)
+	}
+	return err
+}
+
+// UpsertSpecialHoursBatch writes overrides of any number of venues and dates in one transaction
+func (r *Repository) UpsertSpecialHoursBatch(ctx context.Context, hours []*SpecialHours) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	batch := &pgx.Batch{}
+	for _, h := range hours {
+		batch.Queue(upsertSpecialHoursQuery,
+			uuid.New().String(), h.VenueID, h.Date, h.OpenTime, h.CloseTime, h.IsClosed)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	for _, h := range hours {
+		r.InvalidateSchedule(ctx, h.VenueID, h.Date)
+	}
+	return nil
+}
+
+// ListSpecialHours returns the overrides of a venue on the given dates, keyed by date
+func (r *Repository) ListSpecialHours(ctx context.Context, venueID string, dates []string) (map[string]*SpecialHours, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT to_char(date, 'YYYY-MM-DD'), COALESCE(to_char(open_time, 'HH24:MI'), ''),
+		 COALESCE(to_char(close_time, 'HH24:MI'), ''), is_closed
+		 FROM special_hours WHERE venue_id = $1 AND date = ANY($2::date[])`,
+		venueID, dates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hours := make(map[string]*SpecialHours)
+	for rows.Next() {
+		h := &SpecialHours{VenueID: venueID}
+		if err := rows.Scan(&h.Date, &h.OpenTime, &h.CloseTime, &h.IsClosed); err != nil {
+			return nil, err
+		}
+		hours[h.Date] = h
+	}
+
+	return hours, rows.Err()
+}
+
+// GetSpecialHours returns the override for a date, or nil if the regular schedule applies
+func (r *Repository) GetSpecialHours(ctx context.Context, venueID, date string) (*SpecialHours, error) {
+	hours := &SpecialHours{VenueID: venueID, Date: date}
+	err := r.db.QueryRow(ctx,
+		`SELECT COALESCE(to_char(open_time, 'HH24:MI'), ''), COALESCE(to_char(close_time, 'HH24:MI'), ''), is_closed
+		 FROM special_hours WHERE venue_id = $1 AND date = $2`,
+		venueID, date).Scan(&hours.OpenTime, &hours.CloseTime, &hours.IsClosed)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return hours, nil
+}
+
+// SaveRoomLayout stores room dimensions and positions of its tables in one transaction.
+// It fails with ErrLayoutVersionConflict if the room layout changed since layout.LayoutVersion
+// was read, and returns the new version otherwise
+func (r *Repository) SaveRoomLayout(ctx context.Context, layout *RoomLayout) (int32, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var version int32
+	err = tx.QueryRow(ctx,
+		`UPDATE rooms SET width = $1, height = $2, background_url = $3,
+		 layout_version = layout_version + 1, updated_at = NOW()
+		 WHERE id = $4 AND layout_version = $5
+		 RETURNING layout_version`,
+		layout.Width, layout.Height, layout.BackgroundURL, layout.RoomID, layout.LayoutVersion).
+		Scan(&version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, ErrLayoutVersionConflict
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	for _, t := range layout.Tables {
+		tag, err := tx.Exec(ctx,
+			`UPDATE tables SET pos_x = $1, pos_y = $2, width = $3, height = $4, shape = $5, rotation = $6, updated_at = NOW()
+			 WHERE id = $7 AND room_id = $8 AND deleted_at IS NULL`,
+			t.X, t.Y, t.Width, t.Height, t.Shape, t.Rotation, t.TableID, layout.RoomID)
+		if err != nil {
+			return 0, err
+		}
+		if tag.RowsAffected() == 0 {
+			return 0, fmt.Errorf("%w: table %s, room %s", ErrTableNotInRoom, t.TableID, layout.RoomID)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	r.invalidateRoomLayout(ctx, layout.RoomID)
+	return version, nil
+}
+
+// bumpLayoutVersion invalidates layouts opened in editors after tables were added or
+// removed. It runs in the transaction of the change, so the two never part
+func bumpLayoutVersion(ctx context.Context, tx pgx.Tx, roomID string) error {
+	_, err := tx.Exec(ctx,
+		`UPDATE rooms SET layout_version = layout_version + 1, updated_at = NOW() WHERE id = $1`,
+		roomID)
+	return err
+}
+
+// ErrLayoutVersionConflict is returned when a room layout was saved by someone else meanwhile
+var ErrLayoutVersionConflict = errors.New("room layout was modified concurrently")
+
+// ErrTableNotInRoom is returned when a saved layout places a table that is not in the room
+var ErrTableNotInRoom = errors.New("table not found in room")
+
+// ErrNotDeleted is returned when restoring a venue, room or table that is not deleted
+var ErrNotDeleted = errors.New("not deleted")
+
+// ErrParentDeleted is returned when restoring a room or table whose venue or room is still deleted
+var ErrParentDeleted = errors.New("parent is deleted, restore it first")
+
 // Models
 type Venue struct {
-	ID        string
-	Name      string
-	Timezone  string
-	Address   string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID            string
+	Name          string
+	Timezone      string
+	Address       string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	BufferMinutes int32
+	DeletedAt     *time.Time
 }
 
 type Room struct {
-	ID        string
-	VenueID   string
-	Name      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID            string
+	VenueID       string
+	Name          string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Width         float64
+	Height        float64
+	BackgroundURL string
+	LayoutVersion int32
+	DeletedAt     *time.Time
 }
 
 type Table struct {
 	ID        string
 	RoomID    string
+	VenueID   string
 	Name      string
 	Capacity  int32
 	CanMerge  bool
 	Zone      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	X         float64
+	Y         float64
+	Width     float64
+	Height    float64
+	Shape     string
+	Rotation  float64
+	// Minutes to reset the table after a booking, 0 - venue's buffer
+	BufferMinutes int32
+	DeletedAt     *time.Time
+}
+
+type TableCombination struct {
+	ID          string
+	RoomID      string
+	Name        string
+	TableIDs    []string
+	MinCapacity int32
+	MaxCapacity int32
+	CreatedAt   time.Time
+}
+
+// TableBlock keeps a table out of bookings for a time range, once or on a schedule
+type TableBlock struct {
+	ID         string
+	VenueID    string
+	TableID    string
+	Date       string // first day
+	UntilDate  string // last day, empty - no end
+	StartTime  string
+	EndTime    string // at or before StartTime - until midnight
+	Reason     string
+	Recurrence string // "", daily or weekly
+	CreatedBy  string
+	CreatedAt  time.Time
+}
+
+type TurnTimeRule struct {
+	MinPartySize    int32
+	MaxPartySize    int32
+	Weekdays        []int32
+	PeriodStart     string
+	PeriodEnd       string
+	DurationMinutes int32
+	ExtraMinutes    int32
+}
+
+type PacingRule struct {
+	IntervalMinutes int32
+	Weekdays        []int32
+	PeriodStart     string
+	PeriodEnd       string
+	MaxCovers       int32
+	MaxBookings     int32
+}
+
+type DayHours struct {
+	Weekday   int32
+	OpenTime  string
+	CloseTime string
+}
+
+type SpecialHours struct {
+	VenueID   string
+	Date      string
+	OpenTime  string
+	CloseTime string
+	IsClosed  bool
+}
+
+// RoomLayout is a full floor plan of a room saved by SaveRoomLayout
+type RoomLayout struct {
+	RoomID        string
+	LayoutVersion int32 // version the editor started from
+	Width         float64
+	Height        float64
+	BackgroundURL string
+	Tables        []*TablePlacement
+}
+
+type TablePlacement struct {
+	TableID  string
+	X        float64
+	Y        float64
+	Width    float64
+	Height   float64
+	Shape    string
+	Rotation float64
 }
 
@@ -89,7 +89,7 @@ func TestRepository_CreateVenue_Integration(t *testing.T) {
 	defer cleanup()
 
 	ctx := context.Background()
-	id, err := repo.CreateVenue(ctx, "Test Venue", "UTC", "123 Main St")
+	id, err := repo.CreateVenue(ctx, "Test Venue", "UTC", "123 Main St", 0)
 	require.NoError(t, err)
 	assert.NotEmpty(t, id)
 
@@ -112,7 +112,7 @@ func TestRepository_CreateRoom_Integration(t *testing.T) {
 	ctx := context.Background()
 
 	// Create venue first
-	venueID, err := repo.CreateVenue(ctx, "Test Venue", "UTC", "123 Main St")
+	venueID, err := repo.CreateVenue(ctx, "Test Venue", "UTC", "123 Main St", 0)
 	require.NoError(t, err)
 
 	// Create room
@@ -138,14 +138,14 @@ func TestRepository_CreateTable_Integration(t *testing.T) {
 	ctx := context.Background()
 
 	// Create venue and room first
-	venueID, err := repo.CreateVenue(ctx, "Test Venue", "UTC", "123 Main St")
+	venueID, err := repo.CreateVenue(ctx, "Test Venue", "UTC", "123 Main St", 0)
 	require.NoError(t, err)
 
 	roomID, err := repo.CreateRoom(ctx, venueID, "Main Room")
 	require.NoError(t, err)
 
 	// Create table
-	tableID, err := repo.CreateTable(ctx, roomID, "Table 1", 4, true, "window")
+	tableID, err := repo.CreateTable(ctx, roomID, "Table 1", 4, true, "window", 0)
 	require.NoError(t, err)
 	assert.NotEmpty(t, tableID)
 
@@ -170,28 +170,63 @@ func TestRepository_ListTables_Integration(t *testing.T) {
 	ctx := context.Background()
 
 	// Create venue, room, and tables
-	venueID, err := repo.CreateVenue(ctx, "Test Venue", "UTC", "123 Main St")
+	venueID, err := repo.CreateVenue(ctx, "Test Venue", "UTC", "123 Main St", 0)
 	require.NoError(t, err)
 
 	roomID, err := repo.CreateRoom(ctx, venueID, "Main Room")
 	require.NoError(t, err)
 
-	_, err = repo.CreateTable(ctx, roomID, "Table 1", 4, true, "window")
+	_, err = repo.CreateTable(ctx, roomID, "Table 1", 4, true, "window", 0)
 	require.NoError(t, err)
 
-	_, err = repo.CreateTable(ctx, roomID, "Table 2", 2, false, "corner")
+	_, err = repo.CreateTable(ctx, roomID, "Table 2", 2, false, "corner", 0)
 	require.NoError(t, err)
 
 	// List tables by room
-	tables, total, err := repo.ListTables(ctx, roomID, "", 10, 0)
+	tables, total, err := repo.ListTables(ctx, roomID, "", 10, 0, false)
 	require.NoError(t, err)
 	assert.Equal(t, int32(2), total)
 	assert.Equal(t, 2, len(tables))
 
 	// List tables by venue
-	tables, total, err = repo.ListTables(ctx, "", venueID, 10, 0)
+	tables, total, err = repo.ListTables(ctx, "", venueID, 10, 0, false)
 	require.NoError(t, err)
 	assert.Equal(t, int32(2), total)
 	assert.Equal(t, 2, len(tables))
 }
 
+func TestRepository_SoftDelete_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	repo, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	venueID, err := repo.CreateVenue(ctx, "Test Venue", "UTC", "123 Main St", 0)
+	require.NoError(t, err)
+	roomID, err := repo.CreateRoom(ctx, venueID, "Main Room")
+	require.NoError(t, err)
+	tableID, err := repo.CreateTable(ctx, roomID, "Table 1", 4, true, "window", 0)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.DeleteVenue(ctx, venueID))
+
+	_, total, err := repo.ListTables(ctx, roomID, "", 10, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), total)
+	tables, _, err := repo.ListTables(ctx, roomID, "", 10, 0, true)
+	require.NoError(t, err)
+	require.Len(t, tables, 1)
+	assert.NotNil(t, tables[0].DeletedAt)
+
+	assert.ErrorIs(t, repo.RestoreTable(ctx, tableID), ErrParentDeleted)
+	require.NoError(t, repo.RestoreVenue(ctx, venueID))
+	assert.ErrorIs(t, repo.RestoreVenue(ctx, venueID), ErrNotDeleted)
+
+	table, err := repo.GetTable(ctx, tableID)
+	require.NoError(t, err)
+	assert.Nil(t, table.DeletedAt)
+}
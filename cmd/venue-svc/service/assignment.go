@@ -0,0 +1,116 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"booker/cmd/venue-svc/repository"
+	commonpb "booker/pkg/proto/common"
+	venuepb "booker/pkg/proto/venue"
+)
+
+// Assignment penalties, in points of a 100-point score
+const (
+	wastedSeatPenalty   = 8.0
+	extraTablePenalty   = 10.0
+	zoneMismatchPenalty = 30.0
+	scarceTablePenalty  = 15.0
+)
+
+// suggestAssignments scores availability options for a party and returns them best first.
+// An option loses points for empty seats, for every table pushed together, for being
+// outside the preferred zone and for taking one of the few free tables of its size
+// that a larger party could need later
+func suggestAssignments(partySize int32, preferredZone string, options []*venuepb.TableAvailability, tables []*repository.Table, available map[string]bool) []*venuepb.TableAssignment {
+	tableMap := make(map[string]*repository.Table, len(tables))
+	for _, t := range tables {
+		tableMap[t.ID] = t
+	}
+
+	// freeAtLeast returns the number of free tables seating at least capacity guests
+	freeAtLeast := func(capacity int32) int {
+		n := 0
+		for _, t := range tables {
+			if available[t.ID] && t.Capacity >= capacity {
+				n++
+			}
+		}
+		return n
+	}
+
+	assignments := make([]*venuepb.TableAssignment, 0, len(options))
+	penalties := make(map[*venuepb.TableAssignment]float64, len(options))
+	for _, option := range options {
+		refs := option.CombinedTables
+		if len(refs) == 0 {
+			refs = []*commonpb.TableRef{option.Table}
+		}
+
+		var penalty float64
+		var reasons []string
+
+		wasted := option.Capacity - partySize
+		if wasted > 0 {
+			penalty += float64(wasted) * wastedSeatPenalty
+			reasons = append(reasons, fmt.Sprintf("%d empty seats", wasted))
+		}
+
+		if len(refs) > 1 {
+			penalty += float64(len(refs)-1) * extraTablePenalty
+			reasons = append(reasons, fmt.Sprintf("%d tables merged", len(refs)))
+		}
+
+		if preferredZone != "" {
+			for _, ref := range refs {
+				if t := tableMap[ref.TableId]; t == nil || !strings.EqualFold(t.Zone, preferredZone) {
+					penalty += zoneMismatchPenalty
+					reasons = append(reasons, "outside preferred zone")
+					break
+				}
+			}
+		}
+
+		if wasted > 0 {
+			scarcity := 0.0
+			var scarce *repository.Table
+			for _, ref := range refs {
+				t := tableMap[ref.TableId]
+				if t == nil {
+					continue
+				}
+				if n := freeAtLeast(t.Capacity); n > 0 && 1/float64(n) > scarcity {
+					scarcity = 1 / float64(n)
+					scarce = t
+				}
+			}
+			if scarce != nil {
+				penalty += scarceTablePenalty * scarcity
+				reasons = append(reasons, fmt.Sprintf("%.0f free tables for %d+ guests", 1/scarcity, scarce.Capacity))
+			}
+		}
+
+		reason := "exact fit"
+		if len(reasons) > 0 {
+			reason = strings.Join(reasons, ", ")
+		}
+
+		assignment := &venuepb.TableAssignment{
+			Tables:        refs,
+			CombinationId: option.CombinationId,
+			Capacity:      option.Capacity,
+			Score:         math.Round(math.Max(0, 100-penalty)*10) / 10,
+			Reason:        reason,
+		}
+		assignments = append(assignments, assignment)
+		penalties[assignment] = penalty
+	}
+
+	// Sort by raw penalty, the score is clamped at zero and would tie bad options
+	sort.SliceStable(assignments, func(i, j int) bool {
+		return penalties[assignments[i]] < penalties[assignments[j]]
+	})
+
+	return assignments
+}
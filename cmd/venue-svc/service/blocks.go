@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"booker/cmd/venue-svc/repository"
+	venuepb "booker/pkg/proto/venue"
+	"booker/pkg/tracing"
+)
+
+var blockRecurrences = map[string]bool{
+	"":       true,
+	"daily":  true,
+	"weekly": true,
+}
+
+// CreateTableBlock takes a table out of bookings for a time range. Bookings the
+// table already has are kept; the block only stops new ones
+func (s *Service) CreateTableBlock(ctx context.Context, req *venuepb.CreateTableBlockRequest) (*venuepb.TableBlock, error) {
+	ctx, span := tracing.StartSpan(ctx, "CreateTableBlock")
+	defer span.End()
+
+	table, err := s.repo.GetTable(ctx, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+	if table.DeletedAt != nil {
+		return nil, fmt.Errorf("table %s is deleted", req.TableId)
+	}
+
+	block := &repository.TableBlock{
+		TableID:    req.TableId,
+		Date:       req.Date,
+		UntilDate:  req.UntilDate,
+		StartTime:  formatClock(req.StartTime),
+		EndTime:    formatClock(req.EndTime),
+		Reason:     req.Reason,
+		Recurrence: req.Recurrence,
+		CreatedBy:  req.AdminId,
+	}
+	if err := validateTableBlock(block); err != nil {
+		return nil, err
+	}
+	if block.Recurrence == "" {
+		block.UntilDate = block.Date
+	}
+
+	id, err := s.repo.CreateTableBlock(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.repo.GetTableBlock(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("table_id", req.TableId).
+		Str("block_id", id).
+		Str("date", req.Date).
+		Str("recurrence", req.Recurrence).
+		Msg("Table blocked")
+
+	return toTableBlockProto(created), nil
+}
+
+func (s *Service) ListTableBlocks(ctx context.Context, req *venuepb.ListTableBlocksRequest) (*venuepb.ListTableBlocksResponse, error) {
+	if req.VenueId == "" && req.TableId == "" {
+		return nil, fmt.Errorf("venue_id or table_id is required")
+	}
+
+	var blocks []*repository.TableBlock
+	var err error
+	if req.Date != "" {
+		blocks, err = s.dayBlocks(ctx, req.VenueId, req.TableId, req.Date)
+	} else {
+		blocks, err = s.repo.ListTableBlocks(ctx, req.VenueId, req.TableId, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &venuepb.ListTableBlocksResponse{Blocks: make([]*venuepb.TableBlock, len(blocks))}
+	for i, b := range blocks {
+		resp.Blocks[i] = toTableBlockProto(b)
+	}
+	return resp, nil
+}
+
+func (s *Service) DeleteTableBlock(ctx context.Context, req *venuepb.DeleteTableBlockRequest) (*venuepb.DeleteTableBlockResponse, error) {
+	if err := s.repo.DeleteTableBlock(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &venuepb.DeleteTableBlockResponse{Success: true}, nil
+}
+
+// dayBlocks returns the blocks of a venue, or of one table, that apply on a date
+func (s *Service) dayBlocks(ctx context.Context, venueID, tableID, date string) ([]*repository.TableBlock, error) {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
+	}
+	candidates, err := s.repo.ListTableBlocks(ctx, venueID, tableID, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load table blocks: %w", err)
+	}
+
+	blocks := make([]*repository.TableBlock, 0, len(candidates))
+	for _, b := range candidates {
+		if blockOccursOn(b, day) {
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks, nil
+}
+
+func validateTableBlock(b *repository.TableBlock) error {
+	start, err := time.Parse("2006-01-02", b.Date)
+	if err != nil {
+		return fmt.Errorf("invalid date %q, expected YYYY-MM-DD", b.Date)
+	}
+	if b.UntilDate != "" {
+		until, err := time.Parse("2006-01-02", b.UntilDate)
+		if err != nil {
+			return fmt.Errorf("invalid until_date %q, expected YYYY-MM-DD", b.UntilDate)
+		}
+		if until.Before(start) {
+			return fmt.Errorf("until_date is before date")
+		}
+	}
+	from, err := clockMinutes(b.StartTime)
+	if err != nil {
+		return err
+	}
+	to, err := clockMinutes(b.EndTime)
+	if err != nil {
+		return err
+	}
+	// A block never runs past midnight: 00:00 ends it at the end of the day, a
+	// night block is split into one before and one after midnight
+	if to != 0 && to <= from {
+		return fmt.Errorf("end_time must be after start_time, use 00:00 to block until midnight")
+	}
+	if !blockRecurrences[b.Recurrence] {
+		return fmt.Errorf("unknown recurrence %q, expected daily or weekly", b.Recurrence)
+	}
+	return nil
+}
+
+// blockOccursOn reports whether a block applies on a day. A weekly block repeats
+// on the weekday of its first date
+func blockOccursOn(b *repository.TableBlock, day time.Time) bool {
+	date := day.Format("2006-01-02")
+	if date < b.Date || (b.UntilDate != "" && date > b.UntilDate) {
+		return false
+	}
+	switch b.Recurrence {
+	case "daily":
+		return true
+	case "weekly":
+		first, err := time.Parse("2006-01-02", b.Date)
+		return err == nil && first.Weekday() == day.Weekday()
+	default:
+		return date == b.Date
+	}
+}
+
+// blockInterval returns the minutes a block covers. Ending at 00:00, or at or before
+// the start in blocks saved before that was rejected, means the block lasts until midnight
+func blockInterval(b *repository.TableBlock) busyInterval {
+	start, _ := clockMinutes(b.StartTime)
+	end, _ := clockMinutes(b.EndTime)
+	if end <= start {
+		end = 24 * 60
+	}
+	return busyInterval{start: start, end: end}
+}
+
+// addBlockIntervals marks tables busy while they are blocked
+func addBlockIntervals(busy map[string][]busyInterval, blocks []*repository.TableBlock) map[string][]busyInterval {
+	if busy == nil {
+		busy = make(map[string][]busyInterval, len(blocks))
+	}
+	for _, b := range blocks {
+		busy[b.TableID] = append(busy[b.TableID], blockInterval(b))
+	}
+	return busy
+}
+
+func toTableBlockProto(b *repository.TableBlock) *venuepb.TableBlock {
+	return &venuepb.TableBlock{
+		Id:         b.ID,
+		VenueId:    b.VenueID,
+		TableId:    b.TableID,
+		Date:       b.Date,
+		StartTime:  b.StartTime,
+		EndTime:    b.EndTime,
+		Reason:     b.Reason,
+		Recurrence: b.Recurrence,
+		UntilDate:  b.UntilDate,
+		CreatedBy:  b.CreatedBy,
+		CreatedAt:  b.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"booker/cmd/venue-svc/repository"
+	commonpb "booker/pkg/proto/common"
+	venuepb "booker/pkg/proto/venue"
+	"booker/pkg/tracing"
+)
+
+func (s *Service) CreateTableCombination(ctx context.Context, req *venuepb.CreateTableCombinationRequest) (*venuepb.TableCombination, error) {
+	ctx, span := tracing.StartSpan(ctx, "CreateTableCombination")
+	defer span.End()
+
+	if req.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if len(req.TableIds) < 2 {
+		return nil, fmt.Errorf("a combination needs at least two tables")
+	}
+	seen := make(map[string]bool, len(req.TableIds))
+	for _, id := range req.TableIds {
+		if seen[id] {
+			return nil, fmt.Errorf("table %s is listed more than once", id)
+		}
+		seen[id] = true
+	}
+	if req.MinCapacity < 0 || req.MaxCapacity < 0 {
+		return nil, fmt.Errorf("capacity must not be negative")
+	}
+	if req.MaxCapacity > 0 && req.MinCapacity > req.MaxCapacity {
+		return nil, fmt.Errorf("min_capacity is greater than max_capacity")
+	}
+
+	id, err := s.repo.CreateTableCombination(ctx, req.RoomId, req.Name, req.MinCapacity, req.MaxCapacity, req.TableIds)
+	if err != nil {
+		return nil, err
+	}
+
+	combination, err := s.repo.GetTableCombination(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toTableCombinationProto(combination), nil
+}
+
+func (s *Service) ListTableCombinations(ctx context.Context, req *venuepb.ListTableCombinationsRequest) (*venuepb.ListTableCombinationsResponse, error) {
+	combinations, err := s.repo.ListTableCombinations(ctx, req.RoomId, req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+
+	protoCombinations := make([]*venuepb.TableCombination, len(combinations))
+	for i, c := range combinations {
+		protoCombinations[i] = toTableCombinationProto(c)
+	}
+
+	return &venuepb.ListTableCombinationsResponse{Combinations: protoCombinations}, nil
+}
+
+func (s *Service) DeleteTableCombination(ctx context.Context, req *venuepb.DeleteTableCombinationRequest) (*venuepb.DeleteTableCombinationResponse, error) {
+	if err := s.repo.DeleteTableCombination(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &venuepb.DeleteTableCombinationResponse{Success: true}, nil
+}
+
+// combinationOption is a set of free tables that together can seat a party
+type combinationOption struct {
+	combinationID string
+	name          string
+	tables        []*repository.Table
+	capacity      int32
+}
+
+// findCombinations returns options for seating a party at several tables, smallest first.
+// Rooms with configured combinations only use those; other rooms fall back to
+// pairs of can_merge tables, each pair reported once
+func findCombinations(partySize int32, tables []*repository.Table, combinations []*repository.TableCombination, available map[string]bool) []*combinationOption {
+	tableMap := make(map[string]*repository.Table, len(tables))
+	for _, t := range tables {
+		tableMap[t.ID] = t
+	}
+
+	configuredRooms := make(map[string]bool)
+	var options []*combinationOption
+
+	for _, c := range combinations {
+		configuredRooms[c.RoomID] = true
+
+		members := make([]*repository.Table, 0, len(c.TableIDs))
+		var total int32
+		free := true
+		for _, id := range c.TableIDs {
+			t, ok := tableMap[id]
+			if !ok || !available[id] {
+				free = false
+				break
+			}
+			members = append(members, t)
+			total += t.Capacity
+		}
+		if !free || len(members) < 2 {
+			continue
+		}
+
+		capacity := total
+		if c.MaxCapacity > 0 {
+			capacity = c.MaxCapacity
+		}
+		if partySize < c.MinCapacity || partySize > capacity {
+			continue
+		}
+
+		options = append(options, &combinationOption{
+			combinationID: c.ID,
+			name:          c.Name,
+			tables:        members,
+			capacity:      capacity,
+		})
+	}
+
+	for i, t1 := range tables {
+		if configuredRooms[t1.RoomID] || !t1.CanMerge || !available[t1.ID] {
+			continue
+		}
+		for _, t2 := range tables[i+1:] {
+			if t2.RoomID != t1.RoomID || !t2.CanMerge || !available[t2.ID] {
+				continue
+			}
+			if t1.Capacity+t2.Capacity < partySize {
+				continue
+			}
+			options = append(options, &combinationOption{
+				tables:   []*repository.Table{t1, t2},
+				capacity: t1.Capacity + t2.Capacity,
+			})
+		}
+	}
+
+	sort.SliceStable(options, func(i, j int) bool {
+		if options[i].capacity != options[j].capacity {
+			return options[i].capacity < options[j].capacity
+		}
+		return len(options[i].tables) < len(options[j].tables)
+	})
+
+	return options
+}
+
+func (o *combinationOption) toAvailability(venueID string) *venuepb.TableAvailability {
+	refs := make([]*commonpb.TableRef, len(o.tables))
+	names := make([]string, 0, len(o.tables)-1)
+	for i, t := range o.tables {
+		refs[i] = &commonpb.TableRef{
+			VenueId: venueID,
+			RoomId:  t.RoomID,
+			TableId: t.ID,
+		}
+		if i > 0 {
+			names = append(names, t.Name)
+		}
+	}
+
+	reason := fmt.Sprintf("Can be merged with table %s (total capacity: %d)", strings.Join(names, ", "), o.capacity)
+	if o.name != "" {
+		reason = fmt.Sprintf("Can be merged with table %s as %q (capacity: %d)", strings.Join(names, ", "), o.name, o.capacity)
+	}
+
+	return &venuepb.TableAvailability{
+		Table:           refs[0],
+		Available:       true,
+		Reason:          reason,
+		MergedWithTable: refs[1],
+		CombinedTables:  refs,
+		CombinationId:   o.combinationID,
+		Capacity:        o.capacity,
+	}
+}
+
+// findCombination returns the combination made of exactly the given tables, or nil
+func findCombination(ids []string, combinations []*repository.TableCombination) *repository.TableCombination {
+	if len(ids) < 2 {
+		return nil
+	}
+	sorted := slices.Sorted(slices.Values(ids))
+	for _, c := range combinations {
+		if slices.Equal(sorted, slices.Sorted(slices.Values(c.TableIDs))) {
+			return c
+		}
+	}
+	return nil
+}
+
+func toTableCombinationProto(c *repository.TableCombination) *venuepb.TableCombination {
+	return &venuepb.TableCombination{
+		Id:          c.ID,
+		RoomId:      c.RoomID,
+		Name:        c.Name,
+		TableIds:    c.TableIDs,
+		MinCapacity: c.MinCapacity,
+		MaxCapacity: c.MaxCapacity,
+		CreatedAt:   c.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"booker/cmd/venue-svc/repository"
+	bookingpb "booker/pkg/proto/booking"
+	venuepb "booker/pkg/proto/venue"
+)
+
+// deletionPlan says what happens to upcoming bookings on tables about to be deleted
+type deletionPlan struct {
+	moves    []*bookingMove
+	cancels  []*bookingpb.Booking
+	blocking []string
+}
+
+type bookingMove struct {
+	booking  *bookingpb.Booking
+	tableIDs []string
+	buffer   int32
+}
+
+// deletionResult lists bookings and room hires the deletion saga touched, or the ones
+// that stopped it
+type deletionResult struct {
+	blocking       []string
+	cancelled      []string
+	moved          []string
+	blockingHires  []string
+	cancelledHires []string
+}
+
+// planDeletion moves bookings that have a reassignment, cancels the rest if force is
+// set and otherwise reports them as blocking. Reassignments must point at tables
+// of the venue that survive the deletion and seat the whole party; tables forming
+// a combination seat its max_capacity, as in availability
+func planDeletion(bookings []*bookingpb.Booking, deleted map[string]bool, tables []*repository.Table,
+	combinations []*repository.TableCombination, buffers map[string]int32,
+	force bool, reassignments []*venuepb.BookingReassignment) (*deletionPlan, error) {
+	tableMap := make(map[string]*repository.Table, len(tables))
+	for _, t := range tables {
+		tableMap[t.ID] = t
+	}
+
+	targets := make(map[string][]string, len(reassignments))
+	for _, r := range reassignments {
+		if _, ok := targets[r.BookingId]; ok {
+			return nil, fmt.Errorf("booking %s is reassigned more than once", r.BookingId)
+		}
+		targets[r.BookingId] = r.TableIds
+	}
+
+	plan := &deletionPlan{}
+	for _, b := range bookings {
+		tableIDs, ok := targets[b.Id]
+		if !ok {
+			if force {
+				plan.cancels = append(plan.cancels, b)
+			} else {
+				plan.blocking = append(plan.blocking, b.Id)
+			}
+			continue
+		}
+		delete(targets, b.Id)
+
+		if len(tableIDs) == 0 {
+			return nil, fmt.Errorf("reassignment of booking %s has no tables", b.Id)
+		}
+		move := &bookingMove{booking: b, tableIDs: tableIDs}
+		var capacity int32
+		for _, id := range tableIDs {
+			t, ok := tableMap[id]
+			if !ok || deleted[id] {
+				return nil, fmt.Errorf("booking %s cannot be moved to table %s: it is not available in the venue", b.Id, id)
+			}
+			capacity += t.Capacity
+			move.buffer = max(move.buffer, buffers[id])
+		}
+		if c := findCombination(tableIDs, combinations); c != nil && c.MaxCapacity > 0 {
+			capacity = c.MaxCapacity
+		}
+		if capacity < b.PartySize {
+			return nil, fmt.Errorf("booking %s for %d guests cannot be moved to tables seating %d", b.Id, b.PartySize, capacity)
+		}
+		plan.moves = append(plan.moves, move)
+	}
+
+	for id := range targets {
+		return nil, fmt.Errorf("booking %s is not an upcoming booking on the deleted tables", id)
+	}
+
+	return plan, nil
+}
+
+// runDeletionSaga clears upcoming bookings off tables before they are deleted, and
+// active hires of the rooms matched by hires unless it is nil. Moves go first and are
+// undone if one of them fails. Cancellations come last and cannot be undone; if one
+// fails the deletion is aborted, and repeating the request is safe as cancelled and
+// moved bookings no longer block it. Guests and hirers learn about them from
+// booking-svc events
+func (s *Service) runDeletionSaga(ctx context.Context, venueID string, tableIDs []string, hires *bookingpb.ListRoomHiresRequest,
+	force bool, reassignments []*venuepb.BookingReassignment, adminID, reason string) (*deletionResult, error) {
+	plan, err := s.planTableDeletion(ctx, venueID, tableIDs, force, reassignments)
+	if err != nil {
+		return nil, err
+	}
+
+	var activeHires []*bookingpb.RoomHire
+	if hires != nil {
+		// Hires are taken from yesterday on, which covers today in any timezone
+		listed, err := s.bookingClient.ListRoomHires(ctx, &bookingpb.ListRoomHiresRequest{
+			VenueId:    hires.VenueId,
+			RoomId:     hires.RoomId,
+			DateFrom:   time.Now().AddDate(0, 0, -1).Format("2006-01-02"),
+			ActiveOnly: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list room hires: %w", err)
+		}
+		activeHires = listed.RoomHires
+	}
+
+	// Without force bookings left without a reassignment and active hires block the deletion
+	if !force && (len(plan.blocking) > 0 || len(activeHires) > 0) {
+		result := &deletionResult{blocking: plan.blocking}
+		for _, h := range activeHires {
+			result.blockingHires = append(result.blockingHires, h.Id)
+		}
+		return result, nil
+	}
+
+	result := &deletionResult{}
+	var done []*bookingMove
+	for _, m := range plan.moves {
+		_, err := s.bookingClient.MoveBooking(ctx, &bookingpb.MoveBookingRequest{
+			Id:               m.booking.Id,
+			TableId:          m.tableIDs[0],
+			CombinedTableIds: m.tableIDs[1:],
+			BufferMinutes:    m.buffer,
+			AdminId:          adminID,
+			Reason:           reason,
+		})
+		if err != nil {
+			s.undoMoves(ctx, done, adminID)
+			return nil, fmt.Errorf("failed to move booking %s: %w", m.booking.Id, err)
+		}
+		done = append(done, m)
+		result.moved = append(result.moved, m.booking.Id)
+	}
+
+	for _, b := range plan.cancels {
+		_, err := s.bookingClient.CancelBooking(ctx, &bookingpb.CancelBookingRequest{
+			Id:      b.Id,
+			AdminId: adminID,
+			Reason:  reason,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to cancel booking %s: %w", b.Id, err)
+		}
+		result.cancelled = append(result.cancelled, b.Id)
+	}
+
+	for _, h := range activeHires {
+		_, err := s.bookingClient.CancelRoomHire(ctx, &bookingpb.CancelRoomHireRequest{
+			Id:      h.Id,
+			AdminId: adminID,
+			Reason:  reason,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to cancel room hire %s: %w", h.Id, err)
+		}
+		result.cancelledHires = append(result.cancelledHires, h.Id)
+	}
+
+	log.Info().
+		Str("venue_id", venueID).
+		Strs("moved", result.moved).
+		Strs("cancelled", result.cancelled).
+		Strs("cancelled_room_hires", result.cancelledHires).
+		Str("reason", reason).
+		Msg("Bookings and room hires cleared before deletion")
+
+	return result, nil
+}
+
+// planTableDeletion loads upcoming bookings on the tables and plans what happens to them
+func (s *Service) planTableDeletion(ctx context.Context, venueID string, tableIDs []string, force bool,
+	reassignments []*venuepb.BookingReassignment) (*deletionPlan, error) {
+	if len(tableIDs) == 0 {
+		return &deletionPlan{}, nil
+	}
+
+	upcoming, err := s.bookingClient.ListUpcomingBookings(ctx, &bookingpb.ListUpcomingBookingsRequest{
+		VenueId:  venueID,
+		TableIds: tableIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list upcoming bookings: %w", err)
+	}
+	if len(upcoming.Bookings) == 0 && len(reassignments) == 0 {
+		return &deletionPlan{}, nil
+	}
+
+	layout, err := s.repo.GetVenueLayout(ctx, venueID)
+	if err != nil {
+		return nil, err
+	}
+	venue, err := s.repo.GetVenue(ctx, venueID)
+	if err != nil {
+		return nil, err
+	}
+	combinations, err := s.repo.ListTableCombinations(ctx, "", venueID)
+	if err != nil {
+		return nil, err
+	}
+	deleted := make(map[string]bool, len(tableIDs))
+	for _, id := range tableIDs {
+		deleted[id] = true
+	}
+
+	return planDeletion(upcoming.Bookings, deleted, layout.Tables, combinations,
+		tableBuffers(layout.Tables, venue.BufferMinutes), force, reassignments)
+}
+
+// undoMoves puts moved bookings back on their original tables
+func (s *Service) undoMoves(ctx context.Context, moves []*bookingMove, adminID string) {
+	for _, m := range moves {
+		original := make([]string, 0, len(m.booking.CombinedTables))
+		for _, ref := range m.booking.CombinedTables {
+			original = append(original, ref.TableId)
+		}
+		_, err := s.bookingClient.MoveBooking(ctx, &bookingpb.MoveBookingRequest{
+			Id:               m.booking.Id,
+			TableId:          m.booking.Table.GetTableId(),
+			CombinedTableIds: original,
+			BufferMinutes:    m.booking.BufferMinutes,
+			AdminId:          adminID,
+			Reason:           "deletion aborted",
+		})
+		if err != nil {
+			log.Error().Err(err).Str("booking_id", m.booking.Id).Msg("Failed to undo booking move")
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"booker/cmd/venue-svc/repository"
+	"booker/pkg/holidays"
+	venuepb "booker/pkg/proto/venue"
+	"booker/pkg/tracing"
+)
+
+// ImportHolidays applies one rule, closed or custom hours, to a list of holidays
+// in several venues at once. With dry_run the changes are only reported
+func (s *Service) ImportHolidays(ctx context.Context, req *venuepb.ImportHolidaysRequest) (*venuepb.ImportHolidaysResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "ImportHolidays")
+	defer span.End()
+
+	if len(req.VenueIds) == 0 {
+		return nil, fmt.Errorf("venue_ids is required")
+	}
+	if !req.IsClosed {
+		if err := validateHours(req.OpenTime, req.CloseTime); err != nil {
+			return nil, err
+		}
+	}
+
+	list := req.Holidays
+	if len(list) == 0 {
+		if req.Country == "" {
+			return nil, fmt.Errorf("either holidays or country is required")
+		}
+		year := int(req.Year)
+		if year == 0 {
+			year = time.Now().Year()
+		}
+		builtin, err := holidays.ForCountry(req.Country, year)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range builtin {
+			list = append(list, &venuepb.Holiday{Date: h.Date, Name: h.Name})
+		}
+	}
+	list, err := mergeHolidays(list)
+	if err != nil {
+		return nil, err
+	}
+	dates := make([]string, len(list))
+	for i, h := range list {
+		dates[i] = h.Date
+	}
+
+	resp := &venuepb.ImportHolidaysResponse{}
+	var writes []*repository.SpecialHours
+	for _, venueID := range req.VenueIds {
+		venue, err := s.repo.GetVenue(ctx, venueID)
+		if err != nil {
+			return nil, fmt.Errorf("venue %s: %w", venueID, err)
+		}
+		if venue.DeletedAt != nil {
+			return nil, fmt.Errorf("venue %s is deleted", venueID)
+		}
+		existing, err := s.repo.ListSpecialHours(ctx, venueID, dates)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, h := range list {
+			hours := &repository.SpecialHours{
+				VenueID:   venueID,
+				Date:      h.Date,
+				OpenTime:  req.OpenTime,
+				CloseTime: req.CloseTime,
+				IsClosed:  req.IsClosed,
+			}
+			if hours.IsClosed {
+				hours.OpenTime, hours.CloseTime = "", ""
+			}
+			change := holidayChange(h, hours, existing[h.Date], req.DryRun)
+			resp.Changes = append(resp.Changes, change)
+
+			switch change.Status {
+			case "unchanged":
+				resp.Unchanged++
+				continue
+			case "created", "would_create":
+				resp.Created++
+			default:
+				resp.Updated++
+			}
+			writes = append(writes, hours)
+		}
+	}
+
+	if !req.DryRun && len(writes) > 0 {
+		if err := s.repo.UpsertSpecialHoursBatch(ctx, writes); err != nil {
+			return nil, err
+		}
+		for _, h := range writes {
+			s.publishScheduleUpdated(ctx, h.VenueID, h.Date)
+		}
+	}
+
+	log.Info().
+		Strs("venue_ids", req.VenueIds).
+		Int("holidays", len(list)).
+		Int32("created", resp.Created).
+		Int32("updated", resp.Updated).
+		Bool("dry_run", req.DryRun).
+		Msg("Holidays imported")
+
+	return resp, nil
+}
+
+// mergeHolidays validates dates and joins holidays falling on the same date, sorted by date
+func mergeHolidays(list []*venuepb.Holiday) ([]*venuepb.Holiday, error) {
+	byDate := make(map[string]*venuepb.Holiday, len(list))
+	for _, h := range list {
+		if _, err := time.Parse("2006-01-02", h.Date); err != nil {
+			return nil, fmt.Errorf("invalid holiday date %q, expected YYYY-MM-DD", h.Date)
+		}
+		merged, ok := byDate[h.Date]
+		if !ok {
+			byDate[h.Date] = &venuepb.Holiday{Date: h.Date, Name: h.Name}
+			continue
+		}
+		if h.Name != "" && !strings.Contains(merged.Name, h.Name) {
+			merged.Name = strings.TrimPrefix(merged.Name+"; "+h.Name, "; ")
+		}
+	}
+
+	merged := make([]*venuepb.Holiday, 0, len(byDate))
+	for _, h := range byDate {
+		merged = append(merged, h)
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].Date < merged[j].Date })
+	return merged, nil
+}
+
+// holidayChange compares the hours a holiday would get with the special hours already set on that date
+func holidayChange(h *venuepb.Holiday, hours, previous *repository.SpecialHours, dryRun bool) *venuepb.HolidayChange {
+	change := &venuepb.HolidayChange{
+		VenueId: hours.VenueID,
+		Date:    h.Date,
+		Name:    h.Name,
+	}
+
+	if previous == nil {
+		change.Status = "created"
+		if dryRun {
+			change.Status = "would_create"
+		}
+		return change
+	}
+
+	change.HadSpecialHours = true
+	change.PreviousIsClosed = previous.IsClosed
+	change.PreviousOpenTime = previous.OpenTime
+	change.PreviousCloseTime = previous.CloseTime
+
+	same := previous.IsClosed == hours.IsClosed
+	if same && !hours.IsClosed {
+		same = previous.OpenTime == hours.OpenTime && previous.CloseTime == hours.CloseTime
+	}
+	switch {
+	case same:
+		change.Status = "unchanged"
+	case dryRun:
+		change.Status = "would_update"
+	default:
+		change.Status = "updated"
+	}
+	return change
+}
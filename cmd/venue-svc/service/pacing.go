@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"booker/cmd/venue-svc/repository"
+	bookingpb "booker/pkg/proto/booking"
+	venuepb "booker/pkg/proto/venue"
+	"booker/pkg/tracing"
+)
+
+// bookingStart is an active booking as seen by pacing rules
+type bookingStart struct {
+	start  int // minutes after midnight
+	covers int32
+}
+
+func (s *Service) SetPacingRules(ctx context.Context, req *venuepb.SetPacingRulesRequest) (*venuepb.PacingRules, error) {
+	ctx, span := tracing.StartSpan(ctx, "SetPacingRules")
+	defer span.End()
+
+	rules := make([]*repository.PacingRule, len(req.Rules))
+	for i, r := range req.Rules {
+		if err := validatePacingRule(r); err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		rules[i] = &repository.PacingRule{
+			IntervalMinutes: r.IntervalMinutes,
+			Weekdays:        r.Weekdays,
+			PeriodStart:     r.PeriodStart,
+			PeriodEnd:       r.PeriodEnd,
+			MaxCovers:       r.MaxCovers,
+			MaxBookings:     r.MaxBookings,
+		}
+	}
+
+	if err := s.repo.ReplacePacingRules(ctx, req.VenueId, rules); err != nil {
+		return nil, err
+	}
+
+	return s.GetPacingRules(ctx, &venuepb.GetPacingRulesRequest{VenueId: req.VenueId})
+}
+
+func (s *Service) GetPacingRules(ctx context.Context, req *venuepb.GetPacingRulesRequest) (*venuepb.PacingRules, error) {
+	rules, err := s.repo.ListPacingRules(ctx, req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+
+	protoRules := make([]*venuepb.PacingRule, len(rules))
+	for i, r := range rules {
+		protoRules[i] = &venuepb.PacingRule{
+			IntervalMinutes: r.IntervalMinutes,
+			Weekdays:        r.Weekdays,
+			PeriodStart:     r.PeriodStart,
+			PeriodEnd:       r.PeriodEnd,
+			MaxCovers:       r.MaxCovers,
+			MaxBookings:     r.MaxBookings,
+		}
+	}
+
+	return &venuepb.PacingRules{VenueId: req.VenueId, Rules: protoRules}, nil
+}
+
+// pacingRejection checks a new booking against the venue's pacing rules and returns
+// the limits covering its start, which booking-svc checks again when it writes the
+// booking. The day's bookings are only loaded when some rule covers the start time
+func (s *Service) pacingRejection(ctx context.Context, venueID, date string, weekday int32, start int, partySize int32) (*venuepb.PacingRejection, []*venuepb.PacingLimit, error) {
+	rules, err := s.repo.ListPacingRules(ctx, venueID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	limits := pacingLimits(rules, weekday, start)
+	if len(limits) == 0 {
+		return nil, nil, nil
+	}
+
+	bookings, err := s.loadDayBookings(ctx, venueID, date)
+	if err != nil {
+		return nil, nil, err
+	}
+	return checkPacing(rules, weekday, start, partySize, bookingStarts(bookings)), limits, nil
+}
+
+// pacingLimits returns the interval of every rule covering start with its limits
+func pacingLimits(rules []*repository.PacingRule, weekday int32, start int) []*venuepb.PacingLimit {
+	startTime := minutesClock(start)
+	var limits []*venuepb.PacingLimit
+	for _, rule := range rules {
+		if !pacingRuleMatches(rule, weekday, startTime) {
+			continue
+		}
+		interval := int(rule.IntervalMinutes)
+		from := start / interval * interval
+		limits = append(limits, &venuepb.PacingLimit{
+			IntervalStart: minutesClock(from),
+			IntervalEnd:   minutesClock(from + interval),
+			MaxCovers:     rule.MaxCovers,
+			MaxBookings:   rule.MaxBookings,
+		})
+	}
+	return limits
+}
+
+func bookingStarts(bookings []*bookingpb.Booking) []bookingStart {
+	starts := make([]bookingStart, 0, len(bookings))
+	for _, b := range bookings {
+		if !occupyingStatuses[b.Status] {
+			continue
+		}
+		start, err := clockMinutes(b.Slot.GetStartTime())
+		if err != nil {
+			continue
+		}
+		starts = append(starts, bookingStart{start: start, covers: b.PartySize})
+	}
+	return starts
+}
+
+// checkPacing returns why a booking for partySize starting at start would exceed
+// a matching rule, or nil if every rule allows it. Intervals are aligned to midnight
+func checkPacing(rules []*repository.PacingRule, weekday int32, start int, partySize int32, starts []bookingStart) *venuepb.PacingRejection {
+	startTime := minutesClock(start)
+	for _, rule := range rules {
+		if !pacingRuleMatches(rule, weekday, startTime) {
+			continue
+		}
+
+		interval := int(rule.IntervalMinutes)
+		from := start / interval * interval
+		to := from + interval
+
+		var covers, bookings int32
+		for _, b := range starts {
+			if b.start >= from && b.start < to {
+				covers += b.covers
+				bookings++
+			}
+		}
+
+		rejection := &venuepb.PacingRejection{
+			IntervalStart: minutesClock(from),
+			IntervalEnd:   minutesClock(to),
+			Covers:        covers,
+			MaxCovers:     rule.MaxCovers,
+			Bookings:      bookings,
+			MaxBookings:   rule.MaxBookings,
+		}
+		switch {
+		case rule.MaxCovers > 0 && covers+partySize > rule.MaxCovers:
+			rejection.Reason = fmt.Sprintf("%d of %d covers already start between %s and %s",
+				covers, rule.MaxCovers, rejection.IntervalStart, rejection.IntervalEnd)
+			return rejection
+		case rule.MaxBookings > 0 && bookings+1 > rule.MaxBookings:
+			rejection.Reason = fmt.Sprintf("%d of %d bookings already start between %s and %s",
+				bookings, rule.MaxBookings, rejection.IntervalStart, rejection.IntervalEnd)
+			return rejection
+		}
+	}
+	return nil
+}
+
+func pacingRuleMatches(rule *repository.PacingRule, weekday int32, startTime string) bool {
+	if len(rule.Weekdays) > 0 && !containsWeekday(rule.Weekdays, weekday) {
+		return false
+	}
+	// HH:MM strings compare in chronological order
+	if rule.PeriodStart != "" && startTime < rule.PeriodStart {
+		return false
+	}
+	if rule.PeriodEnd != "" && startTime >= rule.PeriodEnd {
+		return false
+	}
+	return true
+}
+
+func validatePacingRule(rule *venuepb.PacingRule) error {
+	if rule.IntervalMinutes != 15 && rule.IntervalMinutes != 30 {
+		return fmt.Errorf("interval_minutes must be 15 or 30")
+	}
+	for _, d := range rule.Weekdays {
+		if d < 0 || d > 6 {
+			return fmt.Errorf("weekday %d is out of range 0-6", d)
+		}
+	}
+	for _, t := range []string{rule.PeriodStart, rule.PeriodEnd} {
+		if t == "" {
+			continue
+		}
+		if _, err := clockMinutes(t); err != nil {
+			return err
+		}
+	}
+	if rule.MaxCovers < 0 || rule.MaxBookings < 0 {
+		return fmt.Errorf("limits must not be negative")
+	}
+	if rule.MaxCovers == 0 && rule.MaxBookings == 0 {
+		return fmt.Errorf("rule sets neither max_covers nor max_bookings")
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// RunPurge permanently removes deleted venues, rooms and tables once their
+// retention period is over. It blocks until ctx is cancelled
+func (s *Service) RunPurge(ctx context.Context) {
+	retention := time.Duration(s.cfg.DeletedRetentionDays) * 24 * time.Hour
+	ticker := time.NewTicker(time.Duration(s.cfg.PurgeIntervalMinutes) * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		purged, err := s.repo.PurgeDeleted(ctx, retention)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to purge deleted venue entities")
+		} else if purged > 0 {
+			log.Info().Int64("purged", purged).Dur("retention", retention).Msg("Purged deleted venue entities")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
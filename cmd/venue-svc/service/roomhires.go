@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	bookingpb "booker/pkg/proto/booking"
+)
+
+// loadDayRoomHires returns the tentative and confirmed room hires of a venue on a date
+func (s *Service) loadDayRoomHires(ctx context.Context, venueID, date string) ([]*bookingpb.RoomHire, error) {
+	resp, err := s.bookingClient.ListRoomHires(ctx, &bookingpb.ListRoomHiresRequest{
+		VenueId:    venueID,
+		Date:       date,
+		ActiveOnly: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load room hires: %w", err)
+	}
+	return resp.RoomHires, nil
+}
+
+// hireInterval returns the minutes a hire covers, hires have no buffer
+func hireInterval(h *bookingpb.RoomHire) (busyInterval, bool) {
+	start, err := clockMinutes(h.Slot.GetStartTime())
+	if err != nil {
+		return busyInterval{}, false
+	}
+	return busyInterval{start: start, end: start + int(h.Slot.GetDurationMinutes())}, true
+}
+
+// addHireIntervals marks every table of a hired room busy for the hire
+func addHireIntervals(busy map[string][]busyInterval, hires []*bookingpb.RoomHire) map[string][]busyInterval {
+	if busy == nil {
+		busy = make(map[string][]busyInterval)
+	}
+	for _, h := range hires {
+		interval, ok := hireInterval(h)
+		if !ok {
+			continue
+		}
+		for _, id := range h.TableIds {
+			busy[id] = append(busy[id], interval)
+		}
+	}
+	return busy
+}
@@ -2,9 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"booker/cmd/venue-svc/config"
 	"booker/cmd/venue-svc/repository"
@@ -42,7 +47,11 @@ func (s *Service) CreateVenue(ctx context.Context, req *venuepb.CreateVenueReque
 		Str("address", req.Address).
 		Msg("Creating venue")
 
-	id, err := s.repo.CreateVenue(ctx, req.Name, req.Timezone, req.Address)
+	if req.BufferMinutes < 0 {
+		return nil, fmt.Errorf("buffer_minutes must not be negative")
+	}
+
+	id, err := s.repo.CreateVenue(ctx, req.Name, req.Timezone, req.Address, req.BufferMinutes)
 	if err != nil {
 		log.Error().Err(err).
 			Str("name", req.Name).
@@ -75,7 +84,7 @@ func (s *Service) GetVenue(ctx context.Context, req *venuepb.GetVenueRequest) (*
 }
 
 func (s *Service) ListVenues(ctx context.Context, req *venuepb.ListVenuesRequest) (*venuepb.ListVenuesResponse, error) {
-	venues, total, err := s.repo.ListVenues(ctx, req.Limit, req.Offset)
+	venues, total, err := s.repo.ListVenues(ctx, req.Limit, req.Offset, req.IncludeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +101,11 @@ func (s *Service) ListVenues(ctx context.Context, req *venuepb.ListVenuesRequest
 }
 
 func (s *Service) UpdateVenue(ctx context.Context, req *venuepb.UpdateVenueRequest) (*venuepb.Venue, error) {
-	err := s.repo.UpdateVenue(ctx, req.Id, req.Name, req.Address)
+	if req.BufferMinutes < 0 {
+		return nil, fmt.Errorf("buffer_minutes must not be negative")
+	}
+
+	err := s.repo.UpdateVenue(ctx, req.Id, req.Name, req.Address, req.BufferMinutes)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +121,26 @@ func (s *Service) UpdateVenue(ctx context.Context, req *venuepb.UpdateVenueReque
 func (s *Service) DeleteVenue(ctx context.Context, req *venuepb.DeleteVenueRequest) (*venuepb.DeleteVenueResponse, error) {
 	log.Info().Str("venue_id", req.Id).Msg("Deleting venue")
 
-	err := s.repo.DeleteVenue(ctx, req.Id)
+	layout, err := s.repo.GetVenueLayout(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tableIDs := layoutTableIDs(layout)
+
+	// The whole venue goes away, so there is nowhere to move bookings to
+	hires := &bookingpb.ListRoomHiresRequest{VenueId: req.Id}
+	result, err := s.runDeletionSaga(ctx, req.Id, tableIDs, hires, req.Force, nil, req.AdminId, "venue deleted")
+	if err != nil {
+		return nil, err
+	}
+	if len(result.blocking) > 0 || len(result.blockingHires) > 0 {
+		return &venuepb.DeleteVenueResponse{
+			BlockingBookingIds:  result.blocking,
+			BlockingRoomHireIds: result.blockingHires,
+		}, nil
+	}
+
+	err = s.repo.DeleteVenue(ctx, req.Id)
 	if err != nil {
 		log.Error().Err(err).
 			Str("venue_id", req.Id).
@@ -117,7 +149,33 @@ func (s *Service) DeleteVenue(ctx context.Context, req *venuepb.DeleteVenueReque
 	}
 
 	log.Info().Str("venue_id", req.Id).Msg("Venue deleted successfully")
-	return &venuepb.DeleteVenueResponse{Success: true}, nil
+	return &venuepb.DeleteVenueResponse{
+		Success:              true,
+		CancelledBookingIds:  result.cancelled,
+		CancelledRoomHireIds: result.cancelledHires,
+	}, nil
+}
+
+func (s *Service) RestoreVenue(ctx context.Context, req *venuepb.RestoreVenueRequest) (*venuepb.Venue, error) {
+	if err := s.repo.RestoreVenue(ctx, req.Id); err != nil {
+		return nil, restoreError(err)
+	}
+
+	layout, err := s.repo.GetVenueLayout(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if ids := layoutTableIDs(layout); len(ids) > 0 {
+		s.publishLayoutUpdated(ctx, req.Id, "", ids)
+	}
+
+	venue, err := s.repo.GetVenue(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().Str("venue_id", req.Id).Msg("Venue restored")
+	return toVenueProto(venue), nil
 }
 
 func (s *Service) CreateRoom(ctx context.Context, req *venuepb.CreateRoomRequest) (*venuepb.Room, error) {
@@ -143,7 +201,7 @@ func (s *Service) GetRoom(ctx context.Context, req *venuepb.GetRoomRequest) (*ve
 }
 
 func (s *Service) ListRooms(ctx context.Context, req *venuepb.ListRoomsRequest) (*venuepb.ListRoomsResponse, error) {
-	rooms, total, err := s.repo.ListRooms(ctx, req.VenueId, req.Limit, req.Offset)
+	rooms, total, err := s.repo.ListRooms(ctx, req.VenueId, req.Limit, req.Offset, req.IncludeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -174,18 +232,70 @@ func (s *Service) UpdateRoom(ctx context.Context, req *venuepb.UpdateRoomRequest
 }
 
 func (s *Service) DeleteRoom(ctx context.Context, req *venuepb.DeleteRoomRequest) (*venuepb.DeleteRoomResponse, error) {
-	err := s.repo.DeleteRoom(ctx, req.Id)
+	ctx, span := tracing.StartSpan(ctx, "DeleteRoom")
+	defer span.End()
+
+	layout, err := s.repo.GetRoomLayout(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	tableIDs := layoutTableIDs(layout)
+
+	hires := &bookingpb.ListRoomHiresRequest{VenueId: layout.Room.VenueID, RoomId: req.Id}
+	result, err := s.runDeletionSaga(ctx, layout.Room.VenueID, tableIDs, hires, req.Force, req.Reassignments, req.AdminId, "room deleted")
+	if err != nil {
+		return nil, err
+	}
+	if len(result.blocking) > 0 || len(result.blockingHires) > 0 {
+		return &venuepb.DeleteRoomResponse{
+			BlockingBookingIds:  result.blocking,
+			BlockingRoomHireIds: result.blockingHires,
+		}, nil
+	}
+
+	err = s.repo.DeleteRoom(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
-	return &venuepb.DeleteRoomResponse{Success: true}, nil
+
+	if len(tableIDs) > 0 {
+		s.publishLayoutUpdated(ctx, layout.Room.VenueID, req.Id, tableIDs)
+	}
+
+	return &venuepb.DeleteRoomResponse{
+		Success:              true,
+		CancelledBookingIds:  result.cancelled,
+		MovedBookingIds:      result.moved,
+		CancelledRoomHireIds: result.cancelledHires,
+	}, nil
+}
+
+func (s *Service) RestoreRoom(ctx context.Context, req *venuepb.RestoreRoomRequest) (*venuepb.Room, error) {
+	if err := s.repo.RestoreRoom(ctx, req.Id); err != nil {
+		return nil, restoreError(err)
+	}
+
+	layout, err := s.repo.GetRoomLayout(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if ids := layoutTableIDs(layout); len(ids) > 0 {
+		s.publishLayoutUpdated(ctx, layout.Room.VenueID, req.Id, ids)
+	}
+
+	log.Info().Str("room_id", req.Id).Msg("Room restored")
+	return toRoomProto(layout.Room), nil
 }
 
 func (s *Service) CreateTable(ctx context.Context, req *venuepb.CreateTableRequest) (*venuepb.Table, error) {
 	ctx, span := tracing.StartSpan(ctx, "CreateTable")
 	defer span.End()
 
-	id, err := s.repo.CreateTable(ctx, req.RoomId, req.Name, req.Capacity, req.CanMerge, req.Zone)
+	if req.BufferMinutes < 0 {
+		return nil, fmt.Errorf("buffer_minutes must not be negative")
+	}
+
+	id, err := s.repo.CreateTable(ctx, req.RoomId, req.Name, req.Capacity, req.CanMerge, req.Zone, req.BufferMinutes)
 	if err != nil {
 		return nil, err
 	}
@@ -196,20 +306,17 @@ func (s *Service) CreateTable(ctx context.Context, req *venuepb.CreateTableReque
 	}
 
 	// Publish event
-	room, _ := s.repo.GetRoom(ctx, req.RoomId)
-	if room != nil {
-		event := &commonpb.VenueEvent{
-			VenueId: room.VenueID,
-			Payload: &commonpb.VenueEvent_LayoutUpdated{
-				LayoutUpdated: &commonpb.TableLayoutUpdated{
-					RoomId:   req.RoomId,
-					TableIds: []string{id},
-				},
+	event := &commonpb.VenueEvent{
+		VenueId: table.VenueID,
+		Payload: &commonpb.VenueEvent_LayoutUpdated{
+			LayoutUpdated: &commonpb.TableLayoutUpdated{
+				RoomId:   req.RoomId,
+				TableIds: []string{id},
 			},
-		}
-		if err := s.producer.PublishVenueEvent(ctx, "table.layout.updated", event); err != nil {
-			log.Error().Err(err).Msg("Failed to publish layout updated event")
-		}
+		},
+	}
+	if err := s.producer.PublishVenueEvent(ctx, "table.layout.updated", event); err != nil {
+		log.Error().Err(err).Msg("Failed to publish layout updated event")
 	}
 
 	return toTableProto(table), nil
@@ -224,7 +331,7 @@ func (s *Service) GetTable(ctx context.Context, req *venuepb.GetTableRequest) (*
 }
 
 func (s *Service) ListTables(ctx context.Context, req *venuepb.ListTablesRequest) (*venuepb.ListTablesResponse, error) {
-	tables, total, err := s.repo.ListTables(ctx, req.RoomId, req.VenueId, req.Limit, req.Offset)
+	tables, total, err := s.repo.ListTables(ctx, req.RoomId, req.VenueId, req.Limit, req.Offset, req.IncludeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -244,7 +351,11 @@ func (s *Service) UpdateTable(ctx context.Context, req *venuepb.UpdateTableReque
 	ctx, span := tracing.StartSpan(ctx, "UpdateTable")
 	defer span.End()
 
-	err := s.repo.UpdateTable(ctx, req.Id, req.Name, req.Capacity, req.Zone)
+	if req.BufferMinutes < 0 {
+		return nil, fmt.Errorf("buffer_minutes must not be negative")
+	}
+
+	err := s.repo.UpdateTable(ctx, req.Id, req.Name, req.Capacity, req.Zone, req.BufferMinutes)
 	if err != nil {
 		return nil, err
 	}
@@ -255,20 +366,17 @@ func (s *Service) UpdateTable(ctx context.Context, req *venuepb.UpdateTableReque
 	}
 
 	// Publish event
-	room, _ := s.repo.GetRoom(ctx, table.RoomID)
-	if room != nil {
-		event := &commonpb.VenueEvent{
-			VenueId: room.VenueID,
-			Payload: &commonpb.VenueEvent_LayoutUpdated{
-				LayoutUpdated: &commonpb.TableLayoutUpdated{
-					RoomId:   table.RoomID,
-					TableIds: []string{req.Id},
-				},
+	event := &commonpb.VenueEvent{
+		VenueId: table.VenueID,
+		Payload: &commonpb.VenueEvent_LayoutUpdated{
+			LayoutUpdated: &commonpb.TableLayoutUpdated{
+				RoomId:   table.RoomID,
+				TableIds: []string{req.Id},
 			},
-		}
-		if err := s.producer.PublishVenueEvent(ctx, "table.layout.updated", event); err != nil {
-			log.Error().Err(err).Msg("Failed to publish layout updated event")
-		}
+		},
+	}
+	if err := s.producer.PublishVenueEvent(ctx, "table.layout.updated", event); err != nil {
+		log.Error().Err(err).Msg("Failed to publish layout updated event")
 	}
 
 	return toTableProto(table), nil
@@ -278,61 +386,239 @@ func (s *Service) DeleteTable(ctx context.Context, req *venuepb.DeleteTableReque
 	ctx, span := tracing.StartSpan(ctx, "DeleteTable")
 	defer span.End()
 
-	table, _ := s.repo.GetTable(ctx, req.Id)
+	table, err := s.repo.GetTable(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
 
-	err := s.repo.DeleteTable(ctx, req.Id)
+	// A hired room keeps its hire when one of its tables goes
+	result, err := s.runDeletionSaga(ctx, table.VenueID, []string{req.Id}, nil, req.Force, req.Reassignments, req.AdminId, "table deleted")
 	if err != nil {
 		return nil, err
 	}
+	if len(result.blocking) > 0 {
+		return &venuepb.DeleteTableResponse{BlockingBookingIds: result.blocking}, nil
+	}
 
-	// Publish event
-	if table != nil {
-		room, _ := s.repo.GetRoom(ctx, table.RoomID)
-		if room != nil {
-			event := &commonpb.VenueEvent{
-				VenueId: room.VenueID,
-				Payload: &commonpb.VenueEvent_LayoutUpdated{
-					LayoutUpdated: &commonpb.TableLayoutUpdated{
-						RoomId:   table.RoomID,
-						TableIds: []string{req.Id},
-					},
-				},
-			}
-			if err := s.producer.PublishVenueEvent(ctx, "table.layout.updated", event); err != nil {
-				log.Error().Err(err).Msg("Failed to publish layout updated event")
-			}
-		}
+	err = s.repo.DeleteTable(ctx, req.Id)
+	if err != nil {
+		return nil, err
 	}
 
-	return &venuepb.DeleteTableResponse{Success: true}, nil
+	s.publishLayoutUpdated(ctx, table.VenueID, table.RoomID, []string{req.Id})
+
+	return &venuepb.DeleteTableResponse{
+		Success:             true,
+		CancelledBookingIds: result.cancelled,
+		MovedBookingIds:     result.moved,
+	}, nil
+}
+
+func (s *Service) RestoreTable(ctx context.Context, req *venuepb.RestoreTableRequest) (*venuepb.Table, error) {
+	if err := s.repo.RestoreTable(ctx, req.Id); err != nil {
+		return nil, restoreError(err)
+	}
+
+	table, err := s.repo.GetTable(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	s.publishLayoutUpdated(ctx, table.VenueID, table.RoomID, []string{req.Id})
+
+	log.Info().Str("table_id", req.Id).Msg("Table restored")
+	return toTableProto(table), nil
+}
+
+// restoreError reports restoring something that is not deleted, or whose parent is, as a failed precondition
+func restoreError(err error) error {
+	if errors.Is(err, repository.ErrNotDeleted) || errors.Is(err, repository.ErrParentDeleted) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
+func layoutTableIDs(layout *repository.Layout) []string {
+	ids := make([]string, len(layout.Tables))
+	for i, t := range layout.Tables {
+		ids[i] = t.ID
+	}
+	return ids
 }
 
 func (s *Service) SetOpeningHours(ctx context.Context, req *venuepb.SetOpeningHoursRequest) (*venuepb.SetOpeningHoursResponse, error) {
-	// TODO: Implement opening hours storage
+	ctx, span := tracing.StartSpan(ctx, "SetOpeningHours")
+	defer span.End()
+
+	days := make([]*repository.DayHours, len(req.Days))
+	seen := make(map[int32]bool, len(req.Days))
+	for i, d := range req.Days {
+		if d.Weekday < 0 || d.Weekday > 6 {
+			return nil, fmt.Errorf("weekday %d is out of range 0-6", d.Weekday)
+		}
+		if seen[d.Weekday] {
+			return nil, fmt.Errorf("weekday %d is listed more than once", d.Weekday)
+		}
+		seen[d.Weekday] = true
+		if err := validateHours(d.OpenTime, d.CloseTime); err != nil {
+			return nil, fmt.Errorf("weekday %d: %w", d.Weekday, err)
+		}
+		days[i] = &repository.DayHours{Weekday: d.Weekday, OpenTime: d.OpenTime, CloseTime: d.CloseTime}
+	}
+
+	if err := s.repo.ReplaceOpeningHours(ctx, req.VenueId, days); err != nil {
+		return nil, err
+	}
+
+	// An empty date means the whole weekly schedule changed
+	s.publishScheduleUpdated(ctx, req.VenueId, "")
+
 	return &venuepb.SetOpeningHoursResponse{Success: true}, nil
 }
 
 func (s *Service) GetOpeningHours(ctx context.Context, req *venuepb.GetOpeningHoursRequest) (*venuepb.OpeningHours, error) {
-	// TODO: Implement opening hours retrieval
-	return &venuepb.OpeningHours{VenueId: req.VenueId}, nil
+	days, err := s.repo.ListOpeningHours(ctx, req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+
+	protoDays := make([]*venuepb.DayHours, len(days))
+	for i, d := range days {
+		protoDays[i] = &venuepb.DayHours{Weekday: d.Weekday, OpenTime: d.OpenTime, CloseTime: d.CloseTime}
+	}
+
+	return &venuepb.OpeningHours{VenueId: req.VenueId, Days: protoDays}, nil
 }
 
 func (s *Service) SetSpecialHours(ctx context.Context, req *venuepb.SetSpecialHoursRequest) (*venuepb.SetSpecialHoursResponse, error) {
-	// TODO: Implement special hours storage
-	// Publish event
+	ctx, span := tracing.StartSpan(ctx, "SetSpecialHours")
+	defer span.End()
+
+	if _, err := time.Parse("2006-01-02", req.Date); err != nil {
+		return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", req.Date)
+	}
+	if !req.IsClosed {
+		if err := validateHours(req.OpenTime, req.CloseTime); err != nil {
+			return nil, err
+		}
+	}
+
+	err := s.repo.UpsertSpecialHours(ctx, &repository.SpecialHours{
+		VenueID:   req.VenueId,
+		Date:      req.Date,
+		OpenTime:  req.OpenTime,
+		CloseTime: req.CloseTime,
+		IsClosed:  req.IsClosed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishScheduleUpdated(ctx, req.VenueId, req.Date)
+
+	return &venuepb.SetSpecialHoursResponse{Success: true}, nil
+}
+
+// GetDaySchedule returns the opening hours of a venue on a date, special hours included
+func (s *Service) GetDaySchedule(ctx context.Context, req *venuepb.GetDayScheduleRequest) (*venuepb.DaySchedule, error) {
+	open, close, closed, err := s.daySchedule(ctx, req.VenueId, req.Date)
+	if err != nil {
+		return nil, err
+	}
+	if closed {
+		return &venuepb.DaySchedule{Date: req.Date, Closed: true}, nil
+	}
+	return &venuepb.DaySchedule{
+		Date:      req.Date,
+		OpenTime:  minutesClock(open),
+		CloseTime: minutesClock(close),
+	}, nil
+}
+
+func (s *Service) publishLayoutUpdated(ctx context.Context, venueID, roomID string, tableIDs []string) {
 	event := &commonpb.VenueEvent{
-		VenueId: req.VenueId,
+		VenueId: venueID,
+		Payload: &commonpb.VenueEvent_LayoutUpdated{
+			LayoutUpdated: &commonpb.TableLayoutUpdated{
+				RoomId:   roomID,
+				TableIds: tableIDs,
+			},
+		},
+	}
+	if err := s.producer.PublishVenueEvent(ctx, "table.layout.updated", event); err != nil {
+		log.Error().Err(err).Msg("Failed to publish layout updated event")
+	}
+}
+
+func (s *Service) publishScheduleUpdated(ctx context.Context, venueID, date string) {
+	event := &commonpb.VenueEvent{
+		VenueId: venueID,
 		Payload: &commonpb.VenueEvent_ScheduleUpdated{
 			ScheduleUpdated: &commonpb.VenueScheduleUpdated{
-				Date: req.Date,
+				Date: date,
 			},
 		},
 	}
 	if err := s.producer.PublishVenueEvent(ctx, "venue.schedule.updated", event); err != nil {
 		log.Error().Err(err).Msg("Failed to publish schedule updated event")
 	}
+}
+
+// daySchedule returns opening hours of a venue on a date in minutes after midnight.
+// Special hours override the weekly schedule; a venue without any schedule is open all day.
+// Closing at or before the opening time means the venue works until midnight
+func (s *Service) daySchedule(ctx context.Context, venueID, date string) (open, close int, closed bool, err error) {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("invalid date %q: %w", date, err)
+	}
 
-	return &venuepb.SetSpecialHoursResponse{Success: true}, nil
+	special, err := s.repo.GetCachedSpecialHours(ctx, venueID, date)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	if special != nil {
+		if special.IsClosed {
+			return 0, 0, true, nil
+		}
+		open, close = scheduleBounds(special.OpenTime, special.CloseTime)
+		return open, close, false, nil
+	}
+
+	days, err := s.repo.GetCachedOpeningHours(ctx, venueID)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	if len(days) == 0 {
+		return 0, 24 * 60, false, nil
+	}
+	for _, d := range days {
+		if d.Weekday == int32(day.Weekday()) {
+			open, close = scheduleBounds(d.OpenTime, d.CloseTime)
+			return open, close, false, nil
+		}
+	}
+	return 0, 0, true, nil
+}
+
+func scheduleBounds(openTime, closeTime string) (int, int) {
+	open, _ := clockMinutes(openTime)
+	close, _ := clockMinutes(closeTime)
+	if close <= open {
+		close = 24 * 60
+	}
+	return open, close
+}
+
+func validateHours(openTime, closeTime string) error {
+	for _, t := range []string{openTime, closeTime} {
+		if _, err := clockMinutes(t); err != nil {
+			return err
+		}
+	}
+	if openTime == closeTime {
+		return fmt.Errorf("open_time and close_time are equal")
+	}
+	return nil
 }
 
 func (s *Service) CheckAvailability(ctx context.Context, req *venuepb.CheckAvailabilityRequest) (*venuepb.CheckAvailabilityResponse, error) {
@@ -340,10 +626,11 @@ func (s *Service) CheckAvailability(ctx context.Context, req *venuepb.CheckAvail
 	defer span.End()
 
 	// Get all tables in the venue
-	allTables, _, err := s.repo.ListTables(ctx, "", req.VenueId, 1000, 0)
+	layout, err := s.repo.GetVenueLayout(ctx, req.VenueId)
 	if err != nil {
 		return nil, err
 	}
+	allTables := layout.Tables
 
 	if len(allTables) == 0 {
 		return &venuepb.CheckAvailabilityResponse{
@@ -359,11 +646,48 @@ func (s *Service) CheckAvailability(ctx context.Context, req *venuepb.CheckAvail
 		tableMap[table.ID] = table
 	}
 
+	// Tables are busy for the venue's turn time unless the caller asked for a duration
+	slot := req.Slot
+	if slot.GetDurationMinutes() == 0 {
+		duration, err := s.resolveTurnTime(ctx, req.VenueId, slot.GetDate(), slot.GetStartTime(), req.PartySize)
+		if err != nil {
+			return nil, err
+		}
+		slot = &commonpb.Slot{Date: slot.GetDate(), StartTime: slot.GetStartTime(), DurationMinutes: duration}
+	}
+
+	// Pacing rules close a start time even if tables are free
+	day, err := time.Parse("2006-01-02", slot.GetDate())
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q: %w", slot.GetDate(), err)
+	}
+	start, err := clockMinutes(slot.GetStartTime())
+	if err != nil {
+		return nil, err
+	}
+	rejection, pacing, err := s.pacingRejection(ctx, req.VenueId, slot.GetDate(), int32(day.Weekday()), start, req.PartySize)
+	if err != nil {
+		return nil, err
+	}
+	if rejection != nil {
+		return &venuepb.CheckAvailabilityResponse{
+			Tables:          []*venuepb.TableAvailability{},
+			PacingRejection: rejection,
+		}, nil
+	}
+
+	venue, err := s.repo.GetVenue(ctx, req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+	buffers := tableBuffers(allTables, venue.BufferMinutes)
+
 	// Check availability via booking service
 	availabilityResp, err := s.bookingClient.CheckTableAvailability(ctx, &bookingpb.CheckTableAvailabilityRequest{
-		VenueId:  req.VenueId,
-		TableIds: tableIDs,
-		Slot:     req.Slot,
+		VenueId:       req.VenueId,
+		TableIds:      tableIDs,
+		Slot:          slot,
+		BufferMinutes: buffers,
 	})
 	if err != nil {
 		log.Warn().Err(err).Msg("Failed to check table availability, assuming all available")
@@ -376,164 +700,263 @@ func (s *Service) CheckAvailability(ctx context.Context, req *venuepb.CheckAvail
 		availabilityMap[info.TableId] = info.Available
 	}
 
-	// Find tables that can accommodate party size
+	// A blocked table is taken even without bookings; the new booking needs its buffer before the block
+	blocks, err := s.dayBlocks(ctx, req.VenueId, "", slot.GetDate())
+	if err != nil {
+		return nil, err
+	}
+	end := start + int(slot.GetDurationMinutes())
+	for tableID, intervals := range addBlockIntervals(nil, blocks) {
+		if overlapsAny(intervals, start, end+int(buffers[tableID])) {
+			availabilityMap[tableID] = false
+		}
+	}
+
+	combinations, err := s.repo.ListTableCombinations(ctx, "", req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+	result := availabilityOptions(req.VenueId, req.PartySize, allTables, combinations, availabilityMap)
+
+	return &venuepb.CheckAvailabilityResponse{
+		Tables:              result,
+		SuggestedAssignment: suggestAssignments(req.PartySize, req.PreferredZone, result, allTables, availabilityMap),
+		TableBufferMinutes:  buffers,
+		PacingLimits:        pacing,
+	}, nil
+}
+
+// availabilityOptions lists free tables that can seat the party. Combinations are only
+// offered when no single table is big enough
+func availabilityOptions(venueID string, partySize int32, tables []*repository.Table, combinations []*repository.TableCombination, available map[string]bool) []*venuepb.TableAvailability {
 	result := make([]*venuepb.TableAvailability, 0)
 
 	// First, find single tables that can accommodate
-	for _, table := range allTables {
-		available := availabilityMap[table.ID]
-		if !available {
+	for _, table := range tables {
+		if !available[table.ID] || table.Capacity < partySize {
 			continue
 		}
-
-		if table.Capacity >= req.PartySize {
-			// Get room info for table ref
-			room, err := s.repo.GetRoom(ctx, table.RoomID)
-			if err != nil {
-				log.Warn().Err(err).Str("room_id", table.RoomID).Msg("Failed to get room")
-				continue
-			}
-
-			result = append(result, &venuepb.TableAvailability{
-				Table: &commonpb.TableRef{
-					VenueId: req.VenueId,
-					RoomId:  room.ID,
-					TableId: table.ID,
-				},
-				Available: true,
-				Reason:    "",
-			})
-		}
+		result = append(result, &venuepb.TableAvailability{
+			Table: &commonpb.TableRef{
+				VenueId: venueID,
+				RoomId:  table.RoomID,
+				TableId: table.ID,
+			},
+			Available: true,
+			Capacity:  table.Capacity,
+		})
 	}
 
-	// If no single table can accommodate, look for mergeable tables
+	// If no single table can accommodate, look for table combinations
 	if len(result) == 0 {
-		// Find pairs of mergeable tables that together can accommodate
-		// We need to add both directions (table1+table2 and table2+table1)
-		// so that regardless of which table is selected, we can find the merge option
-		for i, table1 := range allTables {
-			if !table1.CanMerge || !availabilityMap[table1.ID] {
-				continue
-			}
-
-			for j, table2 := range allTables {
-				// Skip same table and ensure we only check each pair once
-				if i >= j || !table2.CanMerge || !availabilityMap[table2.ID] {
-					continue
-				}
-
-				// Check if they're in the same room (can be merged)
-				if table1.RoomID != table2.RoomID {
-					continue
-				}
-
-				totalCapacity := table1.Capacity + table2.Capacity
-				if totalCapacity >= req.PartySize {
-					room, err := s.repo.GetRoom(ctx, table1.RoomID)
-					if err != nil {
-						log.Warn().Err(err).Str("room_id", table1.RoomID).Msg("Failed to get room")
-						continue
-					}
-
-					// Add both directions: (table1, table2) and (table2, table1)
-					// This ensures that regardless of which table is selected,
-					// we can find the merge option
-
-					// Option 1: table1 as primary, table2 as merged
-					result = append(result, &venuepb.TableAvailability{
-						Table: &commonpb.TableRef{
-							VenueId: req.VenueId,
-							RoomId:  room.ID,
-							TableId: table1.ID,
-						},
-						Available: true,
-						Reason:    fmt.Sprintf("Can be merged with table %s (total capacity: %d)", table2.Name, totalCapacity),
-						MergedWithTable: &commonpb.TableRef{
-							VenueId: req.VenueId,
-							RoomId:  room.ID,
-							TableId: table2.ID,
-						},
-					})
-
-					// Option 2: table2 as primary, table1 as merged
-					result = append(result, &venuepb.TableAvailability{
-						Table: &commonpb.TableRef{
-							VenueId: req.VenueId,
-							RoomId:  room.ID,
-							TableId: table2.ID,
-						},
-						Available: true,
-						Reason:    fmt.Sprintf("Can be merged with table %s (total capacity: %d)", table1.Name, totalCapacity),
-						MergedWithTable: &commonpb.TableRef{
-							VenueId: req.VenueId,
-							RoomId:  room.ID,
-							TableId: table1.ID,
-						},
-					})
-
-					log.Info().
-						Str("table1_id", table1.ID).
-						Str("table2_id", table2.ID).
-						Msg("Adding merge suggestions for both tables")
-				}
-			}
+		for _, option := range findCombinations(partySize, tables, combinations, available) {
+			result = append(result, option.toAvailability(venueID))
 		}
 	}
 
-	return &venuepb.CheckAvailabilityResponse{
-		Tables: result,
-	}, nil
+	return result
 }
 
 func (s *Service) GetTableLayout(ctx context.Context, req *venuepb.GetTableLayoutRequest) (*venuepb.GetTableLayoutResponse, error) {
-	// TODO: Implement with Redis cache
-	tables, _, err := s.repo.ListTables(ctx, req.RoomId, req.VenueId, 100, 0)
+	var layout *repository.Layout
+	var err error
+	if req.RoomId != "" {
+		layout, err = s.repo.GetRoomLayout(ctx, req.RoomId)
+	} else {
+		layout, err = s.repo.GetVenueLayout(ctx, req.VenueId)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	protoTables := make([]*venuepb.Table, len(tables))
-	for i, t := range tables {
+	protoTables := make([]*venuepb.Table, len(layout.Tables))
+	for i, t := range layout.Tables {
 		protoTables[i] = toTableProto(t)
 	}
 
-	return &venuepb.GetTableLayoutResponse{
+	resp := &venuepb.GetTableLayoutResponse{
 		RoomId: req.RoomId,
 		Tables: protoTables,
-	}, nil
+	}
+	venueID := req.VenueId
+	if layout.Room != nil {
+		resp.Room = toRoomProto(layout.Room)
+		venueID = layout.Room.VenueID
+	}
+
+	if req.Date != "" {
+		resp.Timeline, err = s.tableTimeline(ctx, venueID, req.Date, layout.Tables)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *Service) SaveRoomLayout(ctx context.Context, req *venuepb.SaveRoomLayoutRequest) (*venuepb.GetTableLayoutResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "SaveRoomLayout")
+	defer span.End()
+
+	layout, err := toRoomLayout(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	version, err := s.repo.SaveRoomLayout(ctx, layout)
+	if errors.Is(err, repository.ErrLayoutVersionConflict) {
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+	if errors.Is(err, repository.ErrTableNotInRoom) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := s.repo.GetRoom(ctx, req.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	tableIDs := make([]string, len(layout.Tables))
+	for i, t := range layout.Tables {
+		tableIDs[i] = t.TableID
+	}
+	event := &commonpb.VenueEvent{
+		VenueId: room.VenueID,
+		Payload: &commonpb.VenueEvent_LayoutUpdated{
+			LayoutUpdated: &commonpb.TableLayoutUpdated{
+				RoomId:        req.RoomId,
+				TableIds:      tableIDs,
+				LayoutVersion: version,
+			},
+		},
+	}
+	if err := s.producer.PublishVenueEvent(ctx, "table.layout.updated", event); err != nil {
+		log.Error().Err(err).Msg("Failed to publish layout updated event")
+	}
+
+	return s.GetTableLayout(ctx, &venuepb.GetTableLayoutRequest{VenueId: room.VenueID, RoomId: req.RoomId})
+}
+
+func toRoomLayout(req *venuepb.SaveRoomLayoutRequest) (*repository.RoomLayout, error) {
+	if req.RoomId == "" {
+		return nil, fmt.Errorf("room_id is required")
+	}
+	if req.Width < 0 || req.Height < 0 {
+		return nil, fmt.Errorf("room dimensions must not be negative")
+	}
+
+	layout := &repository.RoomLayout{
+		RoomID:        req.RoomId,
+		LayoutVersion: req.LayoutVersion,
+		Width:         req.Width,
+		Height:        req.Height,
+		BackgroundURL: req.BackgroundUrl,
+		Tables:        make([]*repository.TablePlacement, 0, len(req.Tables)),
+	}
+
+	seen := make(map[string]bool, len(req.Tables))
+	for _, t := range req.Tables {
+		if seen[t.TableId] {
+			return nil, fmt.Errorf("table %s is placed more than once", t.TableId)
+		}
+		seen[t.TableId] = true
+
+		shape := t.Shape
+		if shape == "" {
+			shape = "rect"
+		}
+		if shape != "rect" && shape != "round" {
+			return nil, fmt.Errorf("table %s: unknown shape %q", t.TableId, t.Shape)
+		}
+		if t.Width <= 0 || t.Height <= 0 {
+			return nil, fmt.Errorf("table %s: width and height must be positive", t.TableId)
+		}
+		if t.X < 0 || t.Y < 0 {
+			return nil, fmt.Errorf("table %s: position must not be negative", t.TableId)
+		}
+		if (req.Width > 0 && t.X > req.Width) || (req.Height > 0 && t.Y > req.Height) {
+			return nil, fmt.Errorf("table %s is outside of the room", t.TableId)
+		}
+
+		layout.Tables = append(layout.Tables, &repository.TablePlacement{
+			TableID:  t.TableId,
+			X:        t.X,
+			Y:        t.Y,
+			Width:    t.Width,
+			Height:   t.Height,
+			Shape:    shape,
+			Rotation: normalizeRotation(t.Rotation),
+		})
+	}
+
+	return layout, nil
+}
+
+// normalizeRotation brings an angle in degrees into [0, 360)
+func normalizeRotation(deg float64) float64 {
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg
 }
 
 // Converters
 func toVenueProto(v *repository.Venue) *venuepb.Venue {
 	return &venuepb.Venue{
-		Id:        v.ID,
-		Name:      v.Name,
-		Timezone:  v.Timezone,
-		Address:   v.Address,
-		CreatedAt: v.CreatedAt.Unix(),
-		UpdatedAt: v.UpdatedAt.Unix(),
+		Id:            v.ID,
+		Name:          v.Name,
+		Timezone:      v.Timezone,
+		Address:       v.Address,
+		CreatedAt:     v.CreatedAt.Unix(),
+		UpdatedAt:     v.UpdatedAt.Unix(),
+		BufferMinutes: v.BufferMinutes,
+		DeletedAt:     unixOrZero(v.DeletedAt),
 	}
 }
 
 func toRoomProto(r *repository.Room) *venuepb.Room {
 	return &venuepb.Room{
-		Id:        r.ID,
-		VenueId:   r.VenueID,
-		Name:      r.Name,
-		CreatedAt: r.CreatedAt.Unix(),
-		UpdatedAt: r.UpdatedAt.Unix(),
+		Id:            r.ID,
+		VenueId:       r.VenueID,
+		Name:          r.Name,
+		CreatedAt:     r.CreatedAt.Unix(),
+		UpdatedAt:     r.UpdatedAt.Unix(),
+		Width:         r.Width,
+		Height:        r.Height,
+		BackgroundUrl: r.BackgroundURL,
+		LayoutVersion: r.LayoutVersion,
+		DeletedAt:     unixOrZero(r.DeletedAt),
 	}
 }
 
 func toTableProto(t *repository.Table) *venuepb.Table {
 	return &venuepb.Table{
-		Id:        t.ID,
-		RoomId:    t.RoomID,
-		Name:      t.Name,
-		Capacity:  t.Capacity,
-		CanMerge:  t.CanMerge,
-		Zone:      t.Zone,
-		CreatedAt: t.CreatedAt.Unix(),
-		UpdatedAt: t.UpdatedAt.Unix(),
+		Id:            t.ID,
+		RoomId:        t.RoomID,
+		Name:          t.Name,
+		Capacity:      t.Capacity,
+		CanMerge:      t.CanMerge,
+		Zone:          t.Zone,
+		CreatedAt:     t.CreatedAt.Unix(),
+		UpdatedAt:     t.UpdatedAt.Unix(),
+		X:             t.X,
+		Y:             t.Y,
+		Width:         t.Width,
+		Height:        t.Height,
+		Shape:         t.Shape,
+		Rotation:      t.Rotation,
+		BufferMinutes: t.BufferMinutes,
+		DeletedAt:     unixOrZero(t.DeletedAt),
+	}
+}
+
+func unixOrZero(t *time.Time) int64 {
+	if t == nil {
+		return 0
 	}
+	return t.Unix()
 }
@@ -0,0 +1,527 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"booker/cmd/venue-svc/repository"
+	bookingpb "booker/pkg/proto/booking"
+	commonpb "booker/pkg/proto/common"
+	venuepb "booker/pkg/proto/venue"
+)
+
+func TestToRoomLayout(t *testing.T) {
+	req := &venuepb.SaveRoomLayoutRequest{
+		RoomId:        "room-1",
+		LayoutVersion: 3,
+		Width:         800,
+		Height:        600,
+		Tables: []*venuepb.TablePlacement{
+			{TableId: "t1", X: 10, Y: 20, Width: 60, Height: 60},
+			{TableId: "t2", X: 100, Y: 20, Width: 80, Height: 80, Shape: "round", Rotation: 405},
+		},
+	}
+
+	layout, err := toRoomLayout(req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), layout.LayoutVersion)
+	require.Len(t, layout.Tables, 2)
+	assert.Equal(t, "rect", layout.Tables[0].Shape)
+	assert.Equal(t, "round", layout.Tables[1].Shape)
+	assert.Equal(t, 45.0, layout.Tables[1].Rotation)
+}
+
+func TestNormalizeRotation(t *testing.T) {
+	assert.Equal(t, 0.0, normalizeRotation(0))
+	assert.Equal(t, 45.0, normalizeRotation(405))
+	assert.Equal(t, 270.0, normalizeRotation(-90))
+	assert.Equal(t, 0.0, normalizeRotation(-360))
+	assert.Equal(t, 350.5, normalizeRotation(-9.5))
+}
+
+func TestToRoomLayout_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		tables []*venuepb.TablePlacement
+		errMsg string
+	}{
+		{"unknown shape", []*venuepb.TablePlacement{{TableId: "t1", Width: 1, Height: 1, Shape: "star"}}, "unknown shape"},
+		{"zero size", []*venuepb.TablePlacement{{TableId: "t1"}}, "must be positive"},
+		{"negative position", []*venuepb.TablePlacement{{TableId: "t1", X: -5, Width: 1, Height: 1}}, "must not be negative"},
+		{"outside room", []*venuepb.TablePlacement{{TableId: "t1", X: 900, Width: 1, Height: 1}}, "outside of the room"},
+		{"duplicate", []*venuepb.TablePlacement{
+			{TableId: "t1", Width: 1, Height: 1},
+			{TableId: "t1", Width: 1, Height: 1},
+		}, "more than once"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := toRoomLayout(&venuepb.SaveRoomLayoutRequest{RoomId: "room-1", Width: 800, Height: 600, Tables: tt.tables})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestFindCombinations(t *testing.T) {
+	tables := []*repository.Table{
+		{ID: "a1", RoomID: "hall", Name: "A1", Capacity: 4, CanMerge: true},
+		{ID: "a2", RoomID: "hall", Name: "A2", Capacity: 4, CanMerge: true},
+		{ID: "a3", RoomID: "hall", Name: "A3", Capacity: 4, CanMerge: true},
+		{ID: "b1", RoomID: "terrace", Name: "B1", Capacity: 6, CanMerge: true},
+		{ID: "b2", RoomID: "terrace", Name: "B2", Capacity: 6, CanMerge: true},
+		{ID: "b3", RoomID: "terrace", Name: "B3", Capacity: 2, CanMerge: false},
+	}
+	combinations := []*repository.TableCombination{
+		{ID: "c-long", RoomID: "hall", Name: "Long table", TableIDs: []string{"a1", "a2", "a3"}, MinCapacity: 9, MaxCapacity: 14},
+		{ID: "c-pair", RoomID: "hall", Name: "Window pair", TableIDs: []string{"a1", "a2"}},
+	}
+	available := map[string]bool{"a1": true, "a2": true, "a3": true, "b1": true, "b2": true, "b3": true}
+
+	options := findCombinations(12, tables, combinations, available)
+	require.Len(t, options, 2)
+	assert.Equal(t, "b1", options[0].tables[0].ID)
+	assert.Equal(t, int32(12), options[0].capacity)
+	assert.Equal(t, "c-long", options[1].combinationID)
+	assert.Len(t, options[1].tables, 3)
+
+	// Party of 7 fits the configured pair; hall tables are not paired ad hoc
+	options = findCombinations(7, tables, combinations, available)
+	require.Len(t, options, 2)
+	assert.Equal(t, "c-pair", options[0].combinationID)
+	assert.Equal(t, "", options[1].combinationID)
+
+	// A combination with a booked table is not offered
+	available["a3"] = false
+	options = findCombinations(12, tables, combinations, available)
+	require.Len(t, options, 1)
+	assert.Equal(t, "", options[0].combinationID)
+}
+
+func TestCombinationOption_ToAvailability(t *testing.T) {
+	option := &combinationOption{
+		combinationID: "c1",
+		name:          "Long table",
+		tables: []*repository.Table{
+			{ID: "a1", RoomID: "hall", Name: "A1"},
+			{ID: "a2", RoomID: "hall", Name: "A2"},
+			{ID: "a3", RoomID: "hall", Name: "A3"},
+		},
+		capacity: 12,
+	}
+
+	availability := option.toAvailability("venue-1")
+	assert.Equal(t, "a1", availability.Table.TableId)
+	assert.Equal(t, "a2", availability.MergedWithTable.TableId)
+	assert.Len(t, availability.CombinedTables, 3)
+	assert.Equal(t, int32(12), availability.Capacity)
+	assert.Contains(t, availability.Reason, "Can be merged with table A2, A3")
+}
+
+func TestSuggestAssignments(t *testing.T) {
+	tables := []*repository.Table{
+		{ID: "two", RoomID: "hall", Capacity: 2, Zone: "hall"},
+		{ID: "four", RoomID: "hall", Capacity: 4, Zone: "window"},
+		{ID: "eight", RoomID: "hall", Capacity: 8, Zone: "window"},
+	}
+	available := map[string]bool{"two": true, "four": true, "eight": true}
+	options := make([]*venuepb.TableAvailability, len(tables))
+	for i, table := range tables {
+		options[i] = &venuepb.TableAvailability{
+			Table:     &commonpb.TableRef{RoomId: table.RoomID, TableId: table.ID},
+			Available: true,
+			Capacity:  table.Capacity,
+		}
+	}
+
+	// Two guests go to the 2-top, the 8-top is kept for a large party
+	assignments := suggestAssignments(2, "", options, tables, available)
+	require.Len(t, assignments, 3)
+	assert.Equal(t, "two", assignments[0].Tables[0].TableId)
+	assert.Equal(t, 100.0, assignments[0].Score)
+	assert.Equal(t, "exact fit", assignments[0].Reason)
+	assert.Equal(t, "eight", assignments[2].Tables[0].TableId)
+
+	// Zone preference outweighs two empty seats
+	assignments = suggestAssignments(2, "window", options, tables, available)
+	assert.Equal(t, "four", assignments[0].Tables[0].TableId)
+}
+
+func TestApplyTurnTimeRules(t *testing.T) {
+	rules := []*repository.TurnTimeRule{
+		{MaxPartySize: 2, DurationMinutes: 90},
+		{MinPartySize: 6, DurationMinutes: 150},
+		{Weekdays: []int32{5}, PeriodStart: "17:00", PeriodEnd: "23:00", ExtraMinutes: 15},
+	}
+
+	tests := []struct {
+		name      string
+		partySize int32
+		weekday   int32
+		startTime string
+		expected  int32
+	}{
+		{"couple", 2, 1, "19:00", 90},
+		{"default", 4, 1, "19:00", 120},
+		{"large party", 8, 1, "19:00", 150},
+		{"friday dinner couple", 2, 5, "19:00", 105},
+		{"friday lunch", 2, 5, "13:00", 90},
+		{"friday large dinner", 6, 5, "22:59", 165},
+		{"period end is exclusive", 6, 5, "23:00", 150},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, applyTurnTimeRules(rules, 120, tt.partySize, tt.weekday, tt.startTime))
+		})
+	}
+}
+
+func TestApplyTurnTimeRules_MostSpecificWins(t *testing.T) {
+	rules := []*repository.TurnTimeRule{
+		{MinPartySize: 1, DurationMinutes: 100},
+		{MinPartySize: 1, MaxPartySize: 4, Weekdays: []int32{6}, DurationMinutes: 80},
+	}
+
+	assert.Equal(t, int32(80), applyTurnTimeRules(rules, 120, 3, 6, "12:00"))
+	assert.Equal(t, int32(100), applyTurnTimeRules(rules, 120, 3, 0, "12:00"))
+}
+
+func TestSearchSlots(t *testing.T) {
+	tables := []*repository.Table{
+		{ID: "a", RoomID: "hall", Capacity: 4},
+		{ID: "b", RoomID: "hall", Capacity: 2, CanMerge: true},
+		{ID: "c", RoomID: "hall", Capacity: 2, CanMerge: true},
+	}
+	busy := busyIntervals([]*bookingpb.Booking{
+		{Status: "confirmed", Table: &commonpb.TableRef{TableId: "a"}, Slot: &commonpb.Slot{StartTime: "19:00", DurationMinutes: 90}},
+		{Status: "held", Table: &commonpb.TableRef{TableId: "b"}, Slot: &commonpb.Slot{StartTime: "18:00", DurationMinutes: 60},
+			CombinedTables: []*commonpb.TableRef{{TableId: "c"}}},
+		{Status: "cancelled", Table: &commonpb.TableRef{TableId: "b"}, Slot: &commonpb.Slot{StartTime: "20:00", DurationMinutes: 60}},
+	}, 120)
+	assert.Len(t, busy["c"], 1)
+	assert.Len(t, busy["b"], 1)
+
+	search := &slotSearch{
+		partySize: 4,
+		from:      17 * 60,
+		to:        21 * 60,
+		close:     22 * 60,
+		interval:  30,
+		duration:  60,
+	}
+	slots := searchSlots(search, tables, nil, busy)
+
+	var starts []string
+	for _, slot := range slots {
+		starts = append(starts, slot.StartTime)
+	}
+	// At 18:30 the 4-top and both 2-tops are booked, from 19:00 the 2-tops are pushed together
+	assert.Equal(t, []string{"17:00", "17:30", "18:00", "19:00", "19:30", "20:00", "20:30", "21:00"}, starts)
+	assert.Equal(t, "a", slots[0].SuggestedAssignment[0].Tables[0].TableId)
+	assert.Len(t, slots[3].SuggestedAssignment[0].Tables, 2)
+
+	// A 90 minute visit starting at 21:00 would end after closing
+	search.duration = 90
+	slots = searchSlots(search, tables, nil, busy)
+	require.NotEmpty(t, slots)
+	assert.Equal(t, "20:30", slots[len(slots)-1].StartTime)
+}
+
+func TestCheckPacing(t *testing.T) {
+	rules := []*repository.PacingRule{
+		{IntervalMinutes: 15, Weekdays: []int32{5}, PeriodStart: "18:00", PeriodEnd: "22:00", MaxCovers: 10},
+		{IntervalMinutes: 30, MaxBookings: 3},
+	}
+	starts := bookingStarts([]*bookingpb.Booking{
+		{Status: "confirmed", PartySize: 6, Slot: &commonpb.Slot{StartTime: "19:00"}},
+		{Status: "seated", PartySize: 2, Slot: &commonpb.Slot{StartTime: "19:10"}},
+		{Status: "cancelled", PartySize: 8, Slot: &commonpb.Slot{StartTime: "19:05"}},
+		{Status: "held", PartySize: 2, Slot: &commonpb.Slot{StartTime: "19:20"}},
+	})
+
+	// Friday 19:05: 8 covers already start in 19:00-19:15, 4 more would exceed 10
+	rejection := checkPacing(rules, 5, 19*60+5, 4, starts)
+	require.NotNil(t, rejection)
+	assert.Equal(t, "19:00", rejection.IntervalStart)
+	assert.Equal(t, "19:15", rejection.IntervalEnd)
+	assert.Equal(t, int32(8), rejection.Covers)
+
+	// Two guests still fit the covers limit
+	assert.Nil(t, checkPacing(rules[:1], 5, 19*60+5, 2, starts))
+
+	// On Thursday only the bookings limit applies: 19:00-19:30 already has 3
+	rejection = checkPacing(rules, 4, 19*60+25, 2, starts)
+	require.NotNil(t, rejection)
+	assert.Equal(t, int32(3), rejection.Bookings)
+	assert.Nil(t, checkPacing(rules, 4, 19*60+30, 2, starts))
+}
+
+func TestPacingLimits(t *testing.T) {
+	rules := []*repository.PacingRule{
+		{IntervalMinutes: 15, Weekdays: []int32{5}, PeriodStart: "18:00", PeriodEnd: "22:00", MaxCovers: 10},
+		{IntervalMinutes: 30, MaxBookings: 3},
+	}
+
+	// Friday 23:40 is outside the first rule's period, the last interval ends at midnight
+	limits := pacingLimits(rules, 5, 23*60+40)
+	require.Len(t, limits, 1)
+	assert.Equal(t, &venuepb.PacingLimit{IntervalStart: "23:30", IntervalEnd: "24:00", MaxBookings: 3}, limits[0])
+
+	limits = pacingLimits(rules, 5, 19*60+5)
+	require.Len(t, limits, 2)
+	assert.Equal(t, "19:00", limits[0].IntervalStart)
+	assert.Equal(t, "19:15", limits[0].IntervalEnd)
+	assert.Equal(t, int32(10), limits[0].MaxCovers)
+	assert.Equal(t, "19:30", limits[1].IntervalEnd)
+
+	assert.Empty(t, pacingLimits(rules[:1], 4, 19*60+5))
+}
+
+func TestSearchSlots_Buffer(t *testing.T) {
+	tables := []*repository.Table{{ID: "a", RoomID: "hall", Capacity: 4, BufferMinutes: 15}}
+	buffers := tableBuffers(tables, 30)
+	assert.Equal(t, int32(15), buffers["a"])
+
+	busy := busyIntervals([]*bookingpb.Booking{
+		{Status: "confirmed", Table: &commonpb.TableRef{TableId: "a"}, Slot: &commonpb.Slot{StartTime: "19:00", DurationMinutes: 60}, BufferMinutes: 15},
+	}, 120)
+	search := &slotSearch{
+		partySize: 2,
+		from:      17 * 60,
+		to:        21 * 60,
+		close:     23 * 60,
+		interval:  15,
+		duration:  60,
+		buffers:   buffers,
+	}
+
+	var starts []string
+	for _, slot := range searchSlots(search, tables, nil, busy) {
+		starts = append(starts, slot.StartTime)
+	}
+	// 18:00 would leave no time to reset before 19:00, 20:00 falls into the buffer of the 19:00 booking
+	assert.Equal(t, []string{"17:00", "17:15", "17:30", "17:45", "20:15", "20:30", "20:45", "21:00"}, starts)
+}
+
+func TestBuildTimeline(t *testing.T) {
+	tables := []*repository.Table{{ID: "a"}, {ID: "b"}}
+	bookings := []*bookingpb.Booking{
+		{Id: "late", Status: "confirmed", Table: &commonpb.TableRef{TableId: "a"}, Slot: &commonpb.Slot{StartTime: "20:00", DurationMinutes: 90}, BufferMinutes: 15,
+			CombinedTables: []*commonpb.TableRef{{TableId: "b"}}},
+		{Id: "early", Status: "seated", Table: &commonpb.TableRef{TableId: "a"}, Slot: &commonpb.Slot{StartTime: "18:00"}},
+		{Id: "gone", Status: "cancelled", Table: &commonpb.TableRef{TableId: "b"}, Slot: &commonpb.Slot{StartTime: "12:00"}},
+	}
+
+	timeline := buildTimeline(tables, map[string]int32{"a": 15, "b": 15}, bookings, nil, nil, 120)
+	require.Len(t, timeline, 2)
+
+	require.Len(t, timeline[0].Blocks, 2)
+	assert.Equal(t, "early", timeline[0].Blocks[0].BookingId)
+	assert.Equal(t, "20:00", timeline[0].Blocks[0].EndTime)
+	assert.Equal(t, "20:00", timeline[0].Blocks[0].BufferEnd)
+	assert.Equal(t, "21:45", timeline[0].Blocks[1].BufferEnd)
+
+	require.Len(t, timeline[1].Blocks, 1)
+	assert.Equal(t, "late", timeline[1].Blocks[0].BookingId)
+	assert.Equal(t, int32(15), timeline[1].BufferMinutes)
+}
+
+func TestPlanDeletion(t *testing.T) {
+	tables := []*repository.Table{{ID: "a", Capacity: 4}, {ID: "b", Capacity: 2}, {ID: "c", Capacity: 2, BufferMinutes: 20}}
+	buffers := tableBuffers(tables, 10)
+	deleted := map[string]bool{"a": true}
+	bookings := []*bookingpb.Booking{
+		{Id: "move", PartySize: 4, Table: &commonpb.TableRef{TableId: "a"}},
+		{Id: "stay", PartySize: 2, Table: &commonpb.TableRef{TableId: "a"}},
+	}
+	reassignments := []*venuepb.BookingReassignment{{BookingId: "move", TableIds: []string{"b", "c"}}}
+
+	plan, err := planDeletion(bookings, deleted, tables, nil, buffers, false, reassignments)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"stay"}, plan.blocking)
+	require.Len(t, plan.moves, 1)
+	assert.Equal(t, []string{"b", "c"}, plan.moves[0].tableIDs)
+	assert.Equal(t, int32(20), plan.moves[0].buffer)
+
+	plan, err = planDeletion(bookings, deleted, tables, nil, buffers, true, reassignments)
+	require.NoError(t, err)
+	assert.Empty(t, plan.blocking)
+	require.Len(t, plan.cancels, 1)
+	assert.Equal(t, "stay", plan.cancels[0].Id)
+}
+
+func TestPlanDeletion_InvalidReassignment(t *testing.T) {
+	tables := []*repository.Table{{ID: "a", Capacity: 4}, {ID: "b", Capacity: 2}}
+	deleted := map[string]bool{"a": true}
+	bookings := []*bookingpb.Booking{{Id: "x", PartySize: 4, Table: &commonpb.TableRef{TableId: "a"}}}
+
+	cases := map[string][]*venuepb.BookingReassignment{
+		"too small":       {{BookingId: "x", TableIds: []string{"b"}}},
+		"deleted table":   {{BookingId: "x", TableIds: []string{"a"}}},
+		"unknown table":   {{BookingId: "x", TableIds: []string{"z"}}},
+		"no tables":       {{BookingId: "x"}},
+		"unknown booking": {{BookingId: "y", TableIds: []string{"b"}}},
+	}
+	for name, reassignments := range cases {
+		_, err := planDeletion(bookings, deleted, tables, nil, nil, true, reassignments)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestPlanDeletion_Combination(t *testing.T) {
+	tables := []*repository.Table{{ID: "a", Capacity: 6}, {ID: "b", Capacity: 4}, {ID: "c", Capacity: 4}, {ID: "d", Capacity: 2}}
+	deleted := map[string]bool{"a": true}
+	bookings := []*bookingpb.Booking{{Id: "x", PartySize: 8, Table: &commonpb.TableRef{TableId: "a"}}}
+	combinations := []*repository.TableCombination{
+		{ID: "bc", TableIDs: []string{"c", "b"}, MaxCapacity: 7},
+		{ID: "cd", TableIDs: []string{"c", "d"}, MaxCapacity: 8},
+	}
+
+	// b and c add up to 8 seats, but joined they seat 7
+	_, err := planDeletion(bookings, deleted, tables, combinations, nil, true,
+		[]*venuepb.BookingReassignment{{BookingId: "x", TableIds: []string{"b", "c"}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "tables seating 7")
+
+	// c and d only seat 6 apart, the combination seats 8
+	plan, err := planDeletion(bookings, deleted, tables, combinations, nil, true,
+		[]*venuepb.BookingReassignment{{BookingId: "x", TableIds: []string{"d", "c"}}})
+	require.NoError(t, err)
+	require.Len(t, plan.moves, 1)
+}
+
+func TestMergeHolidays(t *testing.T) {
+	merged, err := mergeHolidays([]*venuepb.Holiday{
+		{Date: "2026-05-09", Name: "Victory Day"},
+		{Date: "2026-01-01", Name: "New Year"},
+		{Date: "2026-05-09", Name: "Parade"},
+		{Date: "2026-05-09", Name: "Victory Day"},
+	})
+	require.NoError(t, err)
+	require.Len(t, merged, 2)
+	assert.Equal(t, "2026-01-01", merged[0].Date)
+	assert.Equal(t, "Victory Day; Parade", merged[1].Name)
+
+	_, err = mergeHolidays([]*venuepb.Holiday{{Date: "09.05.2026"}})
+	assert.Error(t, err)
+}
+
+func TestHolidayChange(t *testing.T) {
+	holiday := &venuepb.Holiday{Date: "2026-01-01", Name: "New Year"}
+	closed := &repository.SpecialHours{VenueID: "v", Date: "2026-01-01", IsClosed: true}
+
+	assert.Equal(t, "would_create", holidayChange(holiday, closed, nil, true).Status)
+	assert.Equal(t, "created", holidayChange(holiday, closed, nil, false).Status)
+	assert.Equal(t, "unchanged", holidayChange(holiday, closed, &repository.SpecialHours{IsClosed: true}, false).Status)
+
+	change := holidayChange(holiday, closed, &repository.SpecialHours{OpenTime: "12:00", CloseTime: "18:00"}, true)
+	assert.Equal(t, "would_update", change.Status)
+	assert.True(t, change.HadSpecialHours)
+	assert.Equal(t, "12:00", change.PreviousOpenTime)
+}
+
+func TestBlockOccursOn(t *testing.T) {
+	day := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		require.NoError(t, err)
+		return d
+	}
+
+	once := &repository.TableBlock{Date: "2026-03-06", UntilDate: "2026-03-06"}
+	assert.True(t, blockOccursOn(once, day("2026-03-06")))
+	assert.False(t, blockOccursOn(once, day("2026-03-07")))
+
+	// 2026-03-06 is a Friday
+	weekly := &repository.TableBlock{Date: "2026-03-06", Recurrence: "weekly", UntilDate: "2026-03-27"}
+	assert.True(t, blockOccursOn(weekly, day("2026-03-20")))
+	assert.False(t, blockOccursOn(weekly, day("2026-03-21")))
+	assert.False(t, blockOccursOn(weekly, day("2026-04-03")))
+	assert.False(t, blockOccursOn(weekly, day("2026-02-27")))
+
+	daily := &repository.TableBlock{Date: "2026-03-06", Recurrence: "daily"}
+	assert.True(t, blockOccursOn(daily, day("2027-01-01")))
+}
+
+func TestValidateTableBlock(t *testing.T) {
+	block := func(start, end string) *repository.TableBlock {
+		return &repository.TableBlock{Date: "2026-03-06", StartTime: start, EndTime: end}
+	}
+
+	assert.NoError(t, validateTableBlock(block("18:00", "20:00")))
+	assert.NoError(t, validateTableBlock(block("22:00", "00:00")))
+	assert.NoError(t, validateTableBlock(block("00:00", "00:00")))
+	assert.Error(t, validateTableBlock(block("18:00", "18:00")))
+	assert.Error(t, validateTableBlock(block("22:00", "02:00")))
+	assert.Error(t, validateTableBlock(block("18:00", "25:00")))
+}
+
+func TestSearchSlots_TableBlock(t *testing.T) {
+	tables := []*repository.Table{{ID: "a", RoomID: "hall", Capacity: 4}}
+	blocks := []*repository.TableBlock{{ID: "blk", TableID: "a", StartTime: "18:00", EndTime: "20:00", Reason: "staff"}}
+	search := &slotSearch{
+		partySize: 2,
+		from:      17 * 60,
+		to:        20 * 60,
+		close:     23 * 60,
+		interval:  30,
+		duration:  60,
+		buffers:   map[string]int32{"a": 0},
+	}
+
+	var starts []string
+	for _, slot := range searchSlots(search, tables, nil, addBlockIntervals(nil, blocks)) {
+		starts = append(starts, slot.StartTime)
+	}
+	assert.Equal(t, []string{"17:00", "20:00"}, starts)
+
+	timeline := buildTimeline(tables, search.buffers, nil, blocks, nil, 120)
+	require.Len(t, timeline[0].Blocks, 1)
+	assert.Equal(t, "blk", timeline[0].Blocks[0].TableBlockId)
+	assert.Equal(t, "20:00", timeline[0].Blocks[0].EndTime)
+
+	allDay := blockInterval(&repository.TableBlock{StartTime: "00:00", EndTime: "00:00"})
+	assert.Equal(t, busyInterval{start: 0, end: 24 * 60}, allDay)
+}
+
+func TestSearchSlots_RoomHire(t *testing.T) {
+	tables := []*repository.Table{
+		{ID: "a", RoomID: "hall", Capacity: 4},
+		{ID: "b", RoomID: "hall", Capacity: 4},
+	}
+	hires := []*bookingpb.RoomHire{{
+		Id:        "hire",
+		TableIds:  []string{"a", "b"},
+		Slot:      &commonpb.Slot{Date: "2026-03-06", StartTime: "18:00", DurationMinutes: 180},
+		EventName: "Corporate party",
+		Headcount: 40,
+	}}
+	search := &slotSearch{
+		partySize: 2,
+		from:      17 * 60,
+		to:        21 * 60,
+		close:     23 * 60,
+		interval:  60,
+		duration:  60,
+		buffers:   map[string]int32{"a": 0, "b": 0},
+	}
+
+	var starts []string
+	for _, slot := range searchSlots(search, tables, nil, addHireIntervals(nil, hires)) {
+		starts = append(starts, slot.StartTime)
+	}
+	assert.Equal(t, []string{"17:00", "21:00"}, starts)
+
+	timeline := buildTimeline(tables, search.buffers, nil, nil, hires, 120)
+	for _, row := range timeline {
+		require.Len(t, row.Blocks, 1)
+		assert.Equal(t, "hire", row.Blocks[0].RoomHireId)
+		assert.Equal(t, "hired", row.Blocks[0].Status)
+		assert.Equal(t, "Corporate party", row.Blocks[0].Reason)
+		assert.Equal(t, "21:00", row.Blocks[0].EndTime)
+	}
+}
@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"booker/cmd/venue-svc/repository"
+	bookingpb "booker/pkg/proto/booking"
+	venuepb "booker/pkg/proto/venue"
+	"booker/pkg/tracing"
+)
+
+const (
+	defaultSlotInterval = 15
+	maxSlotSuggestions  = 3
+)
+
+// occupyingStatuses are booking statuses that keep a table busy, same as in booking-svc
+var occupyingStatuses = map[string]bool{
+	"held":      true,
+	"confirmed": true,
+	"seated":    true,
+}
+
+// busyInterval is a time a table is taken, buffer included, in minutes after midnight
+type busyInterval struct {
+	start int
+	end   int
+}
+
+// slotSearch holds everything needed to compute the slots of one day
+type slotSearch struct {
+	venueID         string
+	partySize       int32
+	weekday         int32
+	preferredZone   string
+	from            int   // first start time, minutes after midnight
+	to              int   // last start time, inclusive
+	close           int   // visits must end by closing time
+	interval        int   // minutes between start times
+	duration        int32 // fixed visit length, 0 - by turn-time rules
+	defaultDuration int32
+	rules           []*repository.TurnTimeRule
+	pacing          []*repository.PacingRule
+	starts          []bookingStart // for pacing counts
+	buffers         map[string]int32
+}
+
+// SearchSlots returns every bookable start time of a day for a party. Tables,
+// combinations, turn-time and pacing rules and the day's bookings are loaded once
+// and all start times are checked in memory
+func (s *Service) SearchSlots(ctx context.Context, req *venuepb.SearchSlotsRequest) (*venuepb.SearchSlotsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "SearchSlots")
+	defer span.End()
+
+	if req.VenueId == "" {
+		return nil, fmt.Errorf("venue_id is required")
+	}
+	if req.PartySize <= 0 {
+		return nil, fmt.Errorf("party_size must be positive")
+	}
+	if req.DurationMinutes < 0 {
+		return nil, fmt.Errorf("duration_minutes must not be negative")
+	}
+	interval := int(req.IntervalMinutes)
+	if interval == 0 {
+		interval = defaultSlotInterval
+	}
+	if interval < 0 || interval > 24*60 {
+		return nil, fmt.Errorf("interval_minutes must be between 1 and 1440")
+	}
+
+	day, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", req.Date)
+	}
+
+	open, close, closed, err := s.daySchedule(ctx, req.VenueId, req.Date)
+	if err != nil {
+		return nil, err
+	}
+	resp := &venuepb.SearchSlotsResponse{Slots: []*venuepb.AvailableSlot{}}
+	if closed {
+		return resp, nil
+	}
+	resp.OpenTime = minutesClock(open)
+	resp.CloseTime = minutesClock(close)
+
+	search := &slotSearch{
+		venueID:         req.VenueId,
+		partySize:       req.PartySize,
+		weekday:         int32(day.Weekday()),
+		preferredZone:   req.PreferredZone,
+		from:            open,
+		to:              close - 1,
+		close:           close,
+		interval:        interval,
+		duration:        req.DurationMinutes,
+		defaultDuration: int32(s.cfg.DefaultTurnTimeMinutes),
+	}
+	if req.WindowStart != "" {
+		start, err := clockMinutes(req.WindowStart)
+		if err != nil {
+			return nil, err
+		}
+		search.from = max(search.from, start)
+	}
+	if req.WindowEnd != "" {
+		end, err := clockMinutes(req.WindowEnd)
+		if err != nil {
+			return nil, err
+		}
+		search.to = min(search.to, end)
+	}
+
+	layout, err := s.repo.GetVenueLayout(ctx, req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+	tables := layout.Tables
+	if len(tables) == 0 {
+		return resp, nil
+	}
+	combinations, err := s.repo.ListTableCombinations(ctx, "", req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+	venue, err := s.repo.GetVenue(ctx, req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+	search.buffers = tableBuffers(tables, venue.BufferMinutes)
+	if search.duration == 0 {
+		search.rules, err = s.repo.ListTurnTimeRules(ctx, req.VenueId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	search.pacing, err = s.repo.ListPacingRules(ctx, req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+
+	bookings, err := s.loadDayBookings(ctx, req.VenueId, req.Date)
+	if err != nil {
+		return nil, err
+	}
+	search.starts = bookingStarts(bookings)
+	blocks, err := s.dayBlocks(ctx, req.VenueId, "", req.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	hires, err := s.loadDayRoomHires(ctx, req.VenueId, req.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	busy := addHireIntervals(addBlockIntervals(busyIntervals(bookings, search.defaultDuration), blocks), hires)
+	resp.Slots = searchSlots(search, tables, combinations, busy)
+	return resp, nil
+}
+
+// loadDayBookings streams all bookings of a venue on a date from booking-svc
+func (s *Service) loadDayBookings(ctx context.Context, venueID, date string) ([]*bookingpb.Booking, error) {
+	stream, err := s.bookingClient.ExportBookings(ctx, &bookingpb.ExportBookingsRequest{
+		VenueId: venueID,
+		Date:    date,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load bookings: %w", err)
+	}
+
+	var bookings []*bookingpb.Booking
+	for {
+		b, err := stream.Recv()
+		if err == io.EOF {
+			return bookings, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load bookings: %w", err)
+		}
+		bookings = append(bookings, b)
+	}
+}
+
+// busyIntervals groups active bookings by every table they occupy. A table stays
+// busy for the booking's buffer after it ends
+func busyIntervals(bookings []*bookingpb.Booking, defaultDuration int32) map[string][]busyInterval {
+	busy := make(map[string][]busyInterval)
+	for _, b := range bookings {
+		if !occupyingStatuses[b.Status] {
+			continue
+		}
+		start, err := clockMinutes(b.Slot.GetStartTime())
+		if err != nil {
+			continue
+		}
+		duration := b.Slot.GetDurationMinutes()
+		if duration == 0 {
+			duration = defaultDuration
+		}
+		interval := busyInterval{start: start, end: start + int(duration) + int(b.BufferMinutes)}
+
+		busy[b.Table.GetTableId()] = append(busy[b.Table.GetTableId()], interval)
+		for _, ref := range b.CombinedTables {
+			busy[ref.TableId] = append(busy[ref.TableId], interval)
+		}
+	}
+	return busy
+}
+
+// searchSlots checks every start time of the window and keeps those allowed by pacing
+// rules and with at least one way to seat the party, together with the best assignments
+func searchSlots(p *slotSearch, tables []*repository.Table, combinations []*repository.TableCombination, busy map[string][]busyInterval) []*venuepb.AvailableSlot {
+	slots := make([]*venuepb.AvailableSlot, 0)
+	available := make(map[string]bool, len(tables))
+
+	for start := p.from; start <= p.to; start += p.interval {
+		startTime := minutesClock(start)
+		duration := p.duration
+		if duration == 0 {
+			duration = applyTurnTimeRules(p.rules, p.defaultDuration, p.partySize, p.weekday, startTime)
+		}
+		// Turn time may differ between periods, so a later start can still fit
+		end := start + int(duration)
+		if end > p.close {
+			continue
+		}
+		if checkPacing(p.pacing, p.weekday, start, p.partySize, p.starts) != nil {
+			continue
+		}
+
+		// The new booking needs its own buffer before the next one starts
+		for _, t := range tables {
+			available[t.ID] = !overlapsAny(busy[t.ID], start, end+int(p.buffers[t.ID]))
+		}
+
+		options := availabilityOptions(p.venueID, p.partySize, tables, combinations, available)
+		if len(options) == 0 {
+			continue
+		}
+		assignments := suggestAssignments(p.partySize, p.preferredZone, options, tables, available)
+		if len(assignments) > maxSlotSuggestions {
+			assignments = assignments[:maxSlotSuggestions]
+		}
+
+		slots = append(slots, &venuepb.AvailableSlot{
+			StartTime:           startTime,
+			DurationMinutes:     duration,
+			SuggestedAssignment: assignments,
+		})
+	}
+
+	return slots
+}
+
+func overlapsAny(intervals []busyInterval, start, end int) bool {
+	for _, i := range intervals {
+		if start < i.end && i.start < end {
+			return true
+		}
+	}
+	return false
+}
+
+// minutesClock formats minutes after midnight as HH:MM, midnight at the end of the day is 24:00
+func minutesClock(m int) string {
+	return fmt.Sprintf("%02d:%02d", m/60, m%60)
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"booker/cmd/venue-svc/repository"
+	bookingpb "booker/pkg/proto/booking"
+	commonpb "booker/pkg/proto/common"
+	venuepb "booker/pkg/proto/venue"
+)
+
+// timelineHiddenStatuses are bookings that never took a table
+var timelineHiddenStatuses = map[string]bool{
+	"cancelled": true,
+	"expired":   true,
+	"rejected":  true,
+}
+
+// tableBuffers returns the reset time after a booking for every table,
+// tables without their own buffer use the venue's
+func tableBuffers(tables []*repository.Table, venueBuffer int32) map[string]int32 {
+	buffers := make(map[string]int32, len(tables))
+	for _, t := range tables {
+		buffers[t.ID] = venueBuffer
+		if t.BufferMinutes > 0 {
+			buffers[t.ID] = t.BufferMinutes
+		}
+	}
+	return buffers
+}
+
+// tableTimeline loads the day's bookings, table blocks and room hires of a venue and lays them out per table
+func (s *Service) tableTimeline(ctx context.Context, venueID, date string, tables []*repository.Table) ([]*venuepb.TableTimeline, error) {
+	venue, err := s.repo.GetVenue(ctx, venueID)
+	if err != nil {
+		return nil, err
+	}
+	bookings, err := s.loadDayBookings(ctx, venueID, date)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := s.dayBlocks(ctx, venueID, "", date)
+	if err != nil {
+		return nil, err
+	}
+	hires, err := s.loadDayRoomHires(ctx, venueID, date)
+	if err != nil {
+		return nil, err
+	}
+	return buildTimeline(tables, tableBuffers(tables, venue.BufferMinutes), bookings, blocks, hires, int32(s.cfg.DefaultTurnTimeMinutes)), nil
+}
+
+// buildTimeline places every booking on all tables it occupies, every block on its
+// table and every room hire on the tables of its room, ordered by start time. The
+// buffer shown is the one stored with the booking, as it was when the booking was made
+func buildTimeline(tables []*repository.Table, buffers map[string]int32, bookings []*bookingpb.Booking,
+	blocks []*repository.TableBlock, hires []*bookingpb.RoomHire, defaultDuration int32) []*venuepb.TableTimeline {
+	timeline := make([]*venuepb.TableTimeline, len(tables))
+	byTable := make(map[string]*venuepb.TableTimeline, len(tables))
+	for i, t := range tables {
+		timeline[i] = &venuepb.TableTimeline{TableId: t.ID, BufferMinutes: buffers[t.ID], Blocks: []*venuepb.TimelineBlock{}}
+		byTable[t.ID] = timeline[i]
+	}
+
+	for _, b := range bookings {
+		if timelineHiddenStatuses[b.Status] {
+			continue
+		}
+		start, err := clockMinutes(b.Slot.GetStartTime())
+		if err != nil {
+			continue
+		}
+		duration := b.Slot.GetDurationMinutes()
+		if duration == 0 {
+			duration = defaultDuration
+		}
+		end := start + int(duration)
+
+		refs := append([]string{b.Table.GetTableId()}, tableRefIDs(b.CombinedTables)...)
+		for _, id := range refs {
+			row, ok := byTable[id]
+			if !ok {
+				continue
+			}
+			row.Blocks = append(row.Blocks, &venuepb.TimelineBlock{
+				BookingId: b.Id,
+				Status:    b.Status,
+				PartySize: b.PartySize,
+				StartTime: minutesClock(start),
+				EndTime:   minutesClock(end),
+				BufferEnd: minutesClock(end + int(b.BufferMinutes)),
+			})
+		}
+	}
+
+	for _, b := range blocks {
+		row, ok := byTable[b.TableID]
+		if !ok {
+			continue
+		}
+		interval := blockInterval(b)
+		row.Blocks = append(row.Blocks, &venuepb.TimelineBlock{
+			TableBlockId: b.ID,
+			Reason:       b.Reason,
+			Status:       "blocked",
+			StartTime:    minutesClock(interval.start),
+			EndTime:      minutesClock(interval.end),
+			BufferEnd:    minutesClock(interval.end),
+		})
+	}
+
+	for _, h := range hires {
+		interval, ok := hireInterval(h)
+		if !ok {
+			continue
+		}
+		for _, id := range h.TableIds {
+			row, ok := byTable[id]
+			if !ok {
+				continue
+			}
+			row.Blocks = append(row.Blocks, &venuepb.TimelineBlock{
+				RoomHireId: h.Id,
+				Reason:     h.EventName,
+				Status:     "hired",
+				PartySize:  h.Headcount,
+				StartTime:  minutesClock(interval.start),
+				EndTime:    minutesClock(interval.end),
+				BufferEnd:  minutesClock(interval.end),
+			})
+		}
+	}
+
+	for _, row := range timeline {
+		sort.SliceStable(row.Blocks, func(i, j int) bool {
+			return row.Blocks[i].StartTime < row.Blocks[j].StartTime
+		})
+	}
+	return timeline
+}
+
+func tableRefIDs(refs []*commonpb.TableRef) []string {
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		ids[i] = ref.TableId
+	}
+	return ids
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"booker/cmd/venue-svc/repository"
+	venuepb "booker/pkg/proto/venue"
+	"booker/pkg/tracing"
+)
+
+func (s *Service) SetTurnTimeRules(ctx context.Context, req *venuepb.SetTurnTimeRulesRequest) (*venuepb.TurnTimeRules, error) {
+	ctx, span := tracing.StartSpan(ctx, "SetTurnTimeRules")
+	defer span.End()
+
+	rules := make([]*repository.TurnTimeRule, len(req.Rules))
+	for i, r := range req.Rules {
+		if err := validateTurnTimeRule(r); err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		rules[i] = &repository.TurnTimeRule{
+			MinPartySize:    r.MinPartySize,
+			MaxPartySize:    r.MaxPartySize,
+			Weekdays:        r.Weekdays,
+			PeriodStart:     r.PeriodStart,
+			PeriodEnd:       r.PeriodEnd,
+			DurationMinutes: r.DurationMinutes,
+			ExtraMinutes:    r.ExtraMinutes,
+		}
+	}
+
+	if err := s.repo.ReplaceTurnTimeRules(ctx, req.VenueId, rules); err != nil {
+		return nil, err
+	}
+
+	return s.GetTurnTimeRules(ctx, &venuepb.GetTurnTimeRulesRequest{VenueId: req.VenueId})
+}
+
+func (s *Service) GetTurnTimeRules(ctx context.Context, req *venuepb.GetTurnTimeRulesRequest) (*venuepb.TurnTimeRules, error) {
+	rules, err := s.repo.ListTurnTimeRules(ctx, req.VenueId)
+	if err != nil {
+		return nil, err
+	}
+
+	protoRules := make([]*venuepb.TurnTimeRule, len(rules))
+	for i, r := range rules {
+		protoRules[i] = &venuepb.TurnTimeRule{
+			MinPartySize:    r.MinPartySize,
+			MaxPartySize:    r.MaxPartySize,
+			Weekdays:        r.Weekdays,
+			PeriodStart:     r.PeriodStart,
+			PeriodEnd:       r.PeriodEnd,
+			DurationMinutes: r.DurationMinutes,
+			ExtraMinutes:    r.ExtraMinutes,
+		}
+	}
+
+	return &venuepb.TurnTimeRules{
+		VenueId:                req.VenueId,
+		Rules:                  protoRules,
+		DefaultDurationMinutes: int32(s.cfg.DefaultTurnTimeMinutes),
+	}, nil
+}
+
+func (s *Service) ResolveTurnTime(ctx context.Context, req *venuepb.ResolveTurnTimeRequest) (*venuepb.ResolveTurnTimeResponse, error) {
+	duration, err := s.resolveTurnTime(ctx, req.VenueId, req.Date, req.StartTime, req.PartySize)
+	if err != nil {
+		return nil, err
+	}
+	return &venuepb.ResolveTurnTimeResponse{DurationMinutes: duration}, nil
+}
+
+func (s *Service) resolveTurnTime(ctx context.Context, venueID, date, startTime string, partySize int32) (int32, error) {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return 0, fmt.Errorf("invalid date %q: %w", date, err)
+	}
+
+	start, err := time.Parse("15:04", formatClock(startTime))
+	if err != nil {
+		return 0, fmt.Errorf("invalid start time %q: %w", startTime, err)
+	}
+
+	rules, err := s.repo.ListTurnTimeRules(ctx, venueID)
+	if err != nil {
+		return 0, err
+	}
+
+	return applyTurnTimeRules(rules, int32(s.cfg.DefaultTurnTimeMinutes), partySize, int32(day.Weekday()), start.Format("15:04")), nil
+}
+
+// applyTurnTimeRules picks the base duration from the most specific matching rule
+// (ties go to the earlier rule) and adds extra minutes of every matching rule
+func applyTurnTimeRules(rules []*repository.TurnTimeRule, defaultDuration, partySize, weekday int32, startTime string) int32 {
+	duration := defaultDuration
+	bestSpecificity := -1
+	var extra int32
+
+	for _, rule := range rules {
+		if !turnTimeRuleMatches(rule, partySize, weekday, startTime) {
+			continue
+		}
+		extra += rule.ExtraMinutes
+
+		if rule.DurationMinutes > 0 {
+			if specificity := turnTimeRuleSpecificity(rule); specificity > bestSpecificity {
+				bestSpecificity = specificity
+				duration = rule.DurationMinutes
+			}
+		}
+	}
+
+	return duration + extra
+}
+
+func turnTimeRuleMatches(rule *repository.TurnTimeRule, partySize, weekday int32, startTime string) bool {
+	if rule.MinPartySize > 0 && partySize < rule.MinPartySize {
+		return false
+	}
+	if rule.MaxPartySize > 0 && partySize > rule.MaxPartySize {
+		return false
+	}
+	if len(rule.Weekdays) > 0 && !containsWeekday(rule.Weekdays, weekday) {
+		return false
+	}
+	// HH:MM strings compare in chronological order
+	if rule.PeriodStart != "" && startTime < rule.PeriodStart {
+		return false
+	}
+	if rule.PeriodEnd != "" && startTime >= rule.PeriodEnd {
+		return false
+	}
+	return true
+}
+
+func containsWeekday(weekdays []int32, weekday int32) bool {
+	for _, d := range weekdays {
+		if d == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+func turnTimeRuleSpecificity(rule *repository.TurnTimeRule) int {
+	specificity := 0
+	if rule.MinPartySize > 0 {
+		specificity++
+	}
+	if rule.MaxPartySize > 0 {
+		specificity++
+	}
+	if len(rule.Weekdays) > 0 {
+		specificity++
+	}
+	if rule.PeriodStart != "" || rule.PeriodEnd != "" {
+		specificity++
+	}
+	return specificity
+}
+
+func validateTurnTimeRule(rule *venuepb.TurnTimeRule) error {
+	if rule.MinPartySize < 0 || rule.MaxPartySize < 0 {
+		return fmt.Errorf("party size must not be negative")
+	}
+	if rule.MaxPartySize > 0 && rule.MinPartySize > rule.MaxPartySize {
+		return fmt.Errorf("min_party_size is greater than max_party_size")
+	}
+	for _, d := range rule.Weekdays {
+		if d < 0 || d > 6 {
+			return fmt.Errorf("weekday %d is out of range 0-6", d)
+		}
+	}
+	for _, t := range []string{rule.PeriodStart, rule.PeriodEnd} {
+		if t == "" {
+			continue
+		}
+		if _, err := time.Parse("15:04", t); err != nil {
+			return fmt.Errorf("invalid time %q, expected HH:MM", t)
+		}
+	}
+	if rule.DurationMinutes < 0 {
+		return fmt.Errorf("duration_minutes must not be negative")
+	}
+	if rule.DurationMinutes == 0 && rule.ExtraMinutes == 0 {
+		return fmt.Errorf("rule sets neither duration_minutes nor extra_minutes")
+	}
+	return nil
+}
+
+// formatClock trims seconds from TIME values ("19:00:00" -> "19:00")
+func formatClock(t string) string {
+	if len(t) > 5 {
+		return t[:5]
+	}
+	return t
+}
+
+// clockMinutes converts HH:MM (or HH:MM:SS) to minutes after midnight
+func clockMinutes(t string) (int, error) {
+	parsed, err := time.Parse("15:04", formatClock(t))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", t)
+	}
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
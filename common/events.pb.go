@@ -149,6 +149,7 @@ type EventHeaders struct {
 	TraceId       string                 `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
 	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	EventId       string                 `protobuf:"bytes,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // одинаков при повторной доставке, для дедупликации у потребителей
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -204,6 +205,13 @@ func (x *EventHeaders) GetSource() string {
 	return ""
 }
 
+func (x *EventHeaders) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
 // События бронирования
 type BookingEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -225,6 +233,7 @@ type BookingEvent struct {
 	//	*BookingEvent_Finished
 	//	*BookingEvent_NoShow
 	//	*BookingEvent_Rejected
+	//	*BookingEvent_Moved
 	Payload       isBookingEvent_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -397,6 +406,15 @@ func (x *BookingEvent) GetRejected() *BookingRejected {
 	return nil
 }
 
+func (x *BookingEvent) GetMoved() *BookingMoved {
+	if x != nil {
+		if x, ok := x.Payload.(*BookingEvent_Moved); ok {
+			return x.Moved
+		}
+	}
+	return nil
+}
+
 type isBookingEvent_Payload interface {
 	isBookingEvent_Payload()
 }
@@ -437,6 +455,10 @@ type BookingEvent_Rejected struct {
 	Rejected *BookingRejected `protobuf:"bytes,18,opt,name=rejected,proto3,oneof"`
 }
 
+type BookingEvent_Moved struct {
+	Moved *BookingMoved `protobuf:"bytes,19,opt,name=moved,proto3,oneof"`
+}
+
 func (*BookingEvent_Requested) isBookingEvent_Payload() {}
 
 func (*BookingEvent_Held) isBookingEvent_Payload() {}
@@ -455,6 +477,8 @@ func (*BookingEvent_NoShow) isBookingEvent_Payload() {}
 
 func (*BookingEvent_Rejected) isBookingEvent_Payload() {}
 
+func (*BookingEvent_Moved) isBookingEvent_Payload() {}
+
 type BookingRequested struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
@@ -867,6 +891,209 @@ func (x *BookingRejected) GetReason() string {
 	return ""
 }
 
+// Бронь пересажена за другой стол, table в событии - новый стол
+type BookingMoved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	FromTable     *TableRef              `protobuf:"bytes,3,opt,name=from_table,json=fromTable,proto3" json:"from_table,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingMoved) Reset() {
+	*x = BookingMoved{}
+	mi := &file_common_events_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingMoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingMoved) ProtoMessage() {}
+
+func (x *BookingMoved) ProtoReflect() protoreflect.Message {
+	mi := &file_common_events_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingMoved.ProtoReflect.Descriptor instead.
+func (*BookingMoved) Descriptor() ([]byte, []int) {
+	return file_common_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BookingMoved) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *BookingMoved) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BookingMoved) GetFromTable() *TableRef {
+	if x != nil {
+		return x.FromTable
+	}
+	return nil
+}
+
+// События аренды зала: room_hire.created, room_hire.confirmed,
+// room_hire.cancelled и room_hire.completed
+type RoomHireEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Headers       *EventHeaders          `protobuf:"bytes,1,opt,name=headers,proto3" json:"headers,omitempty"`
+	RoomHireId    string                 `protobuf:"bytes,2,opt,name=room_hire_id,json=roomHireId,proto3" json:"room_hire_id,omitempty"`
+	VenueId       string                 `protobuf:"bytes,3,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Slot          *Slot                  `protobuf:"bytes,5,opt,name=slot,proto3" json:"slot,omitempty"`
+	EventName     string                 `protobuf:"bytes,6,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	Headcount     int32                  `protobuf:"varint,7,opt,name=headcount,proto3" json:"headcount,omitempty"`
+	ContactName   string                 `protobuf:"bytes,8,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
+	ContactPhone  string                 `protobuf:"bytes,9,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	ContactEmail  string                 `protobuf:"bytes,10,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	AdminId       string                 `protobuf:"bytes,12,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,13,opt,name=reason,proto3" json:"reason,omitempty"` // причина отмены
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomHireEvent) Reset() {
+	*x = RoomHireEvent{}
+	mi := &file_common_events_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomHireEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomHireEvent) ProtoMessage() {}
+
+func (x *RoomHireEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_common_events_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomHireEvent.ProtoReflect.Descriptor instead.
+func (*RoomHireEvent) Descriptor() ([]byte, []int) {
+	return file_common_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RoomHireEvent) GetHeaders() *EventHeaders {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *RoomHireEvent) GetRoomHireId() string {
+	if x != nil {
+		return x.RoomHireId
+	}
+	return ""
+}
+
+func (x *RoomHireEvent) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *RoomHireEvent) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RoomHireEvent) GetSlot() *Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *RoomHireEvent) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *RoomHireEvent) GetHeadcount() int32 {
+	if x != nil {
+		return x.Headcount
+	}
+	return 0
+}
+
+func (x *RoomHireEvent) GetContactName() string {
+	if x != nil {
+		return x.ContactName
+	}
+	return ""
+}
+
+func (x *RoomHireEvent) GetContactPhone() string {
+	if x != nil {
+		return x.ContactPhone
+	}
+	return ""
+}
+
+func (x *RoomHireEvent) GetContactEmail() string {
+	if x != nil {
+		return x.ContactEmail
+	}
+	return ""
+}
+
+func (x *RoomHireEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RoomHireEvent) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *RoomHireEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 // События заведения
 type VenueEvent struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
@@ -883,7 +1110,7 @@ type VenueEvent struct {
 
 func (x *VenueEvent) Reset() {
 	*x = VenueEvent{}
-	mi := &file_common_events_proto_msgTypes[13]
+	mi := &file_common_events_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -895,7 +1122,7 @@ func (x *VenueEvent) String() string {
 func (*VenueEvent) ProtoMessage() {}
 
 func (x *VenueEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_common_events_proto_msgTypes[13]
+	mi := &file_common_events_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,7 +1135,7 @@ func (x *VenueEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VenueEvent.ProtoReflect.Descriptor instead.
 func (*VenueEvent) Descriptor() ([]byte, []int) {
-	return file_common_events_proto_rawDescGZIP(), []int{13}
+	return file_common_events_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *VenueEvent) GetHeaders() *EventHeaders {
@@ -970,13 +1197,14 @@ type TableLayoutUpdated struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
 	TableIds      []string               `protobuf:"bytes,2,rep,name=table_ids,json=tableIds,proto3" json:"table_ids,omitempty"`
+	LayoutVersion int32                  `protobuf:"varint,3,opt,name=layout_version,json=layoutVersion,proto3" json:"layout_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TableLayoutUpdated) Reset() {
 	*x = TableLayoutUpdated{}
-	mi := &file_common_events_proto_msgTypes[14]
+	mi := &file_common_events_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -988,7 +1216,7 @@ func (x *TableLayoutUpdated) String() string {
 func (*TableLayoutUpdated) ProtoMessage() {}
 
 func (x *TableLayoutUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_common_events_proto_msgTypes[14]
+	mi := &file_common_events_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1001,7 +1229,7 @@ func (x *TableLayoutUpdated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TableLayoutUpdated.ProtoReflect.Descriptor instead.
 func (*TableLayoutUpdated) Descriptor() ([]byte, []int) {
-	return file_common_events_proto_rawDescGZIP(), []int{14}
+	return file_common_events_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TableLayoutUpdated) GetRoomId() string {
@@ -1018,6 +1246,13 @@ func (x *TableLayoutUpdated) GetTableIds() []string {
 	return nil
 }
 
+func (x *TableLayoutUpdated) GetLayoutVersion() int32 {
+	if x != nil {
+		return x.LayoutVersion
+	}
+	return 0
+}
+
 type VenueScheduleUpdated struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD или пусто для всех
@@ -1027,7 +1262,7 @@ type VenueScheduleUpdated struct {
 
 func (x *VenueScheduleUpdated) Reset() {
 	*x = VenueScheduleUpdated{}
-	mi := &file_common_events_proto_msgTypes[15]
+	mi := &file_common_events_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1039,7 +1274,7 @@ func (x *VenueScheduleUpdated) String() string {
 func (*VenueScheduleUpdated) ProtoMessage() {}
 
 func (x *VenueScheduleUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_common_events_proto_msgTypes[15]
+	mi := &file_common_events_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,7 +1287,7 @@ func (x *VenueScheduleUpdated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VenueScheduleUpdated.ProtoReflect.Descriptor instead.
 func (*VenueScheduleUpdated) Descriptor() ([]byte, []int) {
-	return file_common_events_proto_rawDescGZIP(), []int{15}
+	return file_common_events_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *VenueScheduleUpdated) GetDate() string {
@@ -1075,11 +1310,12 @@ const file_common_events_proto_rawDesc = "" +
 	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1d\n" +
 	"\n" +
 	"start_time\x18\x02 \x01(\tR\tstartTime\x12)\n" +
-	"\x10duration_minutes\x18\x03 \x01(\x05R\x0fdurationMinutes\"_\n" +
+	"\x10duration_minutes\x18\x03 \x01(\x05R\x0fdurationMinutes\"z\n" +
 	"\fEventHeaders\x12\x19\n" +
 	"\btrace_id\x18\x01 \x01(\tR\atraceId\x12\x1c\n" +
 	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12\x16\n" +
-	"\x06source\x18\x03 \x01(\tR\x06source\"\xfb\x05\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x19\n" +
+	"\bevent_id\x18\x04 \x01(\tR\aeventId\"\xa9\x06\n" +
 	"\fBookingEvent\x12.\n" +
 	"\aheaders\x18\x01 \x01(\v2\x14.common.EventHeadersR\aheaders\x12\x1d\n" +
 	"\n" +
@@ -1099,7 +1335,8 @@ const file_common_events_proto_rawDesc = "" +
 	"\x06seated\x18\x0f \x01(\v2\x15.common.BookingSeatedH\x00R\x06seated\x125\n" +
 	"\bfinished\x18\x10 \x01(\v2\x17.common.BookingFinishedH\x00R\bfinished\x120\n" +
 	"\ano_show\x18\x11 \x01(\v2\x15.common.BookingNoShowH\x00R\x06noShow\x125\n" +
-	"\brejected\x18\x12 \x01(\v2\x17.common.BookingRejectedH\x00R\brejectedB\t\n" +
+	"\brejected\x18\x12 \x01(\v2\x17.common.BookingRejectedH\x00R\brejected\x12,\n" +
+	"\x05moved\x18\x13 \x01(\v2\x14.common.BookingMovedH\x00R\x05movedB\t\n" +
 	"\apayload\"G\n" +
 	"\x10BookingRequested\x12\x19\n" +
 	"\badmin_id\x18\x01 \x01(\tR\aadminId\x12\x18\n" +
@@ -1121,7 +1358,29 @@ const file_common_events_proto_rawDesc = "" +
 	"\rBookingNoShow\x12\x19\n" +
 	"\badmin_id\x18\x01 \x01(\tR\aadminId\")\n" +
 	"\x0fBookingRejected\x12\x16\n" +
-	"\x06reason\x18\x01 \x01(\tR\x06reason\"\xf2\x01\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"r\n" +
+	"\fBookingMoved\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12/\n" +
+	"\n" +
+	"from_table\x18\x03 \x01(\v2\x10.common.TableRefR\tfromTable\"\xac\x03\n" +
+	"\rRoomHireEvent\x12.\n" +
+	"\aheaders\x18\x01 \x01(\v2\x14.common.EventHeadersR\aheaders\x12 \n" +
+	"\froom_hire_id\x18\x02 \x01(\tR\n" +
+	"roomHireId\x12\x19\n" +
+	"\bvenue_id\x18\x03 \x01(\tR\avenueId\x12\x17\n" +
+	"\aroom_id\x18\x04 \x01(\tR\x06roomId\x12 \n" +
+	"\x04slot\x18\x05 \x01(\v2\f.common.SlotR\x04slot\x12\x1d\n" +
+	"\n" +
+	"event_name\x18\x06 \x01(\tR\teventName\x12\x1c\n" +
+	"\theadcount\x18\a \x01(\x05R\theadcount\x12!\n" +
+	"\fcontact_name\x18\b \x01(\tR\vcontactName\x12#\n" +
+	"\rcontact_phone\x18\t \x01(\tR\fcontactPhone\x12#\n" +
+	"\rcontact_email\x18\n" +
+	" \x01(\tR\fcontactEmail\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x19\n" +
+	"\badmin_id\x18\f \x01(\tR\aadminId\x12\x16\n" +
+	"\x06reason\x18\r \x01(\tR\x06reason\"\xf2\x01\n" +
 	"\n" +
 	"VenueEvent\x12.\n" +
 	"\aheaders\x18\x01 \x01(\v2\x14.common.EventHeadersR\aheaders\x12\x19\n" +
@@ -1129,10 +1388,11 @@ const file_common_events_proto_rawDesc = "" +
 	"\x0elayout_updated\x18\n" +
 	" \x01(\v2\x1a.common.TableLayoutUpdatedH\x00R\rlayoutUpdated\x12I\n" +
 	"\x10schedule_updated\x18\v \x01(\v2\x1c.common.VenueScheduleUpdatedH\x00R\x0fscheduleUpdatedB\t\n" +
-	"\apayload\"J\n" +
+	"\apayload\"q\n" +
 	"\x12TableLayoutUpdated\x12\x17\n" +
 	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
-	"\ttable_ids\x18\x02 \x03(\tR\btableIds\"*\n" +
+	"\ttable_ids\x18\x02 \x03(\tR\btableIds\x12%\n" +
+	"\x0elayout_version\x18\x03 \x01(\x05R\rlayoutVersion\"*\n" +
 	"\x14VenueScheduleUpdated\x12\x12\n" +
 	"\x04date\x18\x01 \x01(\tR\x04dateB\x19Z\x17booker/pkg/proto/commonb\x06proto3"
 
@@ -1148,7 +1408,7 @@ func file_common_events_proto_rawDescGZIP() []byte {
 	return file_common_events_proto_rawDescData
 }
 
-var file_common_events_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_common_events_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_common_events_proto_goTypes = []any{
 	(*TableRef)(nil),             // 0: common.TableRef
 	(*Slot)(nil),                 // 1: common.Slot
@@ -1163,9 +1423,11 @@ var file_common_events_proto_goTypes = []any{
 	(*BookingFinished)(nil),      // 10: common.BookingFinished
 	(*BookingNoShow)(nil),        // 11: common.BookingNoShow
 	(*BookingRejected)(nil),      // 12: common.BookingRejected
-	(*VenueEvent)(nil),           // 13: common.VenueEvent
-	(*TableLayoutUpdated)(nil),   // 14: common.TableLayoutUpdated
-	(*VenueScheduleUpdated)(nil), // 15: common.VenueScheduleUpdated
+	(*BookingMoved)(nil),         // 13: common.BookingMoved
+	(*RoomHireEvent)(nil),        // 14: common.RoomHireEvent
+	(*VenueEvent)(nil),           // 15: common.VenueEvent
+	(*TableLayoutUpdated)(nil),   // 16: common.TableLayoutUpdated
+	(*VenueScheduleUpdated)(nil), // 17: common.VenueScheduleUpdated
 }
 var file_common_events_proto_depIdxs = []int32{
 	2,  // 0: common.BookingEvent.headers:type_name -> common.EventHeaders
@@ -1180,14 +1442,18 @@ var file_common_events_proto_depIdxs = []int32{
 	10, // 9: common.BookingEvent.finished:type_name -> common.BookingFinished
 	11, // 10: common.BookingEvent.no_show:type_name -> common.BookingNoShow
 	12, // 11: common.BookingEvent.rejected:type_name -> common.BookingRejected
-	2,  // 12: common.VenueEvent.headers:type_name -> common.EventHeaders
-	14, // 13: common.VenueEvent.layout_updated:type_name -> common.TableLayoutUpdated
-	15, // 14: common.VenueEvent.schedule_updated:type_name -> common.VenueScheduleUpdated
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	13, // 12: common.BookingEvent.moved:type_name -> common.BookingMoved
+	0,  // 13: common.BookingMoved.from_table:type_name -> common.TableRef
+	2,  // 14: common.RoomHireEvent.headers:type_name -> common.EventHeaders
+	1,  // 15: common.RoomHireEvent.slot:type_name -> common.Slot
+	2,  // 16: common.VenueEvent.headers:type_name -> common.EventHeaders
+	16, // 17: common.VenueEvent.layout_updated:type_name -> common.TableLayoutUpdated
+	17, // 18: common.VenueEvent.schedule_updated:type_name -> common.VenueScheduleUpdated
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_common_events_proto_init() }
@@ -1205,8 +1471,9 @@ func file_common_events_proto_init() {
 		(*BookingEvent_Finished)(nil),
 		(*BookingEvent_NoShow)(nil),
 		(*BookingEvent_Rejected)(nil),
+		(*BookingEvent_Moved)(nil),
 	}
-	file_common_events_proto_msgTypes[13].OneofWrappers = []any{
+	file_common_events_proto_msgTypes[15].OneofWrappers = []any{
 		(*VenueEvent_LayoutUpdated)(nil),
 		(*VenueEvent_ScheduleUpdated)(nil),
 	}
@@ -1216,7 +1483,7 @@ func file_common_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_events_proto_rawDesc), len(file_common_events_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -27,7 +27,7 @@ func setupTestServer() *echo.Echo {
 
 	// In real integration tests, we'd create actual gRPC connections
 	// For now, we create a handler with nil connections (will fail on actual calls)
-	handler := handlers.New(nil, nil, nil, cfg)
+	handler := handlers.New(nil, nil, nil, nil, cfg)
 
 	mw := &middleware.Middleware{}
 	e := handler.SetupRoutes(mw)
@@ -0,0 +1,13 @@
+-- Booking service: guest search by name and phone
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Phone digits only, with the Russian trunk prefix 8 rewritten to 7,
+-- so that "+7 (916) 123-45-67" and "89161234567" are stored the same way
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS customer_phone_normalized TEXT
+    GENERATED ALWAYS AS (
+        regexp_replace(regexp_replace(COALESCE(customer_phone, ''), '\D', '', 'g'), '^8(\d{10})$', '7\1')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_bookings_customer_name_trgm ON bookings USING GIN (lower(customer_name) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_bookings_customer_phone_trgm ON bookings USING GIN (customer_phone_normalized gin_trgm_ops);
@@ -0,0 +1,3 @@
+-- Booking service: revision counter for calendar feeds (iCalendar SEQUENCE)
+
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS sequence INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+-- Booking service: idempotent import from external systems
+
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS external_ref VARCHAR(255);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bookings_external_ref ON bookings(venue_id, external_ref) WHERE external_ref IS NOT NULL;
@@ -0,0 +1,13 @@
+-- Venue service: floor plan geometry
+
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS width DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS height DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS background_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS layout_version INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE tables ADD COLUMN IF NOT EXISTS pos_x DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE tables ADD COLUMN IF NOT EXISTS pos_y DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE tables ADD COLUMN IF NOT EXISTS width DOUBLE PRECISION NOT NULL DEFAULT 60;
+ALTER TABLE tables ADD COLUMN IF NOT EXISTS height DOUBLE PRECISION NOT NULL DEFAULT 60;
+ALTER TABLE tables ADD COLUMN IF NOT EXISTS shape VARCHAR(20) NOT NULL DEFAULT 'rect';
+ALTER TABLE tables ADD COLUMN IF NOT EXISTS rotation DOUBLE PRECISION NOT NULL DEFAULT 0;
@@ -0,0 +1,21 @@
+-- Venue service: named table combinations
+
+CREATE TABLE IF NOT EXISTS table_combinations (
+    id VARCHAR(36) PRIMARY KEY,
+    room_id VARCHAR(36) NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    min_capacity INTEGER NOT NULL DEFAULT 0,
+    max_capacity INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_table_combinations_room_id ON table_combinations(room_id);
+
+CREATE TABLE IF NOT EXISTS table_combination_members (
+    combination_id VARCHAR(36) NOT NULL REFERENCES table_combinations(id) ON DELETE CASCADE,
+    table_id VARCHAR(36) NOT NULL REFERENCES tables(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (combination_id, table_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_table_combination_members_table_id ON table_combination_members(table_id);
@@ -0,0 +1,5 @@
+-- Booking service: bookings occupying a combination of tables
+
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS combined_table_ids TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_bookings_combined_table_ids ON bookings USING GIN (combined_table_ids);
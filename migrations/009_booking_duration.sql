@@ -0,0 +1,10 @@
+-- Booking service: persisted booking duration
+
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS duration_minutes INTEGER;
+
+UPDATE bookings
+SET duration_minutes = ((EXTRACT(EPOCH FROM (end_time - start_time)) / 60)::INTEGER + 1440) % 1440
+WHERE duration_minutes IS NULL;
+
+ALTER TABLE bookings ALTER COLUMN duration_minutes SET DEFAULT 120;
+ALTER TABLE bookings ALTER COLUMN duration_minutes SET NOT NULL;
@@ -0,0 +1,16 @@
+-- Venue service: turn-time rules
+
+CREATE TABLE IF NOT EXISTS turn_time_rules (
+    id VARCHAR(36) PRIMARY KEY,
+    venue_id VARCHAR(36) NOT NULL REFERENCES venues(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    min_party_size INTEGER NOT NULL DEFAULT 0,
+    max_party_size INTEGER NOT NULL DEFAULT 0,
+    weekdays INTEGER[] NOT NULL DEFAULT '{}',
+    period_start TIME,
+    period_end TIME,
+    duration_minutes INTEGER NOT NULL DEFAULT 0,
+    extra_minutes INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_turn_time_rules_venue_id ON turn_time_rules(venue_id);
@@ -0,0 +1,15 @@
+-- Venue service: pacing rules
+
+CREATE TABLE IF NOT EXISTS pacing_rules (
+    id VARCHAR(36) PRIMARY KEY,
+    venue_id VARCHAR(36) NOT NULL REFERENCES venues(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    interval_minutes INTEGER NOT NULL CHECK (interval_minutes IN (15, 30)),
+    weekdays INTEGER[] NOT NULL DEFAULT '{}',
+    period_start TIME,
+    period_end TIME,
+    max_covers INTEGER NOT NULL DEFAULT 0,
+    max_bookings INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_pacing_rules_venue_id ON pacing_rules(venue_id);
@@ -0,0 +1,6 @@
+-- Venue service: buffer time to reset a table between bookings
+
+ALTER TABLE venues ADD COLUMN IF NOT EXISTS buffer_minutes INTEGER NOT NULL DEFAULT 0;
+
+-- 0 means the venue's buffer applies
+ALTER TABLE tables ADD COLUMN IF NOT EXISTS buffer_minutes INTEGER NOT NULL DEFAULT 0;
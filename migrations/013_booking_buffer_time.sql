@@ -0,0 +1,51 @@
+-- Booking service: buffer time after bookings and overlap protection
+
+-- Buffer of the booking's tables at the time it was made
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS buffer_minutes INTEGER NOT NULL DEFAULT 0;
+
+-- Minute of the day after which a booking no longer blocks its tables.
+-- A booking ending at midnight has end_time 00:00
+CREATE OR REPLACE FUNCTION booking_busy_until(start_time TIME, end_time TIME, buffer_minutes INTEGER)
+RETURNS INTEGER AS $$
+    SELECT (EXTRACT(EPOCH FROM end_time) / 60)::INTEGER
+         + CASE WHEN end_time <= start_time THEN 1440 ELSE 0 END
+         + buffer_minutes
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- Rejects an active booking that overlaps another one on any of its tables,
+-- buffers included. Writers of one venue and day are serialized by an advisory
+-- lock, so concurrent inserts cannot both pass the check
+CREATE OR REPLACE FUNCTION check_booking_overlap() RETURNS TRIGGER AS $$
+DECLARE
+    conflict_id VARCHAR(36);
+BEGIN
+    IF NEW.status NOT IN ('held', 'confirmed', 'seated') THEN
+        RETURN NEW;
+    END IF;
+
+    PERFORM pg_advisory_xact_lock(hashtext(NEW.venue_id || ':' || NEW.date::text));
+
+    SELECT b.id INTO conflict_id FROM bookings b
+    WHERE b.venue_id = NEW.venue_id
+      AND b.date = NEW.date
+      AND b.id <> NEW.id
+      AND b.status IN ('held', 'confirmed', 'seated')
+      AND array_prepend(b.table_id::text, b.combined_table_ids) && array_prepend(NEW.table_id::text, NEW.combined_table_ids)
+      AND (EXTRACT(EPOCH FROM b.start_time) / 60)::INTEGER < booking_busy_until(NEW.start_time, NEW.end_time, NEW.buffer_minutes)
+      AND (EXTRACT(EPOCH FROM NEW.start_time) / 60)::INTEGER < booking_busy_until(b.start_time, b.end_time, b.buffer_minutes)
+    LIMIT 1;
+
+    IF conflict_id IS NOT NULL THEN
+        RAISE EXCEPTION 'table is already booked by % for this time slot', conflict_id
+            USING ERRCODE = 'exclusion_violation';
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS bookings_no_overlap ON bookings;
+CREATE TRIGGER bookings_no_overlap
+    BEFORE INSERT OR UPDATE OF table_id, combined_table_ids, date, start_time, end_time, status, buffer_minutes
+    ON bookings
+    FOR EACH ROW EXECUTE FUNCTION check_booking_overlap();
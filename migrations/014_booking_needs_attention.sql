@@ -0,0 +1,7 @@
+-- Booking service: bookings affected by layout and schedule changes
+
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS needs_attention BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS attention_reason TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_bookings_needs_attention ON bookings (venue_id, date, start_time)
+    WHERE needs_attention;
@@ -0,0 +1,12 @@
+-- Venue service: soft deletion of venues, rooms and tables
+
+-- Children deleted together with their parent share its deleted_at, so a
+-- restore brings back exactly what the delete hid
+ALTER TABLE venues ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE tables ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+-- Purge job lookups
+CREATE INDEX IF NOT EXISTS idx_venues_deleted_at ON venues(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_rooms_deleted_at ON rooms(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_tables_deleted_at ON tables(deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,17 @@
+-- Venue service: tables blocked for maintenance or staff use
+
+CREATE TABLE IF NOT EXISTS table_blocks (
+    id VARCHAR(36) PRIMARY KEY,
+    table_id VARCHAR(36) NOT NULL REFERENCES tables(id) ON DELETE CASCADE,
+    start_date DATE NOT NULL,
+    -- Last day the block applies, equal to start_date for a one-off block
+    until_date DATE,
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    recurrence VARCHAR(16) NOT NULL DEFAULT '' CHECK (recurrence IN ('', 'daily', 'weekly')),
+    created_by VARCHAR(36) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_table_blocks_table_id ON table_blocks(table_id);
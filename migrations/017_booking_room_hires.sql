@@ -0,0 +1,118 @@
+-- Booking service: private hire of a whole room
+
+-- A hire occupies every table of its room for the slot while it is tentative or
+-- confirmed. table_ids is the room's tables when the hire was made, kept up to
+-- date by booking-svc on layout changes
+CREATE TABLE IF NOT EXISTS room_hires (
+    id VARCHAR(36) PRIMARY KEY,
+    venue_id VARCHAR(36) NOT NULL,
+    room_id VARCHAR(36) NOT NULL,
+    table_ids TEXT[] NOT NULL DEFAULT '{}',
+    date DATE NOT NULL,
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    duration_minutes INTEGER NOT NULL,
+    event_name VARCHAR(255) NOT NULL,
+    headcount INTEGER NOT NULL,
+    contact_name VARCHAR(255) NOT NULL,
+    contact_phone VARCHAR(50) NOT NULL DEFAULT '',
+    contact_email VARCHAR(255) NOT NULL DEFAULT '',
+    status VARCHAR(50) NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    admin_id VARCHAR(36) NOT NULL DEFAULT '',
+    cancel_reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_room_hires_venue_date ON room_hires(venue_id, date, start_time);
+CREATE INDEX IF NOT EXISTS idx_room_hires_room_date ON room_hires(room_id, date);
+
+-- Rejects an active booking on a table of a hired room. Takes the same advisory
+-- lock as check_booking_overlap, so bookings and hires of a venue and day are
+-- written one at a time
+CREATE OR REPLACE FUNCTION check_booking_room_hire() RETURNS TRIGGER AS $$
+DECLARE
+    conflict_id VARCHAR(36);
+BEGIN
+    IF NEW.status NOT IN ('held', 'confirmed', 'seated') THEN
+        RETURN NEW;
+    END IF;
+
+    PERFORM pg_advisory_xact_lock(hashtext(NEW.venue_id || ':' || NEW.date::text));
+
+    SELECT h.id INTO conflict_id FROM room_hires h
+    WHERE h.venue_id = NEW.venue_id
+      AND h.date = NEW.date
+      AND h.status IN ('tentative', 'confirmed')
+      AND h.table_ids && array_prepend(NEW.table_id::text, NEW.combined_table_ids)
+      AND (EXTRACT(EPOCH FROM h.start_time) / 60)::INTEGER < booking_busy_until(NEW.start_time, NEW.end_time, NEW.buffer_minutes)
+      AND (EXTRACT(EPOCH FROM NEW.start_time) / 60)::INTEGER < booking_busy_until(h.start_time, h.end_time, 0)
+    LIMIT 1;
+
+    IF conflict_id IS NOT NULL THEN
+        RAISE EXCEPTION 'room is hired by % for this time slot', conflict_id
+            USING ERRCODE = 'exclusion_violation';
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS bookings_no_room_hire ON bookings;
+CREATE TRIGGER bookings_no_room_hire
+    BEFORE INSERT OR UPDATE OF table_id, combined_table_ids, date, start_time, end_time, status, buffer_minutes
+    ON bookings
+    FOR EACH ROW EXECUTE FUNCTION check_booking_room_hire();
+
+-- Rejects an active hire that overlaps an active booking on the room's tables or
+-- another hire of the same room. Refreshing table_ids after a layout change is not
+-- checked: bookings already on a table that joined the room are kept
+CREATE OR REPLACE FUNCTION check_room_hire_overlap() RETURNS TRIGGER AS $$
+DECLARE
+    conflict_id VARCHAR(36);
+BEGIN
+    IF NEW.status NOT IN ('tentative', 'confirmed') THEN
+        RETURN NEW;
+    END IF;
+
+    PERFORM pg_advisory_xact_lock(hashtext(NEW.venue_id || ':' || NEW.date::text));
+
+    SELECT b.id INTO conflict_id FROM bookings b
+    WHERE b.venue_id = NEW.venue_id
+      AND b.date = NEW.date
+      AND b.status IN ('held', 'confirmed', 'seated')
+      AND array_prepend(b.table_id::text, b.combined_table_ids) && NEW.table_ids
+      AND (EXTRACT(EPOCH FROM b.start_time) / 60)::INTEGER < booking_busy_until(NEW.start_time, NEW.end_time, 0)
+      AND (EXTRACT(EPOCH FROM NEW.start_time) / 60)::INTEGER < booking_busy_until(b.start_time, b.end_time, b.buffer_minutes)
+    LIMIT 1;
+
+    IF conflict_id IS NOT NULL THEN
+        RAISE EXCEPTION 'table is already booked by % for this time slot', conflict_id
+            USING ERRCODE = 'exclusion_violation';
+    END IF;
+
+    SELECT h.id INTO conflict_id FROM room_hires h
+    WHERE h.venue_id = NEW.venue_id
+      AND h.date = NEW.date
+      AND h.id <> NEW.id
+      AND h.status IN ('tentative', 'confirmed')
+      AND (h.room_id = NEW.room_id OR h.table_ids && NEW.table_ids)
+      AND (EXTRACT(EPOCH FROM h.start_time) / 60)::INTEGER < booking_busy_until(NEW.start_time, NEW.end_time, 0)
+      AND (EXTRACT(EPOCH FROM NEW.start_time) / 60)::INTEGER < booking_busy_until(h.start_time, h.end_time, 0)
+    LIMIT 1;
+
+    IF conflict_id IS NOT NULL THEN
+        RAISE EXCEPTION 'room is already hired by % for this time slot', conflict_id
+            USING ERRCODE = 'exclusion_violation';
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS room_hires_no_overlap ON room_hires;
+CREATE TRIGGER room_hires_no_overlap
+    BEFORE INSERT OR UPDATE OF date, start_time, end_time, status
+    ON room_hires
+    FOR EACH ROW EXECUTE FUNCTION check_room_hire_overlap();
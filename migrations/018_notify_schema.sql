@@ -0,0 +1,29 @@
+-- Notify service migrations
+
+-- Channels a venue sends guest notifications through. A venue without rows
+-- uses the service's default channels
+CREATE TABLE IF NOT EXISTS venue_channels (
+    venue_id VARCHAR(36) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    recipient VARCHAR(255) NOT NULL DEFAULT '',
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (venue_id, channel)
+);
+
+-- Every attempt to send a notification over a channel
+CREATE TABLE IF NOT EXISTS deliveries (
+    id VARCHAR(36) PRIMARY KEY,
+    venue_id VARCHAR(36) NOT NULL,
+    topic VARCHAR(100) NOT NULL,
+    event_key VARCHAR(36) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    recipient VARCHAR(255) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    provider_message_id VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_deliveries_venue_created ON deliveries(venue_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_deliveries_event_key ON deliveries(event_key);
@@ -0,0 +1,23 @@
+-- Notify service: message templates and venue locale
+
+-- Templates of guest messages. An empty venue_id overrides the built-in template
+-- for every venue, otherwise the row belongs to one venue
+CREATE TABLE IF NOT EXISTS message_templates (
+    venue_id VARCHAR(36) NOT NULL DEFAULT '',
+    event_type VARCHAR(100) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    subject TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    updated_by VARCHAR(36) NOT NULL DEFAULT '',
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (venue_id, event_type, channel, locale)
+);
+
+-- Language guests of a venue are notified in. Venues without a row use the
+-- service's default locale
+CREATE TABLE IF NOT EXISTS venue_settings (
+    venue_id VARCHAR(36) PRIMARY KEY,
+    locale VARCHAR(10) NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
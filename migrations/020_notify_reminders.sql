@@ -0,0 +1,28 @@
+-- Notify service: booking reminders
+
+-- Minutes before the slot a venue reminds guests. Venues without a row use the
+-- service's default offsets; an empty list turns reminders off
+CREATE TABLE IF NOT EXISTS venue_reminders (
+    venue_id VARCHAR(36) PRIMARY KEY,
+    offsets INTEGER[] NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Reminders of confirmed bookings. slot_start is the start the reminder was
+-- scheduled for; a moved slot gets new rows and the old pending ones are cancelled
+CREATE TABLE IF NOT EXISTS reminders (
+    id VARCHAR(36) PRIMARY KEY,
+    venue_id VARCHAR(36) NOT NULL,
+    booking_id VARCHAR(36) NOT NULL,
+    offset_minutes INTEGER NOT NULL,
+    slot_start TIMESTAMPTZ NOT NULL,
+    due_at TIMESTAMPTZ NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- pending, sent, failed, cancelled, expired
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (booking_id, offset_minutes, slot_start)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reminders_due ON reminders(due_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_reminders_venue_due ON reminders(venue_id, due_at DESC);
@@ -0,0 +1,17 @@
+-- Notify service: event deduplication
+
+-- Events handled to the end. Kafka may deliver an event again; one with a known
+-- ID is skipped
+CREATE TABLE IF NOT EXISTS processed_events (
+    event_id VARCHAR(100) PRIMARY KEY,
+    topic VARCHAR(100) NOT NULL,
+    event_key VARCHAR(36) NOT NULL,
+    processed_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_processed_events_processed_at ON processed_events(processed_at);
+
+-- Event a delivery was made for, so a retried event skips channels already done
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS event_id VARCHAR(100) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_deliveries_event_id ON deliveries(event_id) WHERE event_id <> '';
@@ -0,0 +1,5 @@
+-- Notify service: reminder retries
+
+-- Failed attempts of a reminder. A reminder that could not be sent stays pending
+-- with due_at moved back until the service's attempts are used up
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS attempts INTEGER NOT NULL DEFAULT 0;
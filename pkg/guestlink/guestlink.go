@@ -0,0 +1,76 @@
+// Package guestlink signs the tokens of the links guests manage their bookings
+// with. A token names the booking and the revision it was issued for, so any
+// change to the booking revokes the links sent before it
+package guestlink
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalid = errors.New("invalid link")
+	ErrExpired = errors.New("link has expired")
+)
+
+// Claims is what a token vouches for
+type Claims struct {
+	BookingID string
+	Sequence  int32 // revision of the booking the link was issued for
+	ExpiresAt time.Time
+}
+
+// Sign returns the token of the claims
+func Sign(secret string, c *Claims) string {
+	payload := fmt.Sprintf("%s.%d.%d", c.BookingID, c.Sequence, c.ExpiresAt.Unix())
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(payload))
+	return encoded + "." + signature(secret, encoded)
+}
+
+// Parse checks the signature and expiry of a token and returns its claims
+func Parse(secret, token string, now time.Time) (*Claims, error) {
+	encoded, sig, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(signature(secret, encoded))) {
+		return nil, ErrInvalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+
+	parts := strings.Split(string(payload), ".")
+	if len(parts) != 3 || parts[0] == "" {
+		return nil, ErrInvalid
+	}
+	sequence, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	expires, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+
+	c := &Claims{BookingID: parts[0], Sequence: int32(sequence), ExpiresAt: time.Unix(expires, 0)}
+	if !now.Before(c.ExpiresAt) {
+		return nil, ErrExpired
+	}
+	return c, nil
+}
+
+// URL returns the link of a token on the gateway at publicURL
+func URL(publicURL, token string) string {
+	return strings.TrimSuffix(publicURL, "/") + "/api/v1/guest/bookings/" + token
+}
+
+func signature(secret, encoded string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,53 @@
+package guestlink
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignParse(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	claims := &Claims{BookingID: "6f1c2a9e-booking", Sequence: 3, ExpiresAt: now.Add(time.Hour)}
+	token := Sign("secret", claims)
+
+	t.Run("valid token", func(t *testing.T) {
+		got, err := Parse("secret", token, now)
+		require.NoError(t, err)
+		assert.Equal(t, claims.BookingID, got.BookingID)
+		assert.Equal(t, claims.Sequence, got.Sequence)
+		assert.True(t, claims.ExpiresAt.Equal(got.ExpiresAt))
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		_, err := Parse("secret", token, now.Add(time.Hour))
+		assert.ErrorIs(t, err, ErrExpired)
+	})
+
+	t.Run("other secret", func(t *testing.T) {
+		_, err := Parse("rotated", token, now)
+		assert.ErrorIs(t, err, ErrInvalid)
+	})
+
+	t.Run("tampered payload", func(t *testing.T) {
+		forged := Sign("secret", &Claims{BookingID: "other-booking", Sequence: 3, ExpiresAt: claims.ExpiresAt})
+		_, sig, _ := strings.Cut(token, ".")
+		payload, _, _ := strings.Cut(forged, ".")
+		_, err := Parse("secret", payload+"."+sig, now)
+		assert.ErrorIs(t, err, ErrInvalid)
+	})
+
+	t.Run("garbage", func(t *testing.T) {
+		for _, token := range []string{"", "abc", "abc.def", "."} {
+			_, err := Parse("secret", token, now)
+			assert.ErrorIs(t, err, ErrInvalid, token)
+		}
+	})
+}
+
+func TestURL(t *testing.T) {
+	assert.Equal(t, "https://booker.example/api/v1/guest/bookings/abc.def", URL("https://booker.example/", "abc.def"))
+}
@@ -0,0 +1,73 @@
+// Package holidays is a built-in table of public holidays that fall on the same
+// date every year. Days off moved by government decree and holidays with a
+// floating date are not included; import them from an iCalendar file instead
+package holidays
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Holiday is a public holiday on a calendar date
+type Holiday struct {
+	Date string // YYYY-MM-DD
+	Name string
+}
+
+type fixedHoliday struct {
+	month time.Month
+	day   int
+	name  string
+}
+
+var countries = map[string][]fixedHoliday{
+	"RU": {
+		{time.January, 1, "Новогодние каникулы"},
+		{time.January, 2, "Новогодние каникулы"},
+		{time.January, 3, "Новогодние каникулы"},
+		{time.January, 4, "Новогодние каникулы"},
+		{time.January, 5, "Новогодние каникулы"},
+		{time.January, 6, "Новогодние каникулы"},
+		{time.January, 7, "Рождество Христово"},
+		{time.January, 8, "Новогодние каникулы"},
+		{time.February, 23, "День защитника Отечества"},
+		{time.March, 8, "Международный женский день"},
+		{time.May, 1, "Праздник Весны и Труда"},
+		{time.May, 9, "День Победы"},
+		{time.June, 12, "День России"},
+		{time.November, 4, "День народного единства"},
+	},
+	"KZ": {
+		{time.January, 1, "Новый год"},
+		{time.January, 2, "Новый год"},
+		{time.March, 8, "Международный женский день"},
+		{time.March, 21, "Наурыз мейрамы"},
+		{time.March, 22, "Наурыз мейрамы"},
+		{time.March, 23, "Наурыз мейрамы"},
+		{time.May, 1, "Праздник единства народа Казахстана"},
+		{time.May, 7, "День защитника Отечества"},
+		{time.May, 9, "День Победы"},
+		{time.July, 6, "День Столицы"},
+		{time.August, 30, "День Конституции"},
+		{time.October, 25, "День Республики"},
+		{time.December, 16, "День Независимости"},
+	},
+}
+
+// ForCountry returns public holidays of a country, given as an ISO 3166-1 alpha-2 code, in a year
+func ForCountry(country string, year int) ([]Holiday, error) {
+	fixed, ok := countries[strings.ToUpper(country)]
+	if !ok {
+		return nil, fmt.Errorf("no holiday calendar for country %q", country)
+	}
+
+	holidays := make([]Holiday, len(fixed))
+	for i, h := range fixed {
+		holidays[i] = Holiday{
+			Date: time.Date(year, h.month, h.day, 0, 0, 0, 0, time.UTC).Format("2006-01-02"),
+			Name: h.name,
+		}
+	}
+	return holidays, nil
+}
@@ -0,0 +1,19 @@
+package holidays
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForCountry(t *testing.T) {
+	holidays, err := ForCountry("ru", 2026)
+	require.NoError(t, err)
+	require.NotEmpty(t, holidays)
+	assert.Equal(t, Holiday{Date: "2026-01-01", Name: "Новогодние каникулы"}, holidays[0])
+	assert.Contains(t, holidays, Holiday{Date: "2026-05-09", Name: "День Победы"})
+
+	_, err = ForCountry("XX", 2026)
+	assert.Error(t, err)
+}
@@ -0,0 +1,187 @@
+package ical
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	dateTimeFormat    = "20060102T150405"
+	utcDateTimeFormat = "20060102T150405Z"
+	maxLineOctets     = 75
+)
+
+// Calendar is a minimal RFC 5545 VCALENDAR with events in a single time zone
+type Calendar struct {
+	ProdID   string
+	Name     string
+	Location *time.Location
+	Events   []*Event
+}
+
+// Event is a single VEVENT. Start and End are rendered in the calendar's location
+type Event struct {
+	UID          string
+	Sequence     int32
+	Start        time.Time
+	End          time.Time
+	Summary      string
+	Description  string
+	Status       string // TENTATIVE, CONFIRMED or CANCELLED
+	LastModified time.Time
+	AllDay       bool // set by Parse for events with DATE values; Write ignores it
+}
+
+// Write renders the calendar to w using CRLF line endings and 75-octet line folding
+func (c *Calendar) Write(w io.Writer) error {
+	cw := &writer{w: w}
+	loc := c.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	tzid := loc.String()
+
+	cw.line("BEGIN:VCALENDAR")
+	cw.line("VERSION:2.0")
+	cw.line("PRODID:" + c.ProdID)
+	cw.line("CALSCALE:GREGORIAN")
+	cw.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		cw.line("X-WR-CALNAME:" + escapeText(c.Name))
+	}
+	cw.line("X-WR-TIMEZONE:" + tzid)
+	writeTimezone(cw, loc, c.Events)
+
+	for _, e := range c.Events {
+		stamp := e.LastModified
+		if stamp.IsZero() {
+			stamp = time.Now()
+		}
+
+		cw.line("BEGIN:VEVENT")
+		cw.line("UID:" + e.UID)
+		cw.line(fmt.Sprintf("SEQUENCE:%d", e.Sequence))
+		cw.line("DTSTAMP:" + stamp.UTC().Format(utcDateTimeFormat))
+		cw.line("LAST-MODIFIED:" + stamp.UTC().Format(utcDateTimeFormat))
+		cw.line(fmt.Sprintf("DTSTART;TZID=%s:%s", tzid, e.Start.In(loc).Format(dateTimeFormat)))
+		cw.line(fmt.Sprintf("DTEND;TZID=%s:%s", tzid, e.End.In(loc).Format(dateTimeFormat)))
+		cw.line("SUMMARY:" + escapeText(e.Summary))
+		if e.Description != "" {
+			cw.line("DESCRIPTION:" + escapeText(e.Description))
+		}
+		if e.Status != "" {
+			cw.line("STATUS:" + e.Status)
+		}
+		cw.line("END:VEVENT")
+	}
+
+	cw.line("END:VCALENDAR")
+	return cw.err
+}
+
+// writeTimezone emits a VTIMEZONE for loc, as RFC 5545 requires for every TZID
+// used. Instead of recurrence rules it lists the actual offset changes around the
+// events, a year either side, with the observance in effect before the first
+func writeTimezone(cw *writer, loc *time.Location, events []*Event) {
+	from, to := timezoneRange(events)
+	from = from.In(loc)
+
+	cw.line("BEGIN:VTIMEZONE")
+	cw.line("TZID:" + loc.String())
+
+	name, offset := from.Zone()
+	start, end := from.ZoneBounds()
+	if start.IsZero() {
+		start = time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+	writeObservance(cw, start, offset, offset, name, from.IsDST())
+
+	for !end.IsZero() && end.Before(to) {
+		t := end.In(loc)
+		name, next := t.Zone()
+		writeObservance(cw, t, offset, next, name, t.IsDST())
+		offset = next
+		_, end = t.ZoneBounds()
+	}
+
+	cw.line("END:VTIMEZONE")
+}
+
+// timezoneRange returns the span the VTIMEZONE of the events has to cover
+func timezoneRange(events []*Event) (time.Time, time.Time) {
+	from, to := time.Now(), time.Now()
+	for _, e := range events {
+		if e.Start.Before(from) {
+			from = e.Start
+		}
+		if e.End.After(to) {
+			to = e.End
+		}
+	}
+	return from.AddDate(-1, 0, 0), to.AddDate(1, 0, 0)
+}
+
+// writeObservance writes a STANDARD or DAYLIGHT component starting at t, whose
+// DTSTART is the local time under the offset in effect before it
+func writeObservance(cw *writer, t time.Time, offsetFrom, offsetTo int, name string, daylight bool) {
+	kind := "STANDARD"
+	if daylight {
+		kind = "DAYLIGHT"
+	}
+	cw.line("BEGIN:" + kind)
+	cw.line("DTSTART:" + t.UTC().Add(time.Duration(offsetFrom)*time.Second).Format(dateTimeFormat))
+	cw.line("TZOFFSETFROM:" + formatOffset(offsetFrom))
+	cw.line("TZOFFSETTO:" + formatOffset(offsetTo))
+	cw.line("TZNAME:" + name)
+	cw.line("END:" + kind)
+}
+
+func formatOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign = '-'
+		seconds = -seconds
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+func escapeText(s string) string {
+	r := strings.NewReplacer(
+		`\`, `\\`,
+		`;`, `\;`,
+		`,`, `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	)
+	return r.Replace(s)
+}
+
+type writer struct {
+	w   io.Writer
+	err error
+}
+
+// line writes a content line, folding it so that no physical line exceeds
+// 75 octets without splitting multi-byte UTF-8 characters
+func (cw *writer) line(s string) {
+	if cw.err != nil {
+		return
+	}
+
+	var b strings.Builder
+	lineLen := 0
+	for _, r := range s {
+		size := len(string(r))
+		if lineLen+size > maxLineOctets {
+			b.WriteString("\r\n ")
+			lineLen = 1
+		}
+		b.WriteRune(r)
+		lineLen += size
+	}
+	b.WriteString("\r\n")
+
+	_, cw.err = io.WriteString(cw.w, b.String())
+}
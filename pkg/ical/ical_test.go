@@ -0,0 +1,150 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendar_Write(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Moscow")
+	require.NoError(t, err)
+
+	cal := &Calendar{
+		ProdID:   "-//Booker//Test//EN",
+		Name:     "Test Restaurant",
+		Location: loc,
+		Events: []*Event{
+			{
+				UID:          "booking-1@booker",
+				Sequence:     2,
+				Start:        time.Date(2024, 1, 15, 19, 0, 0, 0, loc),
+				End:          time.Date(2024, 1, 15, 21, 0, 0, 0, loc),
+				Summary:      "Ivanov, 4 guests",
+				Description:  "Window seat; birthday\nCake at 20:00",
+				Status:       "CONFIRMED",
+				LastModified: time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, cal.Write(&buf))
+	out := buf.String()
+
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\n"))
+	assert.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+	assert.Contains(t, out, "TZID:Europe/Moscow\r\n")
+	assert.Contains(t, out, "TZOFFSETTO:+0300\r\n")
+	assert.Contains(t, out, "UID:booking-1@booker\r\n")
+	assert.Contains(t, out, "SEQUENCE:2\r\n")
+	assert.Contains(t, out, "DTSTART;TZID=Europe/Moscow:20240115T190000\r\n")
+	assert.Contains(t, out, "DTEND;TZID=Europe/Moscow:20240115T210000\r\n")
+	assert.Contains(t, out, "SUMMARY:Ivanov\\, 4 guests\r\n")
+	assert.Contains(t, out, "DESCRIPTION:Window seat\\; birthday\\nCake at 20:00\r\n")
+	assert.Contains(t, out, "DTSTAMP:20240110T120000Z\r\n")
+}
+
+func TestCalendar_WriteTimezoneWithDST(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	cal := &Calendar{ProdID: "test", Location: loc, Events: []*Event{{
+		UID:   "booking-1@booker",
+		Start: time.Date(2024, 7, 1, 19, 0, 0, 0, loc),
+		End:   time.Date(2024, 7, 1, 21, 0, 0, 0, loc),
+	}}}
+	var buf bytes.Buffer
+	require.NoError(t, cal.Write(&buf))
+	out := buf.String()
+
+	assert.Contains(t, out, "X-WR-TIMEZONE:Europe/Berlin\r\n")
+	assert.Contains(t, out, "BEGIN:VTIMEZONE\r\nTZID:Europe/Berlin\r\n")
+	assert.Contains(t, out, "DTSTART;TZID=Europe/Berlin:20240701T190000\r\n")
+	// Summer time of the event's year: 02:00 CET becomes 03:00 CEST
+	assert.Contains(t, out, "BEGIN:DAYLIGHT\r\n"+
+		"DTSTART:20240331T020000\r\n"+
+		"TZOFFSETFROM:+0100\r\n"+
+		"TZOFFSETTO:+0200\r\n"+
+		"TZNAME:CEST\r\n"+
+		"END:DAYLIGHT\r\n")
+	// and back at 03:00 CEST
+	assert.Contains(t, out, "BEGIN:STANDARD\r\n"+
+		"DTSTART:20241027T030000\r\n"+
+		"TZOFFSETFROM:+0200\r\n"+
+		"TZOFFSETTO:+0100\r\n"+
+		"TZNAME:CET\r\n"+
+		"END:STANDARD\r\n")
+}
+
+func TestCalendar_WriteTimezoneWithoutDST(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, (&Calendar{ProdID: "test", Location: time.UTC}).Write(&buf))
+
+	assert.Contains(t, buf.String(), "BEGIN:VTIMEZONE\r\n"+
+		"TZID:UTC\r\n"+
+		"BEGIN:STANDARD\r\n"+
+		"DTSTART:19700101T000000\r\n"+
+		"TZOFFSETFROM:+0000\r\n"+
+		"TZOFFSETTO:+0000\r\n"+
+		"TZNAME:UTC\r\n"+
+		"END:STANDARD\r\n"+
+		"END:VTIMEZONE\r\n")
+}
+
+func TestWriter_LineFolding(t *testing.T) {
+	var buf bytes.Buffer
+	cw := &writer{w: &buf}
+	cw.line("SUMMARY:" + strings.Repeat("Щ", 60))
+	require.NoError(t, cw.err)
+
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), maxLineOctets)
+	}
+	unfolded := strings.ReplaceAll(buf.String(), "\r\n ", "")
+	assert.Equal(t, "SUMMARY:"+strings.Repeat("Щ", 60)+"\r\n", unfolded)
+}
+
+func TestParse(t *testing.T) {
+	data := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:ny@example\r\n" +
+		"DTSTART;VALUE=DATE:20260101\r\n" +
+		"DTEND;VALUE=DATE:20260103\r\n" +
+		"SUMMARY:New Year\\, holidays\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART;TZID=\"Europe/Moscow\":20260509T180000\r\n" +
+		"DTEND:20260509T200000Z\r\n" +
+		"SUMMARY:Victory\r\n" +
+		"  Day\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	events, err := Parse(strings.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	assert.True(t, events[0].AllDay)
+	assert.Equal(t, "New Year, holidays", events[0].Summary)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), events[0].Start)
+	assert.Equal(t, time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC), events[0].End)
+
+	assert.False(t, events[1].AllDay)
+	assert.Equal(t, "Victory Day", events[1].Summary)
+	assert.Equal(t, "Europe/Moscow", events[1].Start.Location().String())
+	assert.Equal(t, 18, events[1].Start.Hour())
+}
+
+func TestParse_Malformed(t *testing.T) {
+	_, err := Parse(strings.NewReader("BEGIN:VEVENT\r\nSUMMARY:No start\r\nEND:VEVENT\r\n"))
+	assert.Error(t, err)
+
+	_, err = Parse(strings.NewReader("BEGIN:VEVENT\r\nDTSTART:2026-01-01\r\nEND:VEVENT\r\n"))
+	assert.Error(t, err)
+}
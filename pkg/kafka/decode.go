@@ -0,0 +1,114 @@
+package kafka
+
+import (
+	"encoding/json"
+	"fmt"
+
+	commonpb "booker/pkg/proto/common"
+)
+
+// bookingEventMessage mirrors the JSON PublishBookingEvent writes: encoding/json
+// puts the oneof payload under its Go field names
+type bookingEventMessage struct {
+	Headers       *commonpb.EventHeaders `json:"headers"`
+	BookingID     string                 `json:"booking_id"`
+	Table         *commonpb.TableRef     `json:"table"`
+	Slot          *commonpb.Slot         `json:"slot"`
+	PartySize     int32                  `json:"party_size"`
+	CustomerName  string                 `json:"customer_name"`
+	CustomerPhone string                 `json:"customer_phone"`
+	Payload       struct {
+		Requested *commonpb.BookingRequested
+		Held      *commonpb.BookingHeld
+		Confirmed *commonpb.BookingConfirmed
+		Cancelled *commonpb.BookingCancelled
+		Expired   *commonpb.BookingExpired
+		Seated    *commonpb.BookingSeated
+		Finished  *commonpb.BookingFinished
+		NoShow    *commonpb.BookingNoShow
+		Rejected  *commonpb.BookingRejected
+		Moved     *commonpb.BookingMoved
+	}
+}
+
+// DecodeBookingEvent decodes a message written by PublishBookingEvent
+func DecodeBookingEvent(data []byte) (*commonpb.BookingEvent, error) {
+	var msg bookingEventMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, fmt.Errorf("failed to decode booking event: %w", err)
+	}
+
+	event := &commonpb.BookingEvent{
+		Headers:       msg.Headers,
+		BookingId:     msg.BookingID,
+		Table:         msg.Table,
+		Slot:          msg.Slot,
+		PartySize:     msg.PartySize,
+		CustomerName:  msg.CustomerName,
+		CustomerPhone: msg.CustomerPhone,
+	}
+	p := msg.Payload
+	switch {
+	case p.Requested != nil:
+		event.Payload = &commonpb.BookingEvent_Requested{Requested: p.Requested}
+	case p.Held != nil:
+		event.Payload = &commonpb.BookingEvent_Held{Held: p.Held}
+	case p.Confirmed != nil:
+		event.Payload = &commonpb.BookingEvent_Confirmed{Confirmed: p.Confirmed}
+	case p.Cancelled != nil:
+		event.Payload = &commonpb.BookingEvent_Cancelled{Cancelled: p.Cancelled}
+	case p.Expired != nil:
+		event.Payload = &commonpb.BookingEvent_Expired{Expired: p.Expired}
+	case p.Seated != nil:
+		event.Payload = &commonpb.BookingEvent_Seated{Seated: p.Seated}
+	case p.Finished != nil:
+		event.Payload = &commonpb.BookingEvent_Finished{Finished: p.Finished}
+	case p.NoShow != nil:
+		event.Payload = &commonpb.BookingEvent_NoShow{NoShow: p.NoShow}
+	case p.Rejected != nil:
+		event.Payload = &commonpb.BookingEvent_Rejected{Rejected: p.Rejected}
+	case p.Moved != nil:
+		event.Payload = &commonpb.BookingEvent_Moved{Moved: p.Moved}
+	}
+	return event, nil
+}
+
+// venueEventMessage mirrors the JSON PublishVenueEvent writes, see bookingEventMessage
+type venueEventMessage struct {
+	Headers *commonpb.EventHeaders `json:"headers"`
+	VenueID string                 `json:"venue_id"`
+	Payload struct {
+		LayoutUpdated   *commonpb.TableLayoutUpdated
+		ScheduleUpdated *commonpb.VenueScheduleUpdated
+	}
+}
+
+// DecodeVenueEvent decodes a message written by PublishVenueEvent
+func DecodeVenueEvent(data []byte) (*commonpb.VenueEvent, error) {
+	var msg venueEventMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, fmt.Errorf("failed to decode venue event: %w", err)
+	}
+
+	event := &commonpb.VenueEvent{
+		Headers: msg.Headers,
+		VenueId: msg.VenueID,
+	}
+	p := msg.Payload
+	switch {
+	case p.LayoutUpdated != nil:
+		event.Payload = &commonpb.VenueEvent_LayoutUpdated{LayoutUpdated: p.LayoutUpdated}
+	case p.ScheduleUpdated != nil:
+		event.Payload = &commonpb.VenueEvent_ScheduleUpdated{ScheduleUpdated: p.ScheduleUpdated}
+	}
+	return event, nil
+}
+
+// DecodeRoomHireEvent decodes a message written by PublishRoomHireEvent
+func DecodeRoomHireEvent(data []byte) (*commonpb.RoomHireEvent, error) {
+	var event commonpb.RoomHireEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, fmt.Errorf("failed to decode room hire event: %w", err)
+	}
+	return &event, nil
+}
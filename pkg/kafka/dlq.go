@@ -0,0 +1,176 @@
+package kafka
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// dlqReadTimeout is how long List waits for the next message of a partition
+const dlqReadTimeout = 5 * time.Second
+
+// DeadLetter is a message every retry failed on
+type DeadLetter struct {
+	Topic             string
+	Partition         int32
+	Offset            int64
+	Key               string
+	Value             []byte
+	OriginalTopic     string
+	OriginalPartition int32
+	OriginalOffset    int64
+	ConsumerGroup     string
+	Attempts          int
+	Error             string
+	FailedAt          time.Time
+	Headers           map[string]string
+}
+
+// DeadLetters reads dead letter topics and replays their messages
+type DeadLetters struct {
+	client   sarama.Client
+	producer sarama.SyncProducer
+	stages   []RetryStage
+}
+
+// NewDeadLetters connects to the brokers. Replayed messages go to the first of
+// the stages
+func NewDeadLetters(brokers []string, stages []RetryStage) (*DeadLetters, error) {
+	if len(stages) == 0 {
+		return nil, fmt.Errorf("at least one retry stage is required")
+	}
+
+	client, err := sarama.NewClient(brokers, producerConfig())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to create producer: %w", err)
+	}
+
+	return &DeadLetters{client: client, producer: producer, stages: stages}, nil
+}
+
+// List returns every message in a dead letter topic, oldest first in each partition.
+// The topic may also be given as the original one
+func (d *DeadLetters) List(topic string) ([]*DeadLetter, error) {
+	if !strings.HasSuffix(topic, "."+deadLetterSuffix) {
+		topic = DeadLetterTopic(topic)
+	}
+
+	partitions, err := d.client.Partitions(topic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get partitions of %s: %w", topic, err)
+	}
+
+	consumer, err := sarama.NewConsumerFromClient(d.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consumer: %w", err)
+	}
+	defer consumer.Close()
+
+	var letters []*DeadLetter
+	for _, partition := range partitions {
+		oldest, err := d.client.GetOffset(topic, partition, sarama.OffsetOldest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get offsets of %s/%d: %w", topic, partition, err)
+		}
+		newest, err := d.client.GetOffset(topic, partition, sarama.OffsetNewest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get offsets of %s/%d: %w", topic, partition, err)
+		}
+		if oldest >= newest {
+			continue
+		}
+
+		pc, err := consumer.ConsumePartition(topic, partition, oldest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s/%d: %w", topic, partition, err)
+		}
+	read:
+		for {
+			select {
+			case message := <-pc.Messages():
+				letters = append(letters, deadLetter(message))
+				if message.Offset >= newest-1 {
+					break read
+				}
+			case <-time.After(dlqReadTimeout):
+				// The last offsets hold no message, e.g. transaction markers
+				break read
+			}
+		}
+		pc.Close()
+	}
+	return letters, nil
+}
+
+// Replay publishes a dead letter to the first retry topic of its original topic,
+// due at once. Only the consumer group that failed on it handles it again
+func (d *DeadLetters) Replay(letter *DeadLetter) (string, error) {
+	if letter.OriginalTopic == "" || letter.ConsumerGroup == "" {
+		return "", fmt.Errorf("message %s/%d/%d has no origin to replay to", letter.Topic, letter.Partition, letter.Offset)
+	}
+
+	var headers []sarama.RecordHeader
+	for key, value := range letter.Headers {
+		if !isRetryHeader(key) {
+			headers = append(headers, recordHeader(key, value))
+		}
+	}
+	headers = append(headers,
+		recordHeader(HeaderOriginalTopic, letter.OriginalTopic),
+		recordHeader(HeaderOriginalPartition, strconv.Itoa(int(letter.OriginalPartition))),
+		recordHeader(HeaderOriginalOffset, strconv.FormatInt(letter.OriginalOffset, 10)),
+		recordHeader(HeaderConsumerGroup, letter.ConsumerGroup),
+		recordHeader(HeaderRetryAt, strconv.FormatInt(time.Now().Unix(), 10)),
+	)
+
+	topic := letter.OriginalTopic + "." + d.stages[0].Suffix
+	_, _, err := d.producer.SendMessage(&sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     sarama.StringEncoder(letter.Key),
+		Value:   sarama.ByteEncoder(letter.Value),
+		Headers: headers,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to replay message: %w", err)
+	}
+	return topic, nil
+}
+
+func (d *DeadLetters) Close() error {
+	d.producer.Close()
+	return d.client.Close()
+}
+
+func deadLetter(message *sarama.ConsumerMessage) *DeadLetter {
+	letter := &DeadLetter{
+		Topic:         message.Topic,
+		Partition:     message.Partition,
+		Offset:        message.Offset,
+		Key:           string(message.Key),
+		Value:         message.Value,
+		OriginalTopic: header(message, HeaderOriginalTopic),
+		ConsumerGroup: header(message, HeaderConsumerGroup),
+		Error:         header(message, HeaderError),
+		Headers:       make(map[string]string, len(message.Headers)),
+	}
+	if partition, err := strconv.ParseInt(header(message, HeaderOriginalPartition), 10, 32); err == nil {
+		letter.OriginalPartition = int32(partition)
+	}
+	letter.OriginalOffset, _ = strconv.ParseInt(header(message, HeaderOriginalOffset), 10, 64)
+	letter.Attempts, _ = strconv.Atoi(header(message, HeaderAttempts))
+	letter.FailedAt, _ = time.Parse(time.RFC3339, header(message, HeaderFailedAt))
+	for _, rh := range message.Headers {
+		if rh != nil {
+			letter.Headers[string(rh.Key)] = string(rh.Value)
+		}
+	}
+	return letter
+}
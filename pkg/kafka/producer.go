@@ -19,12 +19,7 @@ type Producer struct {
 }
 
 func NewProducer(brokers []string) (*Producer, error) {
-	config := sarama.NewConfig()
-	config.Producer.Return.Successes = true
-	config.Producer.RequiredAcks = sarama.WaitForAll
-	config.Producer.Retry.Max = 5
-
-	producer, err := sarama.NewSyncProducer(brokers, config)
+	producer, err := sarama.NewSyncProducer(brokers, producerConfig())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create producer: %w", err)
 	}
@@ -32,6 +27,14 @@ func NewProducer(brokers []string) (*Producer, error) {
 	return &Producer{producer: producer}, nil
 }
 
+func producerConfig() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Retry.Max = 5
+	return config
+}
+
 func (p *Producer) PublishBookingEvent(ctx context.Context, topic string, event *commonpb.BookingEvent) error {
 	span := trace.SpanFromContext(ctx)
 	traceID := span.SpanContext().TraceID().String()
@@ -42,6 +45,9 @@ func (p *Producer) PublishBookingEvent(ctx context.Context, topic string, event
 	event.Headers.TraceId = traceID
 	event.Headers.Timestamp = getCurrentTimestamp()
 	event.Headers.Source = "booking-svc"
+	if event.Headers.EventId == "" {
+		event.Headers.EventId = uuid.New().String()
+	}
 
 	data, err := json.Marshal(event)
 	if err != nil {
@@ -59,6 +65,7 @@ func (p *Producer) PublishBookingEvent(ctx context.Context, topic string, event
 		Value: sarama.ByteEncoder(data),
 		Headers: []sarama.RecordHeader{
 			{Key: []byte("trace_id"), Value: []byte(traceID)},
+			{Key: []byte("event_id"), Value: []byte(event.Headers.EventId)},
 		},
 	}
 
@@ -87,6 +94,9 @@ func (p *Producer) PublishVenueEvent(ctx context.Context, topic string, event *c
 	event.Headers.TraceId = traceID
 	event.Headers.Timestamp = getCurrentTimestamp()
 	event.Headers.Source = "venue-svc"
+	if event.Headers.EventId == "" {
+		event.Headers.EventId = uuid.New().String()
+	}
 
 	data, err := json.Marshal(event)
 	if err != nil {
@@ -104,6 +114,7 @@ func (p *Producer) PublishVenueEvent(ctx context.Context, topic string, event *c
 		Value: sarama.ByteEncoder(data),
 		Headers: []sarama.RecordHeader{
 			{Key: []byte("trace_id"), Value: []byte(traceID)},
+			{Key: []byte("event_id"), Value: []byte(event.Headers.EventId)},
 		},
 	}
 
@@ -122,6 +133,55 @@ func (p *Producer) PublishVenueEvent(ctx context.Context, topic string, event *c
 	return nil
 }
 
+func (p *Producer) PublishRoomHireEvent(ctx context.Context, topic string, event *commonpb.RoomHireEvent) error {
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+
+	if event.Headers == nil {
+		event.Headers = &commonpb.EventHeaders{}
+	}
+	event.Headers.TraceId = traceID
+	event.Headers.Timestamp = getCurrentTimestamp()
+	event.Headers.Source = "booking-svc"
+	if event.Headers.EventId == "" {
+		event.Headers.EventId = uuid.New().String()
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	key := event.RoomHireId
+	if key == "" {
+		key = uuid.New().String()
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic: topic,
+		Key:   sarama.StringEncoder(key),
+		Value: sarama.ByteEncoder(data),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte("trace_id"), Value: []byte(traceID)},
+			{Key: []byte("event_id"), Value: []byte(event.Headers.EventId)},
+		},
+	}
+
+	partition, offset, err := p.producer.SendMessage(msg)
+	if err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+
+	log.Info().
+		Str("topic", topic).
+		Int32("partition", partition).
+		Int64("offset", offset).
+		Str("room_hire_id", event.RoomHireId).
+		Msg("Published room hire event")
+
+	return nil
+}
+
 func (p *Producer) Close() error {
 	return p.producer.Close()
 }
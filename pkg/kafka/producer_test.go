@@ -1,9 +1,12 @@
 package kafka
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	commonpb "booker/pkg/proto/common"
 )
@@ -98,3 +101,59 @@ func TestProducer_Integration(t *testing.T) {
 	// require.NoError(t, err)
 }
 
+func TestDecodeBookingEvent(t *testing.T) {
+	event := &commonpb.BookingEvent{
+		Headers:   &commonpb.EventHeaders{EventId: "event-1", Source: "booking-svc"},
+		BookingId: "booking-1",
+		Slot:      &commonpb.Slot{Date: "2026-03-06", StartTime: "19:00", DurationMinutes: 120},
+		PartySize: 4,
+		Payload: &commonpb.BookingEvent_Cancelled{
+			Cancelled: &commonpb.BookingCancelled{AdminId: "admin-1", Reason: "guest called"},
+		},
+	}
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	decoded, err := DecodeBookingEvent(data)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(event, decoded))
+	assert.Equal(t, "guest called", decoded.GetCancelled().GetReason())
+
+	_, err = DecodeBookingEvent([]byte("not json"))
+	assert.Error(t, err)
+}
+
+func TestDecodeVenueEvent(t *testing.T) {
+	event := &commonpb.VenueEvent{
+		Headers: &commonpb.EventHeaders{EventId: "event-3", Source: "venue-svc"},
+		VenueId: "venue-1",
+		Payload: &commonpb.VenueEvent_LayoutUpdated{
+			LayoutUpdated: &commonpb.TableLayoutUpdated{RoomId: "room-1", TableIds: []string{"t1", "t2"}, LayoutVersion: 3},
+		},
+	}
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	decoded, err := DecodeVenueEvent(data)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(event, decoded))
+	assert.Equal(t, []string{"t1", "t2"}, decoded.GetLayoutUpdated().GetTableIds())
+
+	_, err = DecodeVenueEvent([]byte("not json"))
+	assert.Error(t, err)
+}
+
+func TestDecodeRoomHireEvent(t *testing.T) {
+	event := &commonpb.RoomHireEvent{
+		Headers:    &commonpb.EventHeaders{EventId: "event-2"},
+		RoomHireId: "hire-1",
+		Status:     "cancelled",
+		Reason:     "venue closed",
+	}
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	decoded, err := DecodeRoomHireEvent(data)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(event, decoded))
+}
@@ -0,0 +1,284 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/rs/zerolog/log"
+)
+
+// HandlerFunc handles one message. Messages that come back from a retry topic
+// are passed with the topic, partition and offset they were first published at
+type HandlerFunc func(ctx context.Context, message *sarama.ConsumerMessage) error
+
+// RetryStage is a topic failed messages wait in before they are handled again
+type RetryStage struct {
+	Suffix string // appended to the original topic, e.g. booking.confirmed.retry.1m
+	Delay  time.Duration
+}
+
+// DefaultRetryStages retry a failed message after a minute and then after ten
+// more; if both fail it goes to the dead letter topic
+var DefaultRetryStages = []RetryStage{
+	{Suffix: "retry.1m", Delay: time.Minute},
+	{Suffix: "retry.10m", Delay: 10 * time.Minute},
+}
+
+const deadLetterSuffix = "dlq"
+
+// Headers of messages in retry and dead letter topics
+const (
+	HeaderOriginalTopic     = "original_topic"
+	HeaderOriginalPartition = "original_partition"
+	HeaderOriginalOffset    = "original_offset"
+	HeaderConsumerGroup     = "consumer_group"
+	HeaderAttempts          = "attempts"
+	HeaderError             = "error"
+	HeaderFailedAt          = "failed_at"
+	HeaderRetryAt           = "retry_at"
+)
+
+var retryHeaders = []string{
+	HeaderOriginalTopic, HeaderOriginalPartition, HeaderOriginalOffset, HeaderConsumerGroup,
+	HeaderAttempts, HeaderError, HeaderFailedAt, HeaderRetryAt,
+}
+
+const publishRetryDelay = 5 * time.Second
+
+// DeadLetterTopic returns the topic messages of a topic end up in when every
+// retry failed
+func DeadLetterTopic(topic string) string {
+	return topic + "." + deadLetterSuffix
+}
+
+// RetryTopics returns the retry topics of the topics, which a consumer using
+// RetryHandler has to subscribe to along with the topics themselves
+func RetryTopics(topics []string, stages []RetryStage) []string {
+	var retry []string
+	for _, topic := range topics {
+		for _, stage := range stages {
+			retry = append(retry, topic+"."+stage.Suffix)
+		}
+	}
+	return retry
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks an error retrying cannot fix, such as a message that cannot be
+// decoded; the message goes straight to the dead letter topic
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// RetryHandler is a consumer group handler that passes messages to a HandlerFunc
+// and marks them consumed either way. A message that failed is published to the
+// next retry topic, where it waits out the stage's delay, and after the last stage
+// to the dead letter topic, with the error and where it came from in its headers.
+// Retried messages leave the order of their partition. Retry topics are shared by
+// the consumer groups of a topic, each only picks up the messages it failed on
+type RetryHandler struct {
+	group    string
+	stages   []RetryStage
+	handle   HandlerFunc
+	producer sarama.SyncProducer
+}
+
+func NewRetryHandler(brokers []string, group string, stages []RetryStage, handle HandlerFunc) (*RetryHandler, error) {
+	producer, err := sarama.NewSyncProducer(brokers, producerConfig())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create retry producer: %w", err)
+	}
+
+	return &RetryHandler{group: group, stages: stages, handle: handle, producer: producer}, nil
+}
+
+func (h *RetryHandler) Setup(sarama.ConsumerGroupSession) error   { return nil }
+func (h *RetryHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *RetryHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ctx := session.Context()
+	for message := range claim.Messages() {
+		stage := h.stageOf(message.Topic)
+		if stage >= 0 {
+			if header(message, HeaderConsumerGroup) != h.group {
+				session.MarkMessage(message, "")
+				continue
+			}
+			if !sleepUntil(ctx, retryAt(message)) {
+				return nil
+			}
+		}
+
+		original := originalMessage(message)
+		err := h.handle(ctx, original)
+		if err != nil && ctx.Err() != nil {
+			// The session is over; the message is delivered again to whoever gets the partition
+			return nil
+		}
+		if err != nil && !h.forward(ctx, original, message, stage, err) {
+			return nil
+		}
+		session.MarkMessage(message, "")
+	}
+	return nil
+}
+
+// Close closes the producer of retried messages
+func (h *RetryHandler) Close() error {
+	return h.producer.Close()
+}
+
+// stageOf returns the index of the retry stage a topic belongs to, -1 for an
+// original topic
+func (h *RetryHandler) stageOf(topic string) int {
+	for i, stage := range h.stages {
+		if strings.HasSuffix(topic, "."+stage.Suffix) {
+			return i
+		}
+	}
+	return -1
+}
+
+// forward publishes a failed message to the topic after the stage it failed in,
+// retrying until it succeeds or ctx is done
+func (h *RetryHandler) forward(ctx context.Context, original, message *sarama.ConsumerMessage, stage int, handleErr error) bool {
+	msg := h.retryMessage(original, message, stage, handleErr, time.Now())
+
+	logEvent := log.Warn()
+	if strings.HasSuffix(msg.Topic, "."+deadLetterSuffix) {
+		logEvent = log.Error()
+	}
+	logEvent.Err(handleErr).
+		Str("topic", original.Topic).
+		Str("key", string(original.Key)).
+		Str("to", msg.Topic).
+		Msg("Failed to handle message, forwarding")
+
+	for {
+		_, _, err := h.producer.SendMessage(msg)
+		if err == nil {
+			return true
+		}
+		log.Error().Err(err).Str("topic", msg.Topic).Msg("Failed to forward message, retrying")
+		if !sleepUntil(ctx, time.Now().Add(publishRetryDelay)) {
+			return false
+		}
+	}
+}
+
+// retryMessage builds the message a failed one is forwarded as: the next retry
+// topic, or the dead letter topic if retries are used up or the error is permanent
+func (h *RetryHandler) retryMessage(original, message *sarama.ConsumerMessage, stage int, handleErr error, now time.Time) *sarama.ProducerMessage {
+	next := stage + 1
+	var permanent *permanentError
+	if errors.As(handleErr, &permanent) {
+		next = len(h.stages)
+	}
+
+	topic := DeadLetterTopic(original.Topic)
+	var wait time.Duration
+	if next < len(h.stages) {
+		topic = original.Topic + "." + h.stages[next].Suffix
+		wait = h.stages[next].Delay
+	}
+
+	var headers []sarama.RecordHeader
+	for _, rh := range message.Headers {
+		if rh != nil && !isRetryHeader(string(rh.Key)) {
+			headers = append(headers, *rh)
+		}
+	}
+	headers = append(headers,
+		recordHeader(HeaderOriginalTopic, original.Topic),
+		recordHeader(HeaderOriginalPartition, strconv.Itoa(int(original.Partition))),
+		recordHeader(HeaderOriginalOffset, strconv.FormatInt(original.Offset, 10)),
+		recordHeader(HeaderConsumerGroup, h.group),
+		recordHeader(HeaderAttempts, strconv.Itoa(stage+2)),
+		recordHeader(HeaderError, handleErr.Error()),
+		recordHeader(HeaderFailedAt, now.UTC().Format(time.RFC3339)),
+		recordHeader(HeaderRetryAt, strconv.FormatInt(now.Add(wait).Unix(), 10)),
+	)
+
+	return &sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     sarama.ByteEncoder(original.Key),
+		Value:   sarama.ByteEncoder(original.Value),
+		Headers: headers,
+	}
+}
+
+// originalMessage returns a message from a retry topic as it was first consumed
+func originalMessage(message *sarama.ConsumerMessage) *sarama.ConsumerMessage {
+	topic := header(message, HeaderOriginalTopic)
+	if topic == "" {
+		return message
+	}
+
+	original := *message
+	original.Topic = topic
+	if partition, err := strconv.ParseInt(header(message, HeaderOriginalPartition), 10, 32); err == nil {
+		original.Partition = int32(partition)
+	}
+	if offset, err := strconv.ParseInt(header(message, HeaderOriginalOffset), 10, 64); err == nil {
+		original.Offset = offset
+	}
+	return &original
+}
+
+// retryAt returns when a retried message is due, now if it does not say
+func retryAt(message *sarama.ConsumerMessage) time.Time {
+	at, err := strconv.ParseInt(header(message, HeaderRetryAt), 10, 64)
+	if err != nil {
+		return time.Now()
+	}
+	return time.Unix(at, 0)
+}
+
+// sleepUntil waits until t, returning false if ctx is done first
+func sleepUntil(ctx context.Context, t time.Time) bool {
+	wait := time.Until(t)
+	if wait <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func header(message *sarama.ConsumerMessage, key string) string {
+	for _, rh := range message.Headers {
+		if rh != nil && string(rh.Key) == key {
+			return string(rh.Value)
+		}
+	}
+	return ""
+}
+
+func isRetryHeader(key string) bool {
+	for _, h := range retryHeaders {
+		if h == key {
+			return true
+		}
+	}
+	return false
+}
+
+func recordHeader(key, value string) sarama.RecordHeader {
+	return sarama.RecordHeader{Key: []byte(key), Value: []byte(value)}
+}
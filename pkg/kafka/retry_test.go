@@ -0,0 +1,111 @@
+package kafka
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryTopics(t *testing.T) {
+	assert.Equal(t, []string{
+		"booking.confirmed.retry.1m", "booking.confirmed.retry.10m",
+		"booking.cancelled.retry.1m", "booking.cancelled.retry.10m",
+	}, RetryTopics([]string{"booking.confirmed", "booking.cancelled"}, DefaultRetryStages))
+	assert.Equal(t, "booking.confirmed.dlq", DeadLetterTopic("booking.confirmed"))
+}
+
+func TestRetryMessage(t *testing.T) {
+	h := &RetryHandler{group: "notify-svc-group", stages: DefaultRetryStages}
+	now := time.Unix(1700000000, 0)
+	message := &sarama.ConsumerMessage{
+		Topic:     "booking.confirmed",
+		Partition: 2,
+		Offset:    41,
+		Key:       []byte("booking-1"),
+		Value:     []byte(`{"booking_id":"booking-1"}`),
+		Headers:   []*sarama.RecordHeader{{Key: []byte("trace_id"), Value: []byte("trace-1")}},
+	}
+
+	t.Run("first failure goes to the first stage", func(t *testing.T) {
+		msg := h.retryMessage(message, message, -1, errors.New("sms gateway down"), now)
+
+		assert.Equal(t, "booking.confirmed.retry.1m", msg.Topic)
+		headers := producerHeaders(msg)
+		assert.Equal(t, "trace-1", headers["trace_id"])
+		assert.Equal(t, "booking.confirmed", headers[HeaderOriginalTopic])
+		assert.Equal(t, "2", headers[HeaderOriginalPartition])
+		assert.Equal(t, "41", headers[HeaderOriginalOffset])
+		assert.Equal(t, "notify-svc-group", headers[HeaderConsumerGroup])
+		assert.Equal(t, "1", headers[HeaderAttempts])
+		assert.Equal(t, "sms gateway down", headers[HeaderError])
+		assert.Equal(t, "1700000060", headers[HeaderRetryAt])
+	})
+
+	t.Run("retried message keeps its origin", func(t *testing.T) {
+		first := h.retryMessage(message, message, -1, errors.New("sms gateway down"), now)
+		retried := consumed(first, "booking.confirmed.retry.1m")
+		original := originalMessage(retried)
+		require.Equal(t, "booking.confirmed", original.Topic)
+		assert.Equal(t, int32(2), original.Partition)
+		assert.Equal(t, int64(41), original.Offset)
+
+		msg := h.retryMessage(original, retried, 0, errors.New("still down"), now)
+
+		assert.Equal(t, "booking.confirmed.retry.10m", msg.Topic)
+		headers := producerHeaders(msg)
+		assert.Equal(t, "2", headers[HeaderAttempts])
+		assert.Equal(t, "still down", headers[HeaderError])
+		assert.Len(t, msg.Headers, 9, "retry headers are replaced, not added again")
+	})
+
+	t.Run("last stage goes to the dead letter topic", func(t *testing.T) {
+		msg := h.retryMessage(message, message, len(DefaultRetryStages)-1, errors.New("still down"), now)
+		assert.Equal(t, "booking.confirmed.dlq", msg.Topic)
+	})
+
+	t.Run("permanent error skips retries", func(t *testing.T) {
+		msg := h.retryMessage(message, message, -1, Permanent(errors.New("bad payload")), now)
+		assert.Equal(t, "booking.confirmed.dlq", msg.Topic)
+		assert.Equal(t, "bad payload", producerHeaders(msg)[HeaderError])
+	})
+}
+
+func TestDeadLetter(t *testing.T) {
+	h := &RetryHandler{group: "notify-svc-group", stages: DefaultRetryStages}
+	original := &sarama.ConsumerMessage{Topic: "booking.moved", Partition: 1, Offset: 7, Key: []byte("booking-2")}
+	msg := h.retryMessage(original, original, 1, errors.New("venue-svc unavailable"), time.Unix(1700000000, 0))
+
+	letter := deadLetter(consumed(msg, msg.Topic))
+
+	assert.Equal(t, "booking.moved.dlq", letter.Topic)
+	assert.Equal(t, "booking.moved", letter.OriginalTopic)
+	assert.Equal(t, int32(1), letter.OriginalPartition)
+	assert.Equal(t, int64(7), letter.OriginalOffset)
+	assert.Equal(t, "notify-svc-group", letter.ConsumerGroup)
+	assert.Equal(t, 3, letter.Attempts)
+	assert.Equal(t, "venue-svc unavailable", letter.Error)
+	assert.Equal(t, time.Unix(1700000000, 0).UTC(), letter.FailedAt)
+}
+
+func producerHeaders(msg *sarama.ProducerMessage) map[string]string {
+	headers := make(map[string]string, len(msg.Headers))
+	for _, h := range msg.Headers {
+		headers[string(h.Key)] = string(h.Value)
+	}
+	return headers
+}
+
+// consumed returns a produced message as a consumer would see it
+func consumed(msg *sarama.ProducerMessage, topic string) *sarama.ConsumerMessage {
+	key, _ := msg.Key.Encode()
+	value, _ := msg.Value.Encode()
+	message := &sarama.ConsumerMessage{Topic: topic, Key: key, Value: value}
+	for _, h := range msg.Headers {
+		message.Headers = append(message.Headers, &sarama.RecordHeader{Key: h.Key, Value: h.Value})
+	}
+	return message
+}
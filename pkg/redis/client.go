@@ -25,6 +25,28 @@ func (c *Client) SetHold(ctx context.Context, key string, bookingID string, ttl
 	return c.SetNX(ctx, key, bookingID, ttl).Result()
 }
 
+// setHoldsScript sets every key to the booking ID, or none of them if one is taken
+var setHoldsScript = redis.NewScript(`
+for _, key in ipairs(KEYS) do
+	if redis.call("EXISTS", key) == 1 then
+		return 0
+	end
+end
+for _, key in ipairs(KEYS) do
+	redis.call("SET", key, ARGV[1], "PX", ARGV[2])
+end
+return 1
+`)
+
+// SetHolds takes all keys at once; it returns false without taking any if one is already held
+func (c *Client) SetHolds(ctx context.Context, keys []string, bookingID string, ttl time.Duration) (bool, error) {
+	taken, err := setHoldsScript.Run(ctx, c.Client, keys, bookingID, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return taken == 1, nil
+}
+
 func (c *Client) GetHold(ctx context.Context, key string) (string, error) {
 	return c.Get(ctx, key).Result()
 }
@@ -33,6 +55,13 @@ func (c *Client) DeleteHold(ctx context.Context, key string) error {
 	return c.Del(ctx, key).Err()
 }
 
+func (c *Client) DeleteHolds(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return c.Del(ctx, keys...).Err()
+}
+
 func (c *Client) Incr(ctx context.Context, key string) (int64, error) {
 	return c.Client.Incr(ctx, key).Result()
 }
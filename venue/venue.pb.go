@@ -30,6 +30,8 @@ type Venue struct {
 	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
 	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	BufferMinutes int32                  `protobuf:"varint,7,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"` // время на подготовку стола после брони
+	DeletedAt     int64                  `protobuf:"varint,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`             // 0 - не удалено
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -106,13 +108,33 @@ func (x *Venue) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *Venue) GetBufferMinutes() int32 {
+	if x != nil {
+		return x.BufferMinutes
+	}
+	return 0
+}
+
+func (x *Venue) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
 type Room struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	VenueId       string                 `protobuf:"bytes,2,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VenueId   string                 `protobuf:"bytes,2,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Размеры плана зала в тех же единицах, что и координаты столов
+	Width         float64 `protobuf:"fixed64,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height        float64 `protobuf:"fixed64,7,opt,name=height,proto3" json:"height,omitempty"`
+	BackgroundUrl string  `protobuf:"bytes,8,opt,name=background_url,json=backgroundUrl,proto3" json:"background_url,omitempty"`
+	LayoutVersion int32   `protobuf:"varint,9,opt,name=layout_version,json=layoutVersion,proto3" json:"layout_version,omitempty"` // увеличивается при каждом изменении плана
+	DeletedAt     int64   `protobuf:"varint,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`            // 0 - не удалено
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -182,16 +204,60 @@ func (x *Room) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *Room) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Room) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Room) GetBackgroundUrl() string {
+	if x != nil {
+		return x.BackgroundUrl
+	}
+	return ""
+}
+
+func (x *Room) GetLayoutVersion() int32 {
+	if x != nil {
+		return x.LayoutVersion
+	}
+	return 0
+}
+
+func (x *Room) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
 type Table struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Capacity      int32                  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	CanMerge      bool                   `protobuf:"varint,5,opt,name=can_merge,json=canMerge,proto3" json:"can_merge,omitempty"`
-	Zone          string                 `protobuf:"bytes,6,opt,name=zone,proto3" json:"zone,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoomId    string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity  int32                  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	CanMerge  bool                   `protobuf:"varint,5,opt,name=can_merge,json=canMerge,proto3" json:"can_merge,omitempty"`
+	Zone      string                 `protobuf:"bytes,6,opt,name=zone,proto3" json:"zone,omitempty"`
+	CreatedAt int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Геометрия на плане зала: левый верхний угол, размеры и поворот в градусах
+	X             float64 `protobuf:"fixed64,9,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64 `protobuf:"fixed64,10,opt,name=y,proto3" json:"y,omitempty"`
+	Width         float64 `protobuf:"fixed64,11,opt,name=width,proto3" json:"width,omitempty"`
+	Height        float64 `protobuf:"fixed64,12,opt,name=height,proto3" json:"height,omitempty"`
+	Shape         string  `protobuf:"bytes,13,opt,name=shape,proto3" json:"shape,omitempty"` // rect, round
+	Rotation      float64 `protobuf:"fixed64,14,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	BufferMinutes int32   `protobuf:"varint,15,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"` // 0 - как у заведения
+	DeletedAt     int64   `protobuf:"varint,16,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`             // 0 - не удалено
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -282,6 +348,156 @@ func (x *Table) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *Table) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Table) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Table) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Table) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Table) GetShape() string {
+	if x != nil {
+		return x.Shape
+	}
+	return ""
+}
+
+func (x *Table) GetRotation() float64 {
+	if x != nil {
+		return x.Rotation
+	}
+	return 0
+}
+
+func (x *Table) GetBufferMinutes() int32 {
+	if x != nil {
+		return x.BufferMinutes
+	}
+	return 0
+}
+
+func (x *Table) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+// Именованная комбинация столов одного зала. Если в зале заданы комбинации,
+// при поиске мест объединяются только они; иначе - любые пары can_merge столов
+type TableCombination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TableIds      []string               `protobuf:"bytes,4,rep,name=table_ids,json=tableIds,proto3" json:"table_ids,omitempty"`
+	MinCapacity   int32                  `protobuf:"varint,5,opt,name=min_capacity,json=minCapacity,proto3" json:"min_capacity,omitempty"` // 0 - без ограничения
+	MaxCapacity   int32                  `protobuf:"varint,6,opt,name=max_capacity,json=maxCapacity,proto3" json:"max_capacity,omitempty"` // 0 - сумма вместимостей столов
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableCombination) Reset() {
+	*x = TableCombination{}
+	mi := &file_venue_venue_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableCombination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableCombination) ProtoMessage() {}
+
+func (x *TableCombination) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableCombination.ProtoReflect.Descriptor instead.
+func (*TableCombination) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TableCombination) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TableCombination) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *TableCombination) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableCombination) GetTableIds() []string {
+	if x != nil {
+		return x.TableIds
+	}
+	return nil
+}
+
+func (x *TableCombination) GetMinCapacity() int32 {
+	if x != nil {
+		return x.MinCapacity
+	}
+	return 0
+}
+
+func (x *TableCombination) GetMaxCapacity() int32 {
+	if x != nil {
+		return x.MaxCapacity
+	}
+	return 0
+}
+
+func (x *TableCombination) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
 type OpeningHours struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
@@ -292,7 +508,7 @@ type OpeningHours struct {
 
 func (x *OpeningHours) Reset() {
 	*x = OpeningHours{}
-	mi := &file_venue_venue_proto_msgTypes[3]
+	mi := &file_venue_venue_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -304,7 +520,7 @@ func (x *OpeningHours) String() string {
 func (*OpeningHours) ProtoMessage() {}
 
 func (x *OpeningHours) ProtoReflect() protoreflect.Message {
-	mi := &file_venue_venue_proto_msgTypes[3]
+	mi := &file_venue_venue_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -317,7 +533,7 @@ func (x *OpeningHours) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpeningHours.ProtoReflect.Descriptor instead.
 func (*OpeningHours) Descriptor() ([]byte, []int) {
-	return file_venue_venue_proto_rawDescGZIP(), []int{3}
+	return file_venue_venue_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *OpeningHours) GetVenueId() string {
@@ -345,7 +561,7 @@ type DayHours struct {
 
 func (x *DayHours) Reset() {
 	*x = DayHours{}
-	mi := &file_venue_venue_proto_msgTypes[4]
+	mi := &file_venue_venue_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -357,7 +573,7 @@ func (x *DayHours) String() string {
 func (*DayHours) ProtoMessage() {}
 
 func (x *DayHours) ProtoReflect() protoreflect.Message {
-	mi := &file_venue_venue_proto_msgTypes[4]
+	mi := &file_venue_venue_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,7 +586,7 @@ func (x *DayHours) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DayHours.ProtoReflect.Descriptor instead.
 func (*DayHours) Descriptor() ([]byte, []int) {
-	return file_venue_venue_proto_rawDescGZIP(), []int{4}
+	return file_venue_venue_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DayHours) GetWeekday() int32 {
@@ -394,32 +610,37 @@ func (x *DayHours) GetCloseTime() string {
 	return ""
 }
 
-type SpecialHours struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
-	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
-	OpenTime      string                 `protobuf:"bytes,3,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
-	CloseTime     string                 `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
-	IsClosed      bool                   `protobuf:"varint,5,opt,name=is_closed,json=isClosed,proto3" json:"is_closed,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Правило длительности посадки. Из подходящих правил с duration_minutes берется
+// самое специфичное, затем прибавляются extra_minutes всех подходящих правил.
+// Например: 2 гостя = 90 мин, 6+ = 150 мин, пятница 18:00-23:00 +15 мин
+type TurnTimeRule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MinPartySize    int32                  `protobuf:"varint,1,opt,name=min_party_size,json=minPartySize,proto3" json:"min_party_size,omitempty"` // 0 - без ограничения
+	MaxPartySize    int32                  `protobuf:"varint,2,opt,name=max_party_size,json=maxPartySize,proto3" json:"max_party_size,omitempty"` // 0 - без ограничения
+	Weekdays        []int32                `protobuf:"varint,3,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`                        // 0-6, 0=Sunday; пусто - любой день
+	PeriodStart     string                 `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`       // HH:MM, пусто - с открытия
+	PeriodEnd       string                 `protobuf:"bytes,5,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`             // HH:MM, не включительно; пусто - до закрытия
+	DurationMinutes int32                  `protobuf:"varint,6,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	ExtraMinutes    int32                  `protobuf:"varint,7,opt,name=extra_minutes,json=extraMinutes,proto3" json:"extra_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SpecialHours) Reset() {
-	*x = SpecialHours{}
-	mi := &file_venue_venue_proto_msgTypes[5]
+func (x *TurnTimeRule) Reset() {
+	*x = TurnTimeRule{}
+	mi := &file_venue_venue_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SpecialHours) String() string {
+func (x *TurnTimeRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpecialHours) ProtoMessage() {}
+func (*TurnTimeRule) ProtoMessage() {}
 
-func (x *SpecialHours) ProtoReflect() protoreflect.Message {
-	mi := &file_venue_venue_proto_msgTypes[5]
+func (x *TurnTimeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,71 +651,84 @@ func (x *SpecialHours) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpecialHours.ProtoReflect.Descriptor instead.
-func (*SpecialHours) Descriptor() ([]byte, []int) {
-	return file_venue_venue_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use TurnTimeRule.ProtoReflect.Descriptor instead.
+func (*TurnTimeRule) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SpecialHours) GetVenueId() string {
+func (x *TurnTimeRule) GetMinPartySize() int32 {
 	if x != nil {
-		return x.VenueId
+		return x.MinPartySize
 	}
-	return ""
+	return 0
 }
 
-func (x *SpecialHours) GetDate() string {
+func (x *TurnTimeRule) GetMaxPartySize() int32 {
 	if x != nil {
-		return x.Date
+		return x.MaxPartySize
 	}
-	return ""
+	return 0
 }
 
-func (x *SpecialHours) GetOpenTime() string {
+func (x *TurnTimeRule) GetWeekdays() []int32 {
 	if x != nil {
-		return x.OpenTime
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *TurnTimeRule) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
 	}
 	return ""
 }
 
-func (x *SpecialHours) GetCloseTime() string {
+func (x *TurnTimeRule) GetPeriodEnd() string {
 	if x != nil {
-		return x.CloseTime
+		return x.PeriodEnd
 	}
 	return ""
 }
 
-func (x *SpecialHours) GetIsClosed() bool {
+func (x *TurnTimeRule) GetDurationMinutes() int32 {
 	if x != nil {
-		return x.IsClosed
+		return x.DurationMinutes
 	}
-	return false
+	return 0
 }
 
-// Requests
-type CreateVenueRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
-	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TurnTimeRule) GetExtraMinutes() int32 {
+	if x != nil {
+		return x.ExtraMinutes
+	}
+	return 0
 }
 
-func (x *CreateVenueRequest) Reset() {
-	*x = CreateVenueRequest{}
-	mi := &file_venue_venue_proto_msgTypes[6]
+type TurnTimeRules struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	VenueId                string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Rules                  []*TurnTimeRule        `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	DefaultDurationMinutes int32                  `protobuf:"varint,3,opt,name=default_duration_minutes,json=defaultDurationMinutes,proto3" json:"default_duration_minutes,omitempty"` // если ни одно правило не задает длительность
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TurnTimeRules) Reset() {
+	*x = TurnTimeRules{}
+	mi := &file_venue_venue_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVenueRequest) String() string {
+func (x *TurnTimeRules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVenueRequest) ProtoMessage() {}
+func (*TurnTimeRules) ProtoMessage() {}
 
-func (x *CreateVenueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_venue_venue_proto_msgTypes[6]
+func (x *TurnTimeRules) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,54 +739,61 @@ func (x *CreateVenueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVenueRequest.ProtoReflect.Descriptor instead.
-func (*CreateVenueRequest) Descriptor() ([]byte, []int) {
-	return file_venue_venue_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use TurnTimeRules.ProtoReflect.Descriptor instead.
+func (*TurnTimeRules) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateVenueRequest) GetName() string {
+func (x *TurnTimeRules) GetVenueId() string {
 	if x != nil {
-		return x.Name
+		return x.VenueId
 	}
 	return ""
 }
 
-func (x *CreateVenueRequest) GetTimezone() string {
+func (x *TurnTimeRules) GetRules() []*TurnTimeRule {
 	if x != nil {
-		return x.Timezone
+		return x.Rules
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateVenueRequest) GetAddress() string {
+func (x *TurnTimeRules) GetDefaultDurationMinutes() int32 {
 	if x != nil {
-		return x.Address
+		return x.DefaultDurationMinutes
 	}
-	return ""
+	return 0
 }
 
-type GetVenueRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+// Ограничение темпа посадки: сколько гостей и броней может начаться в каждом
+// интервале. Например: не больше 40 гостей за 15 минут в пятницу 18:00-22:00
+type PacingRule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IntervalMinutes int32                  `protobuf:"varint,1,opt,name=interval_minutes,json=intervalMinutes,proto3" json:"interval_minutes,omitempty"` // 15 или 30, интервалы отсчитываются от полуночи
+	Weekdays        []int32                `protobuf:"varint,2,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`                               // 0-6, 0=Sunday; пусто - любой день
+	PeriodStart     string                 `protobuf:"bytes,3,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`              // HH:MM, пусто - с открытия
+	PeriodEnd       string                 `protobuf:"bytes,4,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`                    // HH:MM, не включительно; пусто - до закрытия
+	MaxCovers       int32                  `protobuf:"varint,5,opt,name=max_covers,json=maxCovers,proto3" json:"max_covers,omitempty"`                   // 0 - без ограничения
+	MaxBookings     int32                  `protobuf:"varint,6,opt,name=max_bookings,json=maxBookings,proto3" json:"max_bookings,omitempty"`             // 0 - без ограничения
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
 
-func (x *GetVenueRequest) Reset() {
-	*x = GetVenueRequest{}
-	mi := &file_venue_venue_proto_msgTypes[7]
+func (x *PacingRule) Reset() {
+	*x = PacingRule{}
+	mi := &file_venue_venue_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVenueRequest) String() string {
+func (x *PacingRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVenueRequest) ProtoMessage() {}
+func (*PacingRule) ProtoMessage() {}
 
-func (x *GetVenueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_venue_venue_proto_msgTypes[7]
+func (x *PacingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -563,93 +804,75 @@ func (x *GetVenueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVenueRequest.ProtoReflect.Descriptor instead.
-func (*GetVenueRequest) Descriptor() ([]byte, []int) {
-	return file_venue_venue_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use PacingRule.ProtoReflect.Descriptor instead.
+func (*PacingRule) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetVenueRequest) GetId() string {
+func (x *PacingRule) GetIntervalMinutes() int32 {
 	if x != nil {
-		return x.Id
+		return x.IntervalMinutes
 	}
-	return ""
-}
-
-type ListVenuesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListVenuesRequest) Reset() {
-	*x = ListVenuesRequest{}
-	mi := &file_venue_venue_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *ListVenuesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PacingRule) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
 }
 
-func (*ListVenuesRequest) ProtoMessage() {}
-
-func (x *ListVenuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_venue_venue_proto_msgTypes[8]
+func (x *PacingRule) GetPeriodStart() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PeriodStart
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListVenuesRequest.ProtoReflect.Descriptor instead.
-func (*ListVenuesRequest) Descriptor() ([]byte, []int) {
-	return file_venue_venue_proto_rawDescGZIP(), []int{8}
+func (x *PacingRule) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
 }
 
-func (x *ListVenuesRequest) GetLimit() int32 {
+func (x *PacingRule) GetMaxCovers() int32 {
 	if x != nil {
-		return x.Limit
+		return x.MaxCovers
 	}
 	return 0
 }
 
-func (x *ListVenuesRequest) GetOffset() int32 {
+func (x *PacingRule) GetMaxBookings() int32 {
 	if x != nil {
-		return x.Offset
+		return x.MaxBookings
 	}
 	return 0
 }
 
-type UpdateVenueRequest struct {
+type PacingRules struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Rules         []*PacingRule          `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateVenueRequest) Reset() {
-	*x = UpdateVenueRequest{}
+func (x *PacingRules) Reset() {
+	*x = PacingRules{}
 	mi := &file_venue_venue_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVenueRequest) String() string {
+func (x *PacingRules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVenueRequest) ProtoMessage() {}
+func (*PacingRules) ProtoMessage() {}
 
-func (x *UpdateVenueRequest) ProtoReflect() protoreflect.Message {
+func (x *PacingRules) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -661,53 +884,53 @@ func (x *UpdateVenueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVenueRequest.ProtoReflect.Descriptor instead.
-func (*UpdateVenueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PacingRules.ProtoReflect.Descriptor instead.
+func (*PacingRules) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateVenueRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateVenueRequest) GetName() string {
+func (x *PacingRules) GetVenueId() string {
 	if x != nil {
-		return x.Name
+		return x.VenueId
 	}
 	return ""
 }
 
-func (x *UpdateVenueRequest) GetAddress() string {
+func (x *PacingRules) GetRules() []*PacingRule {
 	if x != nil {
-		return x.Address
+		return x.Rules
 	}
-	return ""
+	return nil
 }
 
-type DeleteVenueRequest struct {
+// Причина отказа по темпу посадки
+type PacingRejection struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IntervalStart string                 `protobuf:"bytes,1,opt,name=interval_start,json=intervalStart,proto3" json:"interval_start,omitempty"` // HH:MM
+	IntervalEnd   string                 `protobuf:"bytes,2,opt,name=interval_end,json=intervalEnd,proto3" json:"interval_end,omitempty"`
+	Covers        int32                  `protobuf:"varint,3,opt,name=covers,proto3" json:"covers,omitempty"` // уже начинается в интервале
+	MaxCovers     int32                  `protobuf:"varint,4,opt,name=max_covers,json=maxCovers,proto3" json:"max_covers,omitempty"`
+	Bookings      int32                  `protobuf:"varint,5,opt,name=bookings,proto3" json:"bookings,omitempty"`
+	MaxBookings   int32                  `protobuf:"varint,6,opt,name=max_bookings,json=maxBookings,proto3" json:"max_bookings,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteVenueRequest) Reset() {
-	*x = DeleteVenueRequest{}
+func (x *PacingRejection) Reset() {
+	*x = PacingRejection{}
 	mi := &file_venue_venue_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteVenueRequest) String() string {
+func (x *PacingRejection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVenueRequest) ProtoMessage() {}
+func (*PacingRejection) ProtoMessage() {}
 
-func (x *DeleteVenueRequest) ProtoReflect() protoreflect.Message {
+func (x *PacingRejection) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -719,40 +942,85 @@ func (x *DeleteVenueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVenueRequest.ProtoReflect.Descriptor instead.
-func (*DeleteVenueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PacingRejection.ProtoReflect.Descriptor instead.
+func (*PacingRejection) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteVenueRequest) GetId() string {
+func (x *PacingRejection) GetIntervalStart() string {
 	if x != nil {
-		return x.Id
+		return x.IntervalStart
 	}
 	return ""
 }
 
-type CreateRoomRequest struct {
+func (x *PacingRejection) GetIntervalEnd() string {
+	if x != nil {
+		return x.IntervalEnd
+	}
+	return ""
+}
+
+func (x *PacingRejection) GetCovers() int32 {
+	if x != nil {
+		return x.Covers
+	}
+	return 0
+}
+
+func (x *PacingRejection) GetMaxCovers() int32 {
+	if x != nil {
+		return x.MaxCovers
+	}
+	return 0
+}
+
+func (x *PacingRejection) GetBookings() int32 {
+	if x != nil {
+		return x.Bookings
+	}
+	return 0
+}
+
+func (x *PacingRejection) GetMaxBookings() int32 {
+	if x != nil {
+		return x.MaxBookings
+	}
+	return 0
+}
+
+func (x *PacingRejection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Интервал правила темпа, в который попадает время начала брони
+type PacingLimit struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IntervalStart string                 `protobuf:"bytes,1,opt,name=interval_start,json=intervalStart,proto3" json:"interval_start,omitempty"` // HH:MM
+	IntervalEnd   string                 `protobuf:"bytes,2,opt,name=interval_end,json=intervalEnd,proto3" json:"interval_end,omitempty"`
+	MaxCovers     int32                  `protobuf:"varint,3,opt,name=max_covers,json=maxCovers,proto3" json:"max_covers,omitempty"` // 0 - без ограничения
+	MaxBookings   int32                  `protobuf:"varint,4,opt,name=max_bookings,json=maxBookings,proto3" json:"max_bookings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRoomRequest) Reset() {
-	*x = CreateRoomRequest{}
+func (x *PacingLimit) Reset() {
+	*x = PacingLimit{}
 	mi := &file_venue_venue_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRoomRequest) String() string {
+func (x *PacingLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoomRequest) ProtoMessage() {}
+func (*PacingLimit) ProtoMessage() {}
 
-func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+func (x *PacingLimit) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -764,46 +1032,64 @@ func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
-func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PacingLimit.ProtoReflect.Descriptor instead.
+func (*PacingLimit) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateRoomRequest) GetVenueId() string {
+func (x *PacingLimit) GetIntervalStart() string {
 	if x != nil {
-		return x.VenueId
+		return x.IntervalStart
 	}
 	return ""
 }
 
-func (x *CreateRoomRequest) GetName() string {
+func (x *PacingLimit) GetIntervalEnd() string {
 	if x != nil {
-		return x.Name
+		return x.IntervalEnd
 	}
 	return ""
 }
 
-type GetRoomRequest struct {
+func (x *PacingLimit) GetMaxCovers() int32 {
+	if x != nil {
+		return x.MaxCovers
+	}
+	return 0
+}
+
+func (x *PacingLimit) GetMaxBookings() int32 {
+	if x != nil {
+		return x.MaxBookings
+	}
+	return 0
+}
+
+type SpecialHours struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	OpenTime      string                 `protobuf:"bytes,3,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime     string                 `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	IsClosed      bool                   `protobuf:"varint,5,opt,name=is_closed,json=isClosed,proto3" json:"is_closed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoomRequest) Reset() {
-	*x = GetRoomRequest{}
+func (x *SpecialHours) Reset() {
+	*x = SpecialHours{}
 	mi := &file_venue_venue_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoomRequest) String() string {
+func (x *SpecialHours) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoomRequest) ProtoMessage() {}
+func (*SpecialHours) ProtoMessage() {}
 
-func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
+func (x *SpecialHours) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -815,41 +1101,71 @@ func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoomRequest.ProtoReflect.Descriptor instead.
-func (*GetRoomRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SpecialHours.ProtoReflect.Descriptor instead.
+func (*SpecialHours) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetRoomRequest) GetId() string {
+func (x *SpecialHours) GetVenueId() string {
 	if x != nil {
-		return x.Id
+		return x.VenueId
 	}
 	return ""
 }
 
-type ListRoomsRequest struct {
+func (x *SpecialHours) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SpecialHours) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *SpecialHours) GetCloseTime() string {
+	if x != nil {
+		return x.CloseTime
+	}
+	return ""
+}
+
+func (x *SpecialHours) GetIsClosed() bool {
+	if x != nil {
+		return x.IsClosed
+	}
+	return false
+}
+
+// Requests
+type CreateVenueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	BufferMinutes int32                  `protobuf:"varint,4,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRoomsRequest) Reset() {
-	*x = ListRoomsRequest{}
+func (x *CreateVenueRequest) Reset() {
+	*x = CreateVenueRequest{}
 	mi := &file_venue_venue_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRoomsRequest) String() string {
+func (x *CreateVenueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRoomsRequest) ProtoMessage() {}
+func (*CreateVenueRequest) ProtoMessage() {}
 
-func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateVenueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -861,54 +1177,60 @@ func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
-func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateVenueRequest.ProtoReflect.Descriptor instead.
+func (*CreateVenueRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListRoomsRequest) GetVenueId() string {
+func (x *CreateVenueRequest) GetName() string {
 	if x != nil {
-		return x.VenueId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListRoomsRequest) GetLimit() int32 {
+func (x *CreateVenueRequest) GetTimezone() string {
 	if x != nil {
-		return x.Limit
+		return x.Timezone
 	}
-	return 0
+	return ""
 }
 
-func (x *ListRoomsRequest) GetOffset() int32 {
+func (x *CreateVenueRequest) GetAddress() string {
 	if x != nil {
-		return x.Offset
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateVenueRequest) GetBufferMinutes() int32 {
+	if x != nil {
+		return x.BufferMinutes
 	}
 	return 0
 }
 
-type UpdateRoomRequest struct {
+type GetVenueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRoomRequest) Reset() {
-	*x = UpdateRoomRequest{}
+func (x *GetVenueRequest) Reset() {
+	*x = GetVenueRequest{}
 	mi := &file_venue_venue_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRoomRequest) String() string {
+func (x *GetVenueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoomRequest) ProtoMessage() {}
+func (*GetVenueRequest) ProtoMessage() {}
 
-func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
+func (x *GetVenueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -920,46 +1242,41 @@ func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVenueRequest.ProtoReflect.Descriptor instead.
+func (*GetVenueRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UpdateRoomRequest) GetId() string {
+func (x *GetVenueRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateRoomRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type DeleteRoomRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListVenuesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Limit          int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteRoomRequest) Reset() {
-	*x = DeleteRoomRequest{}
+func (x *ListVenuesRequest) Reset() {
+	*x = ListVenuesRequest{}
 	mi := &file_venue_venue_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRoomRequest) String() string {
+func (x *ListVenuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRoomRequest) ProtoMessage() {}
+func (*ListVenuesRequest) ProtoMessage() {}
 
-func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
+func (x *ListVenuesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -971,43 +1288,56 @@ func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRoomRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListVenuesRequest.ProtoReflect.Descriptor instead.
+func (*ListVenuesRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DeleteRoomRequest) GetId() string {
+func (x *ListVenuesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Id
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type CreateTableRequest struct {
+func (x *ListVenuesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListVenuesRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UpdateVenueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	CanMerge      bool                   `protobuf:"varint,4,opt,name=can_merge,json=canMerge,proto3" json:"can_merge,omitempty"`
-	Zone          string                 `protobuf:"bytes,5,opt,name=zone,proto3" json:"zone,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	BufferMinutes int32                  `protobuf:"varint,4,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTableRequest) Reset() {
-	*x = CreateTableRequest{}
+func (x *UpdateVenueRequest) Reset() {
+	*x = UpdateVenueRequest{}
 	mi := &file_venue_venue_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTableRequest) String() string {
+func (x *UpdateVenueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTableRequest) ProtoMessage() {}
+func (*UpdateVenueRequest) ProtoMessage() {}
 
-func (x *CreateTableRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateVenueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1019,67 +1349,66 @@ func (x *CreateTableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTableRequest.ProtoReflect.Descriptor instead.
-func (*CreateTableRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateVenueRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVenueRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateTableRequest) GetRoomId() string {
+func (x *UpdateVenueRequest) GetId() string {
 	if x != nil {
-		return x.RoomId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateTableRequest) GetName() string {
+func (x *UpdateVenueRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateTableRequest) GetCapacity() int32 {
-	if x != nil {
-		return x.Capacity
-	}
-	return 0
-}
-
-func (x *CreateTableRequest) GetCanMerge() bool {
+func (x *UpdateVenueRequest) GetAddress() string {
 	if x != nil {
-		return x.CanMerge
+		return x.Address
 	}
-	return false
+	return ""
 }
 
-func (x *CreateTableRequest) GetZone() string {
+func (x *UpdateVenueRequest) GetBufferMinutes() int32 {
 	if x != nil {
-		return x.Zone
+		return x.BufferMinutes
 	}
-	return ""
+	return 0
 }
 
-type GetTableRequest struct {
+// Если на удаляемых столах есть будущие брони, удаление выполняется только
+// с force (брони отменяются) или с reassignments (брони пересаживаются).
+// Удаление мягкое: заведение, зал или стол скрываются из списков вместе со всем,
+// что в них входит, и окончательно удаляются по истечении срока хранения
+type DeleteVenueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	AdminId       string                 `protobuf:"bytes,3,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTableRequest) Reset() {
-	*x = GetTableRequest{}
+func (x *DeleteVenueRequest) Reset() {
+	*x = DeleteVenueRequest{}
 	mi := &file_venue_venue_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTableRequest) String() string {
+func (x *DeleteVenueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTableRequest) ProtoMessage() {}
+func (*DeleteVenueRequest) ProtoMessage() {}
 
-func (x *GetTableRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteVenueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1091,42 +1420,54 @@ func (x *GetTableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTableRequest.ProtoReflect.Descriptor instead.
-func (*GetTableRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteVenueRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVenueRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetTableRequest) GetId() string {
+func (x *DeleteVenueRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListTablesRequest struct {
+func (x *DeleteVenueRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeleteVenueRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type CreateRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	VenueId       string                 `protobuf:"bytes,2,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTablesRequest) Reset() {
-	*x = ListTablesRequest{}
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
 	mi := &file_venue_venue_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTablesRequest) String() string {
+func (x *CreateRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTablesRequest) ProtoMessage() {}
+func (*CreateRoomRequest) ProtoMessage() {}
 
-func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1138,63 +1479,46 @@ func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTablesRequest.ProtoReflect.Descriptor instead.
-func (*ListTablesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListTablesRequest) GetRoomId() string {
-	if x != nil {
-		return x.RoomId
-	}
-	return ""
-}
-
-func (x *ListTablesRequest) GetVenueId() string {
+func (x *CreateRoomRequest) GetVenueId() string {
 	if x != nil {
 		return x.VenueId
 	}
 	return ""
 }
 
-func (x *ListTablesRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListTablesRequest) GetOffset() int32 {
+func (x *CreateRoomRequest) GetName() string {
 	if x != nil {
-		return x.Offset
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type UpdateTableRequest struct {
+type GetRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	Zone          string                 `protobuf:"bytes,4,opt,name=zone,proto3" json:"zone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTableRequest) Reset() {
-	*x = UpdateTableRequest{}
+func (x *GetRoomRequest) Reset() {
+	*x = GetRoomRequest{}
 	mi := &file_venue_venue_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTableRequest) String() string {
+func (x *GetRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTableRequest) ProtoMessage() {}
+func (*GetRoomRequest) ProtoMessage() {}
 
-func (x *UpdateTableRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1206,60 +1530,42 @@ func (x *UpdateTableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTableRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTableRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRoomRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UpdateTableRequest) GetId() string {
+func (x *GetRoomRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateTableRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateTableRequest) GetCapacity() int32 {
-	if x != nil {
-		return x.Capacity
-	}
-	return 0
-}
-
-func (x *UpdateTableRequest) GetZone() string {
-	if x != nil {
-		return x.Zone
-	}
-	return ""
-}
-
-type DeleteTableRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListRoomsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VenueId        string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Limit          int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,4,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteTableRequest) Reset() {
-	*x = DeleteTableRequest{}
+func (x *ListRoomsRequest) Reset() {
+	*x = ListRoomsRequest{}
 	mi := &file_venue_venue_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTableRequest) String() string {
+func (x *ListRoomsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTableRequest) ProtoMessage() {}
+func (*ListRoomsRequest) ProtoMessage() {}
 
-func (x *DeleteTableRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1271,40 +1577,61 @@ func (x *DeleteTableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTableRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTableRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *DeleteTableRequest) GetId() string {
+func (x *ListRoomsRequest) GetVenueId() string {
 	if x != nil {
-		return x.Id
+		return x.VenueId
 	}
 	return ""
 }
 
-type SetOpeningHoursRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
-	Days          []*DayHours            `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListRoomsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *SetOpeningHoursRequest) Reset() {
-	*x = SetOpeningHoursRequest{}
-	mi := &file_venue_venue_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListRoomsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
 }
 
-func (x *SetOpeningHoursRequest) String() string {
+func (x *ListRoomsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UpdateRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoomRequest) Reset() {
+	*x = UpdateRoomRequest{}
+	mi := &file_venue_venue_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetOpeningHoursRequest) ProtoMessage() {}
+func (*UpdateRoomRequest) ProtoMessage() {}
 
-func (x *SetOpeningHoursRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1316,46 +1643,49 @@ func (x *SetOpeningHoursRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetOpeningHoursRequest.ProtoReflect.Descriptor instead.
-func (*SetOpeningHoursRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SetOpeningHoursRequest) GetVenueId() string {
+func (x *UpdateRoomRequest) GetId() string {
 	if x != nil {
-		return x.VenueId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SetOpeningHoursRequest) GetDays() []*DayHours {
+func (x *UpdateRoomRequest) GetName() string {
 	if x != nil {
-		return x.Days
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetOpeningHoursRequest struct {
+type DeleteRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	Reassignments []*BookingReassignment `protobuf:"bytes,3,rep,name=reassignments,proto3" json:"reassignments,omitempty"`
+	AdminId       string                 `protobuf:"bytes,4,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOpeningHoursRequest) Reset() {
-	*x = GetOpeningHoursRequest{}
+func (x *DeleteRoomRequest) Reset() {
+	*x = DeleteRoomRequest{}
 	mi := &file_venue_venue_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpeningHoursRequest) String() string {
+func (x *DeleteRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpeningHoursRequest) ProtoMessage() {}
+func (*DeleteRoomRequest) ProtoMessage() {}
 
-func (x *GetOpeningHoursRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1367,43 +1697,65 @@ func (x *GetOpeningHoursRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpeningHoursRequest.ProtoReflect.Descriptor instead.
-func (*GetOpeningHoursRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteRoomRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetOpeningHoursRequest) GetVenueId() string {
+func (x *DeleteRoomRequest) GetId() string {
 	if x != nil {
-		return x.VenueId
+		return x.Id
 	}
 	return ""
 }
 
-type SetSpecialHoursRequest struct {
+func (x *DeleteRoomRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeleteRoomRequest) GetReassignments() []*BookingReassignment {
+	if x != nil {
+		return x.Reassignments
+	}
+	return nil
+}
+
+func (x *DeleteRoomRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type CreateTableRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
-	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	OpenTime      string                 `protobuf:"bytes,3,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
-	CloseTime     string                 `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
-	IsClosed      bool                   `protobuf:"varint,5,opt,name=is_closed,json=isClosed,proto3" json:"is_closed,omitempty"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	CanMerge      bool                   `protobuf:"varint,4,opt,name=can_merge,json=canMerge,proto3" json:"can_merge,omitempty"`
+	Zone          string                 `protobuf:"bytes,5,opt,name=zone,proto3" json:"zone,omitempty"`
+	BufferMinutes int32                  `protobuf:"varint,6,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetSpecialHoursRequest) Reset() {
-	*x = SetSpecialHoursRequest{}
+func (x *CreateTableRequest) Reset() {
+	*x = CreateTableRequest{}
 	mi := &file_venue_venue_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetSpecialHoursRequest) String() string {
+func (x *CreateTableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSpecialHoursRequest) ProtoMessage() {}
+func (*CreateTableRequest) ProtoMessage() {}
 
-func (x *SetSpecialHoursRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateTableRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1415,69 +1767,78 @@ func (x *SetSpecialHoursRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSpecialHoursRequest.ProtoReflect.Descriptor instead.
-func (*SetSpecialHoursRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTableRequest.ProtoReflect.Descriptor instead.
+func (*CreateTableRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SetSpecialHoursRequest) GetVenueId() string {
+func (x *CreateTableRequest) GetRoomId() string {
 	if x != nil {
-		return x.VenueId
+		return x.RoomId
 	}
 	return ""
 }
 
-func (x *SetSpecialHoursRequest) GetDate() string {
+func (x *CreateTableRequest) GetName() string {
 	if x != nil {
-		return x.Date
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SetSpecialHoursRequest) GetOpenTime() string {
+func (x *CreateTableRequest) GetCapacity() int32 {
 	if x != nil {
-		return x.OpenTime
+		return x.Capacity
 	}
-	return ""
+	return 0
 }
 
-func (x *SetSpecialHoursRequest) GetCloseTime() string {
+func (x *CreateTableRequest) GetCanMerge() bool {
 	if x != nil {
-		return x.CloseTime
+		return x.CanMerge
+	}
+	return false
+}
+
+func (x *CreateTableRequest) GetZone() string {
+	if x != nil {
+		return x.Zone
 	}
 	return ""
 }
 
-func (x *SetSpecialHoursRequest) GetIsClosed() bool {
+func (x *CreateTableRequest) GetBufferMinutes() int32 {
 	if x != nil {
-		return x.IsClosed
+		return x.BufferMinutes
 	}
-	return false
+	return 0
 }
 
-type CheckAvailabilityRequest struct {
+type CreateTableCombinationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
-	Slot          *common.Slot           `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
-	PartySize     int32                  `protobuf:"varint,3,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TableIds      []string               `protobuf:"bytes,3,rep,name=table_ids,json=tableIds,proto3" json:"table_ids,omitempty"`
+	MinCapacity   int32                  `protobuf:"varint,4,opt,name=min_capacity,json=minCapacity,proto3" json:"min_capacity,omitempty"`
+	MaxCapacity   int32                  `protobuf:"varint,5,opt,name=max_capacity,json=maxCapacity,proto3" json:"max_capacity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckAvailabilityRequest) Reset() {
-	*x = CheckAvailabilityRequest{}
+func (x *CreateTableCombinationRequest) Reset() {
+	*x = CreateTableCombinationRequest{}
 	mi := &file_venue_venue_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckAvailabilityRequest) String() string {
+func (x *CreateTableCombinationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAvailabilityRequest) ProtoMessage() {}
+func (*CreateTableCombinationRequest) ProtoMessage() {}
 
-func (x *CheckAvailabilityRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateTableCombinationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1489,53 +1850,68 @@ func (x *CheckAvailabilityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAvailabilityRequest.ProtoReflect.Descriptor instead.
-func (*CheckAvailabilityRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTableCombinationRequest.ProtoReflect.Descriptor instead.
+func (*CreateTableCombinationRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CheckAvailabilityRequest) GetVenueId() string {
+func (x *CreateTableCombinationRequest) GetRoomId() string {
 	if x != nil {
-		return x.VenueId
+		return x.RoomId
 	}
 	return ""
 }
 
-func (x *CheckAvailabilityRequest) GetSlot() *common.Slot {
+func (x *CreateTableCombinationRequest) GetName() string {
 	if x != nil {
-		return x.Slot
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTableCombinationRequest) GetTableIds() []string {
+	if x != nil {
+		return x.TableIds
 	}
 	return nil
 }
 
-func (x *CheckAvailabilityRequest) GetPartySize() int32 {
+func (x *CreateTableCombinationRequest) GetMinCapacity() int32 {
 	if x != nil {
-		return x.PartySize
+		return x.MinCapacity
 	}
 	return 0
 }
 
-type CheckAvailabilityResponse struct {
+func (x *CreateTableCombinationRequest) GetMaxCapacity() int32 {
+	if x != nil {
+		return x.MaxCapacity
+	}
+	return 0
+}
+
+type ListTableCombinationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tables        []*TableAvailability   `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	VenueId       string                 `protobuf:"bytes,2,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckAvailabilityResponse) Reset() {
-	*x = CheckAvailabilityResponse{}
+func (x *ListTableCombinationsRequest) Reset() {
+	*x = ListTableCombinationsRequest{}
 	mi := &file_venue_venue_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckAvailabilityResponse) String() string {
+func (x *ListTableCombinationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAvailabilityResponse) ProtoMessage() {}
+func (*ListTableCombinationsRequest) ProtoMessage() {}
 
-func (x *CheckAvailabilityResponse) ProtoReflect() protoreflect.Message {
+func (x *ListTableCombinationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1547,42 +1923,46 @@ func (x *CheckAvailabilityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAvailabilityResponse.ProtoReflect.Descriptor instead.
-func (*CheckAvailabilityResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTableCombinationsRequest.ProtoReflect.Descriptor instead.
+func (*ListTableCombinationsRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CheckAvailabilityResponse) GetTables() []*TableAvailability {
+func (x *ListTableCombinationsRequest) GetRoomId() string {
 	if x != nil {
-		return x.Tables
+		return x.RoomId
 	}
-	return nil
+	return ""
 }
 
-type TableAvailability struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Table           *common.TableRef       `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
-	Available       bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
-	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	MergedWithTable *common.TableRef       `protobuf:"bytes,4,opt,name=merged_with_table,json=mergedWithTable,proto3" json:"merged_with_table,omitempty"` // If this table can be merged with another table
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ListTableCombinationsRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
 }
 
-func (x *TableAvailability) Reset() {
-	*x = TableAvailability{}
+type DeleteTableCombinationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTableCombinationRequest) Reset() {
+	*x = DeleteTableCombinationRequest{}
 	mi := &file_venue_venue_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TableAvailability) String() string {
+func (x *DeleteTableCombinationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TableAvailability) ProtoMessage() {}
+func (*DeleteTableCombinationRequest) ProtoMessage() {}
 
-func (x *TableAvailability) ProtoReflect() protoreflect.Message {
+func (x *DeleteTableCombinationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1594,61 +1974,39 @@ func (x *TableAvailability) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TableAvailability.ProtoReflect.Descriptor instead.
-func (*TableAvailability) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTableCombinationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTableCombinationRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *TableAvailability) GetTable() *common.TableRef {
-	if x != nil {
-		return x.Table
-	}
-	return nil
-}
-
-func (x *TableAvailability) GetAvailable() bool {
-	if x != nil {
-		return x.Available
-	}
-	return false
-}
-
-func (x *TableAvailability) GetReason() string {
+func (x *DeleteTableCombinationRequest) GetId() string {
 	if x != nil {
-		return x.Reason
+		return x.Id
 	}
 	return ""
 }
 
-func (x *TableAvailability) GetMergedWithTable() *common.TableRef {
-	if x != nil {
-		return x.MergedWithTable
-	}
-	return nil
-}
-
-type GetTableLayoutRequest struct {
+type GetTableRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
-	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTableLayoutRequest) Reset() {
-	*x = GetTableLayoutRequest{}
+func (x *GetTableRequest) Reset() {
+	*x = GetTableRequest{}
 	mi := &file_venue_venue_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTableLayoutRequest) String() string {
+func (x *GetTableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTableLayoutRequest) ProtoMessage() {}
+func (*GetTableRequest) ProtoMessage() {}
 
-func (x *GetTableLayoutRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTableRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1660,47 +2018,43 @@ func (x *GetTableLayoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTableLayoutRequest.ProtoReflect.Descriptor instead.
-func (*GetTableLayoutRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTableRequest.ProtoReflect.Descriptor instead.
+func (*GetTableRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetTableLayoutRequest) GetVenueId() string {
+func (x *GetTableRequest) GetId() string {
 	if x != nil {
-		return x.VenueId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetTableLayoutRequest) GetRoomId() string {
-	if x != nil {
-		return x.RoomId
-	}
-	return ""
+type ListTablesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RoomId         string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	VenueId        string                 `protobuf:"bytes,2,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-type GetTableLayoutResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	Tables        []*Table               `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetTableLayoutResponse) Reset() {
-	*x = GetTableLayoutResponse{}
+func (x *ListTablesRequest) Reset() {
+	*x = ListTablesRequest{}
 	mi := &file_venue_venue_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTableLayoutResponse) String() string {
+func (x *ListTablesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTableLayoutResponse) ProtoMessage() {}
+func (*ListTablesRequest) ProtoMessage() {}
 
-func (x *GetTableLayoutResponse) ProtoReflect() protoreflect.Message {
+func (x *ListTablesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1712,48 +2066,71 @@ func (x *GetTableLayoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTableLayoutResponse.ProtoReflect.Descriptor instead.
-func (*GetTableLayoutResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTablesRequest.ProtoReflect.Descriptor instead.
+func (*ListTablesRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetTableLayoutResponse) GetRoomId() string {
+func (x *ListTablesRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-func (x *GetTableLayoutResponse) GetTables() []*Table {
+func (x *ListTablesRequest) GetVenueId() string {
 	if x != nil {
-		return x.Tables
+		return x.VenueId
 	}
-	return nil
+	return ""
 }
 
-// Responses
-type ListVenuesResponse struct {
+func (x *ListTablesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTablesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListTablesRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UpdateTableRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Venues        []*Venue               `protobuf:"bytes,1,rep,name=venues,proto3" json:"venues,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Zone          string                 `protobuf:"bytes,4,opt,name=zone,proto3" json:"zone,omitempty"`
+	BufferMinutes int32                  `protobuf:"varint,5,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListVenuesResponse) Reset() {
-	*x = ListVenuesResponse{}
+func (x *UpdateTableRequest) Reset() {
+	*x = UpdateTableRequest{}
 	mi := &file_venue_venue_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVenuesResponse) String() string {
+func (x *UpdateTableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVenuesResponse) ProtoMessage() {}
+func (*UpdateTableRequest) ProtoMessage() {}
 
-func (x *ListVenuesResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateTableRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_venue_venue_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1765,48 +2142,2896 @@ func (x *ListVenuesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVenuesResponse.ProtoReflect.Descriptor instead.
-func (*ListVenuesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateTableRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTableRequest) Descriptor() ([]byte, []int) {
 	return file_venue_venue_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListVenuesResponse) GetVenues() []*Venue {
+func (x *UpdateTableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTableRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *UpdateTableRequest) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *UpdateTableRequest) GetBufferMinutes() int32 {
+	if x != nil {
+		return x.BufferMinutes
+	}
+	return 0
+}
+
+type DeleteTableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	Reassignments []*BookingReassignment `protobuf:"bytes,3,rep,name=reassignments,proto3" json:"reassignments,omitempty"`
+	AdminId       string                 `protobuf:"bytes,4,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTableRequest) Reset() {
+	*x = DeleteTableRequest{}
+	mi := &file_venue_venue_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTableRequest) ProtoMessage() {}
+
+func (x *DeleteTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTableRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTableRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteTableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteTableRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeleteTableRequest) GetReassignments() []*BookingReassignment {
+	if x != nil {
+		return x.Reassignments
+	}
+	return nil
+}
+
+func (x *DeleteTableRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+// Восстановление возвращает всё, что было удалено вместе с объектом;
+// зал или стол удалённого заведения сначала требуют восстановить заведение
+type RestoreVenueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreVenueRequest) Reset() {
+	*x = RestoreVenueRequest{}
+	mi := &file_venue_venue_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreVenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreVenueRequest) ProtoMessage() {}
+
+func (x *RestoreVenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreVenueRequest.ProtoReflect.Descriptor instead.
+func (*RestoreVenueRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RestoreVenueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRoomRequest) Reset() {
+	*x = RestoreRoomRequest{}
+	mi := &file_venue_venue_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRoomRequest) ProtoMessage() {}
+
+func (x *RestoreRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRoomRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRoomRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RestoreRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreTableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTableRequest) Reset() {
+	*x = RestoreTableRequest{}
+	mi := &file_venue_venue_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTableRequest) ProtoMessage() {}
+
+func (x *RestoreTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTableRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTableRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RestoreTableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Куда пересадить бронь с удаляемого стола
+type BookingReassignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	TableIds      []string               `protobuf:"bytes,2,rep,name=table_ids,json=tableIds,proto3" json:"table_ids,omitempty"` // первый - основной стол
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingReassignment) Reset() {
+	*x = BookingReassignment{}
+	mi := &file_venue_venue_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingReassignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingReassignment) ProtoMessage() {}
+
+func (x *BookingReassignment) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingReassignment.ProtoReflect.Descriptor instead.
+func (*BookingReassignment) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BookingReassignment) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BookingReassignment) GetTableIds() []string {
+	if x != nil {
+		return x.TableIds
+	}
+	return nil
+}
+
+type SetOpeningHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Days          []*DayHours            `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOpeningHoursRequest) Reset() {
+	*x = SetOpeningHoursRequest{}
+	mi := &file_venue_venue_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOpeningHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOpeningHoursRequest) ProtoMessage() {}
+
+func (x *SetOpeningHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOpeningHoursRequest.ProtoReflect.Descriptor instead.
+func (*SetOpeningHoursRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetOpeningHoursRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *SetOpeningHoursRequest) GetDays() []*DayHours {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type GetOpeningHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpeningHoursRequest) Reset() {
+	*x = GetOpeningHoursRequest{}
+	mi := &file_venue_venue_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpeningHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpeningHoursRequest) ProtoMessage() {}
+
+func (x *GetOpeningHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpeningHoursRequest.ProtoReflect.Descriptor instead.
+func (*GetOpeningHoursRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetOpeningHoursRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+type SetSpecialHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	OpenTime      string                 `protobuf:"bytes,3,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime     string                 `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	IsClosed      bool                   `protobuf:"varint,5,opt,name=is_closed,json=isClosed,proto3" json:"is_closed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSpecialHoursRequest) Reset() {
+	*x = SetSpecialHoursRequest{}
+	mi := &file_venue_venue_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSpecialHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpecialHoursRequest) ProtoMessage() {}
+
+func (x *SetSpecialHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpecialHoursRequest.ProtoReflect.Descriptor instead.
+func (*SetSpecialHoursRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetSpecialHoursRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *SetSpecialHoursRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SetSpecialHoursRequest) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *SetSpecialHoursRequest) GetCloseTime() string {
+	if x != nil {
+		return x.CloseTime
+	}
+	return ""
+}
+
+func (x *SetSpecialHoursRequest) GetIsClosed() bool {
+	if x != nil {
+		return x.IsClosed
+	}
+	return false
+}
+
+type SetTurnTimeRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Rules         []*TurnTimeRule        `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"` // заменяют все текущие правила заведения
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTurnTimeRulesRequest) Reset() {
+	*x = SetTurnTimeRulesRequest{}
+	mi := &file_venue_venue_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTurnTimeRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTurnTimeRulesRequest) ProtoMessage() {}
+
+func (x *SetTurnTimeRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTurnTimeRulesRequest.ProtoReflect.Descriptor instead.
+func (*SetTurnTimeRulesRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetTurnTimeRulesRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *SetTurnTimeRulesRequest) GetRules() []*TurnTimeRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type GetTurnTimeRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTurnTimeRulesRequest) Reset() {
+	*x = GetTurnTimeRulesRequest{}
+	mi := &file_venue_venue_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTurnTimeRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTurnTimeRulesRequest) ProtoMessage() {}
+
+func (x *GetTurnTimeRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTurnTimeRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetTurnTimeRulesRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetTurnTimeRulesRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+type SetPacingRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Rules         []*PacingRule          `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"` // заменяют все текущие правила заведения
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPacingRulesRequest) Reset() {
+	*x = SetPacingRulesRequest{}
+	mi := &file_venue_venue_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPacingRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPacingRulesRequest) ProtoMessage() {}
+
+func (x *SetPacingRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPacingRulesRequest.ProtoReflect.Descriptor instead.
+func (*SetPacingRulesRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetPacingRulesRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *SetPacingRulesRequest) GetRules() []*PacingRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type GetPacingRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPacingRulesRequest) Reset() {
+	*x = GetPacingRulesRequest{}
+	mi := &file_venue_venue_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPacingRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPacingRulesRequest) ProtoMessage() {}
+
+func (x *GetPacingRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPacingRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetPacingRulesRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetPacingRulesRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+type ResolveTurnTimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`                            // YYYY-MM-DD
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // HH:MM
+	PartySize     int32                  `protobuf:"varint,4,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveTurnTimeRequest) Reset() {
+	*x = ResolveTurnTimeRequest{}
+	mi := &file_venue_venue_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveTurnTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveTurnTimeRequest) ProtoMessage() {}
+
+func (x *ResolveTurnTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveTurnTimeRequest.ProtoReflect.Descriptor instead.
+func (*ResolveTurnTimeRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ResolveTurnTimeRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *ResolveTurnTimeRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ResolveTurnTimeRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ResolveTurnTimeRequest) GetPartySize() int32 {
+	if x != nil {
+		return x.PartySize
+	}
+	return 0
+}
+
+type ResolveTurnTimeResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DurationMinutes int32                  `protobuf:"varint,1,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResolveTurnTimeResponse) Reset() {
+	*x = ResolveTurnTimeResponse{}
+	mi := &file_venue_venue_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveTurnTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveTurnTimeResponse) ProtoMessage() {}
+
+func (x *ResolveTurnTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveTurnTimeResponse.ProtoReflect.Descriptor instead.
+func (*ResolveTurnTimeResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ResolveTurnTimeResponse) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type CheckAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Slot          *common.Slot           `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	PartySize     int32                  `protobuf:"varint,3,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"`
+	PreferredZone string                 `protobuf:"bytes,4,opt,name=preferred_zone,json=preferredZone,proto3" json:"preferred_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAvailabilityRequest) Reset() {
+	*x = CheckAvailabilityRequest{}
+	mi := &file_venue_venue_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAvailabilityRequest) ProtoMessage() {}
+
+func (x *CheckAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*CheckAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CheckAvailabilityRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *CheckAvailabilityRequest) GetSlot() *common.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *CheckAvailabilityRequest) GetPartySize() int32 {
+	if x != nil {
+		return x.PartySize
+	}
+	return 0
+}
+
+func (x *CheckAvailabilityRequest) GetPreferredZone() string {
+	if x != nil {
+		return x.PreferredZone
+	}
+	return ""
+}
+
+type CheckAvailabilityResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Tables              []*TableAvailability   `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	SuggestedAssignment []*TableAssignment     `protobuf:"bytes,2,rep,name=suggested_assignment,json=suggestedAssignment,proto3" json:"suggested_assignment,omitempty"`                                                                           // варианты из tables, лучший первым
+	PacingRejection     *PacingRejection       `protobuf:"bytes,3,opt,name=pacing_rejection,json=pacingRejection,proto3" json:"pacing_rejection,omitempty"`                                                                                       // задано, если время закрыто правилами темпа посадки
+	TableBufferMinutes  map[string]int32       `protobuf:"bytes,4,rep,name=table_buffer_minutes,json=tableBufferMinutes,proto3" json:"table_buffer_minutes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // буфер после брони для каждого стола заведения
+	PacingLimits        []*PacingLimit         `protobuf:"bytes,5,rep,name=pacing_limits,json=pacingLimits,proto3" json:"pacing_limits,omitempty"`                                                                                                // booking-svc перепроверяет их при записи брони
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CheckAvailabilityResponse) Reset() {
+	*x = CheckAvailabilityResponse{}
+	mi := &file_venue_venue_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAvailabilityResponse) ProtoMessage() {}
+
+func (x *CheckAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*CheckAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CheckAvailabilityResponse) GetTables() []*TableAvailability {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *CheckAvailabilityResponse) GetSuggestedAssignment() []*TableAssignment {
+	if x != nil {
+		return x.SuggestedAssignment
+	}
+	return nil
+}
+
+func (x *CheckAvailabilityResponse) GetPacingRejection() *PacingRejection {
+	if x != nil {
+		return x.PacingRejection
+	}
+	return nil
+}
+
+func (x *CheckAvailabilityResponse) GetTableBufferMinutes() map[string]int32 {
+	if x != nil {
+		return x.TableBufferMinutes
+	}
+	return nil
+}
+
+func (x *CheckAvailabilityResponse) GetPacingLimits() []*PacingLimit {
+	if x != nil {
+		return x.PacingLimits
+	}
+	return nil
+}
+
+// Предложенная рассадка: стол или комбинация столов с оценкой
+type TableAssignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tables        []*common.TableRef     `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"` // первый - основной стол брони
+	CombinationId string                 `protobuf:"bytes,2,opt,name=combination_id,json=combinationId,proto3" json:"combination_id,omitempty"`
+	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Score         float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"` // 0-100, больше - лучше
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableAssignment) Reset() {
+	*x = TableAssignment{}
+	mi := &file_venue_venue_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableAssignment) ProtoMessage() {}
+
+func (x *TableAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableAssignment.ProtoReflect.Descriptor instead.
+func (*TableAssignment) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TableAssignment) GetTables() []*common.TableRef {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *TableAssignment) GetCombinationId() string {
+	if x != nil {
+		return x.CombinationId
+	}
+	return ""
+}
+
+func (x *TableAssignment) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *TableAssignment) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TableAssignment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TableAvailability struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Table           *common.TableRef       `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Available       bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	MergedWithTable *common.TableRef       `protobuf:"bytes,4,opt,name=merged_with_table,json=mergedWithTable,proto3" json:"merged_with_table,omitempty"` // If this table can be merged with another table
+	CombinedTables  []*common.TableRef     `protobuf:"bytes,5,rep,name=combined_tables,json=combinedTables,proto3" json:"combined_tables,omitempty"`      // all tables of a combination, including table
+	CombinationId   string                 `protobuf:"bytes,6,opt,name=combination_id,json=combinationId,proto3" json:"combination_id,omitempty"`         // empty for ad-hoc pairs of can_merge tables
+	Capacity        int32                  `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TableAvailability) Reset() {
+	*x = TableAvailability{}
+	mi := &file_venue_venue_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableAvailability) ProtoMessage() {}
+
+func (x *TableAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableAvailability.ProtoReflect.Descriptor instead.
+func (*TableAvailability) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TableAvailability) GetTable() *common.TableRef {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *TableAvailability) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *TableAvailability) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TableAvailability) GetMergedWithTable() *common.TableRef {
+	if x != nil {
+		return x.MergedWithTable
+	}
+	return nil
+}
+
+func (x *TableAvailability) GetCombinedTables() []*common.TableRef {
+	if x != nil {
+		return x.CombinedTables
+	}
+	return nil
+}
+
+func (x *TableAvailability) GetCombinationId() string {
+	if x != nil {
+		return x.CombinationId
+	}
+	return ""
+}
+
+func (x *TableAvailability) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+// Поиск всех свободных времен начала за день для компании
+type SearchSlotsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	VenueId         string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Date            string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	PartySize       int32                  `protobuf:"varint,3,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"`
+	WindowStart     string                 `protobuf:"bytes,4,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`              // HH:MM, по умолчанию время открытия
+	WindowEnd       string                 `protobuf:"bytes,5,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`                    // HH:MM включительно, по умолчанию последнее время посадки
+	IntervalMinutes int32                  `protobuf:"varint,6,opt,name=interval_minutes,json=intervalMinutes,proto3" json:"interval_minutes,omitempty"` // шаг, по умолчанию 15
+	DurationMinutes int32                  `protobuf:"varint,7,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"` // по умолчанию по правилам длительности посадки
+	PreferredZone   string                 `protobuf:"bytes,8,opt,name=preferred_zone,json=preferredZone,proto3" json:"preferred_zone,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchSlotsRequest) Reset() {
+	*x = SearchSlotsRequest{}
+	mi := &file_venue_venue_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSlotsRequest) ProtoMessage() {}
+
+func (x *SearchSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSlotsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SearchSlotsRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *SearchSlotsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SearchSlotsRequest) GetPartySize() int32 {
+	if x != nil {
+		return x.PartySize
+	}
+	return 0
+}
+
+func (x *SearchSlotsRequest) GetWindowStart() string {
+	if x != nil {
+		return x.WindowStart
+	}
+	return ""
+}
+
+func (x *SearchSlotsRequest) GetWindowEnd() string {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return ""
+}
+
+func (x *SearchSlotsRequest) GetIntervalMinutes() int32 {
+	if x != nil {
+		return x.IntervalMinutes
+	}
+	return 0
+}
+
+func (x *SearchSlotsRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *SearchSlotsRequest) GetPreferredZone() string {
+	if x != nil {
+		return x.PreferredZone
+	}
+	return ""
+}
+
+type SearchSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*AvailableSlot       `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	OpenTime      string                 `protobuf:"bytes,2,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"` // часы работы в этот день; пусто, если закрыто
+	CloseTime     string                 `protobuf:"bytes,3,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSlotsResponse) Reset() {
+	*x = SearchSlotsResponse{}
+	mi := &file_venue_venue_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSlotsResponse) ProtoMessage() {}
+
+func (x *SearchSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSlotsResponse.ProtoReflect.Descriptor instead.
+func (*SearchSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SearchSlotsResponse) GetSlots() []*AvailableSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *SearchSlotsResponse) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *SearchSlotsResponse) GetCloseTime() string {
+	if x != nil {
+		return x.CloseTime
+	}
+	return ""
+}
+
+type AvailableSlot struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	StartTime           string                 `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	DurationMinutes     int32                  `protobuf:"varint,2,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	SuggestedAssignment []*TableAssignment     `protobuf:"bytes,3,rep,name=suggested_assignment,json=suggestedAssignment,proto3" json:"suggested_assignment,omitempty"` // лучшие варианты рассадки
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AvailableSlot) Reset() {
+	*x = AvailableSlot{}
+	mi := &file_venue_venue_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailableSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableSlot) ProtoMessage() {}
+
+func (x *AvailableSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableSlot.ProtoReflect.Descriptor instead.
+func (*AvailableSlot) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AvailableSlot) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *AvailableSlot) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *AvailableSlot) GetSuggestedAssignment() []*TableAssignment {
+	if x != nil {
+		return x.SuggestedAssignment
+	}
+	return nil
+}
+
+type GetTableLayoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD, если задано - вернуть загрузку столов за день
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTableLayoutRequest) Reset() {
+	*x = GetTableLayoutRequest{}
+	mi := &file_venue_venue_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTableLayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTableLayoutRequest) ProtoMessage() {}
+
+func (x *GetTableLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTableLayoutRequest.ProtoReflect.Descriptor instead.
+func (*GetTableLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetTableLayoutRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *GetTableLayoutRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetTableLayoutRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type GetTableLayoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Tables        []*Table               `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
+	Room          *Room                  `protobuf:"bytes,3,opt,name=room,proto3" json:"room,omitempty"`
+	Timeline      []*TableTimeline       `protobuf:"bytes,4,rep,name=timeline,proto3" json:"timeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTableLayoutResponse) Reset() {
+	*x = GetTableLayoutResponse{}
+	mi := &file_venue_venue_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTableLayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTableLayoutResponse) ProtoMessage() {}
+
+func (x *GetTableLayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTableLayoutResponse.ProtoReflect.Descriptor instead.
+func (*GetTableLayoutResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetTableLayoutResponse) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetTableLayoutResponse) GetTables() []*Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *GetTableLayoutResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *GetTableLayoutResponse) GetTimeline() []*TableTimeline {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+// Брони и блокировки стола за день вместе с буфером на подготовку после каждой брони
+type TableTimeline struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	BufferMinutes int32                  `protobuf:"varint,2,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"`
+	Blocks        []*TimelineBlock       `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableTimeline) Reset() {
+	*x = TableTimeline{}
+	mi := &file_venue_venue_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableTimeline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableTimeline) ProtoMessage() {}
+
+func (x *TableTimeline) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableTimeline.ProtoReflect.Descriptor instead.
+func (*TableTimeline) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TableTimeline) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *TableTimeline) GetBufferMinutes() int32 {
+	if x != nil {
+		return x.BufferMinutes
+	}
+	return 0
+}
+
+func (x *TableTimeline) GetBlocks() []*TimelineBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type TimelineBlock struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BookingId string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Status    string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	PartySize int32                  `protobuf:"varint,3,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"`
+	StartTime string                 `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // HH:MM
+	EndTime   string                 `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	BufferEnd string                 `protobuf:"bytes,6,opt,name=buffer_end,json=bufferEnd,proto3" json:"buffer_end,omitempty"` // стол снова свободен
+	// Заполнены вместо booking_id, если это блокировка стола
+	TableBlockId string `protobuf:"bytes,7,opt,name=table_block_id,json=tableBlockId,proto3" json:"table_block_id,omitempty"`
+	Reason       string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Заполнен вместо booking_id, если стол занят арендой зала; reason - название мероприятия
+	RoomHireId    string `protobuf:"bytes,9,opt,name=room_hire_id,json=roomHireId,proto3" json:"room_hire_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineBlock) Reset() {
+	*x = TimelineBlock{}
+	mi := &file_venue_venue_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineBlock) ProtoMessage() {}
+
+func (x *TimelineBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineBlock.ProtoReflect.Descriptor instead.
+func (*TimelineBlock) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TimelineBlock) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *TimelineBlock) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TimelineBlock) GetPartySize() int32 {
+	if x != nil {
+		return x.PartySize
+	}
+	return 0
+}
+
+func (x *TimelineBlock) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *TimelineBlock) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *TimelineBlock) GetBufferEnd() string {
+	if x != nil {
+		return x.BufferEnd
+	}
+	return ""
+}
+
+func (x *TimelineBlock) GetTableBlockId() string {
+	if x != nil {
+		return x.TableBlockId
+	}
+	return ""
+}
+
+func (x *TimelineBlock) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TimelineBlock) GetRoomHireId() string {
+	if x != nil {
+		return x.RoomHireId
+	}
+	return ""
+}
+
+// Стол недоступен для броней с start_time до end_time. end_time не позже
+// start_time означает до конца дня, 00:00-00:00 - весь день
+type TableBlock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VenueId       string                 `protobuf:"bytes,2,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	TableId       string                 `protobuf:"bytes,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`                            // YYYY-MM-DD, первый день
+	StartTime     string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // HH:MM
+	EndTime       string                 `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Recurrence    string                 `protobuf:"bytes,8,opt,name=recurrence,proto3" json:"recurrence,omitempty"`                // "" - один раз, daily, weekly (в день недели date)
+	UntilDate     string                 `protobuf:"bytes,9,opt,name=until_date,json=untilDate,proto3" json:"until_date,omitempty"` // последний день повторения, пусто - бессрочно
+	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableBlock) Reset() {
+	*x = TableBlock{}
+	mi := &file_venue_venue_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableBlock) ProtoMessage() {}
+
+func (x *TableBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableBlock.ProtoReflect.Descriptor instead.
+func (*TableBlock) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TableBlock) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TableBlock) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *TableBlock) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *TableBlock) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *TableBlock) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *TableBlock) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *TableBlock) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TableBlock) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *TableBlock) GetUntilDate() string {
+	if x != nil {
+		return x.UntilDate
+	}
+	return ""
+}
+
+func (x *TableBlock) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *TableBlock) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateTableBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Recurrence    string                 `protobuf:"bytes,6,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	UntilDate     string                 `protobuf:"bytes,7,opt,name=until_date,json=untilDate,proto3" json:"until_date,omitempty"`
+	AdminId       string                 `protobuf:"bytes,8,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTableBlockRequest) Reset() {
+	*x = CreateTableBlockRequest{}
+	mi := &file_venue_venue_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTableBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTableBlockRequest) ProtoMessage() {}
+
+func (x *CreateTableBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTableBlockRequest.ProtoReflect.Descriptor instead.
+func (*CreateTableBlockRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateTableBlockRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *CreateTableBlockRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CreateTableBlockRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateTableBlockRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *CreateTableBlockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateTableBlockRequest) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *CreateTableBlockRequest) GetUntilDate() string {
+	if x != nil {
+		return x.UntilDate
+	}
+	return ""
+}
+
+func (x *CreateTableBlockRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+// Блокировки заведения или одного стола; с date - только действующие в этот день
+type ListTableBlocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTableBlocksRequest) Reset() {
+	*x = ListTableBlocksRequest{}
+	mi := &file_venue_venue_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTableBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTableBlocksRequest) ProtoMessage() {}
+
+func (x *ListTableBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTableBlocksRequest.ProtoReflect.Descriptor instead.
+func (*ListTableBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListTableBlocksRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *ListTableBlocksRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ListTableBlocksRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type ListTableBlocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blocks        []*TableBlock          `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTableBlocksResponse) Reset() {
+	*x = ListTableBlocksResponse{}
+	mi := &file_venue_venue_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTableBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTableBlocksResponse) ProtoMessage() {}
+
+func (x *ListTableBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTableBlocksResponse.ProtoReflect.Descriptor instead.
+func (*ListTableBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListTableBlocksResponse) GetBlocks() []*TableBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type DeleteTableBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTableBlockRequest) Reset() {
+	*x = DeleteTableBlockRequest{}
+	mi := &file_venue_venue_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTableBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTableBlockRequest) ProtoMessage() {}
+
+func (x *DeleteTableBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTableBlockRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTableBlockRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteTableBlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTableBlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTableBlockResponse) Reset() {
+	*x = DeleteTableBlockResponse{}
+	mi := &file_venue_venue_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTableBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTableBlockResponse) ProtoMessage() {}
+
+func (x *DeleteTableBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTableBlockResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTableBlockResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteTableBlockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Сохраняет план зала целиком. layout_version должен совпадать с текущей версией
+// зала, иначе план уже изменил кто-то другой и запрос отклоняется
+type SaveRoomLayoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	LayoutVersion int32                  `protobuf:"varint,2,opt,name=layout_version,json=layoutVersion,proto3" json:"layout_version,omitempty"`
+	Width         float64                `protobuf:"fixed64,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        float64                `protobuf:"fixed64,4,opt,name=height,proto3" json:"height,omitempty"`
+	BackgroundUrl string                 `protobuf:"bytes,5,opt,name=background_url,json=backgroundUrl,proto3" json:"background_url,omitempty"`
+	Tables        []*TablePlacement      `protobuf:"bytes,6,rep,name=tables,proto3" json:"tables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveRoomLayoutRequest) Reset() {
+	*x = SaveRoomLayoutRequest{}
+	mi := &file_venue_venue_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveRoomLayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveRoomLayoutRequest) ProtoMessage() {}
+
+func (x *SaveRoomLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveRoomLayoutRequest.ProtoReflect.Descriptor instead.
+func (*SaveRoomLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SaveRoomLayoutRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SaveRoomLayoutRequest) GetLayoutVersion() int32 {
+	if x != nil {
+		return x.LayoutVersion
+	}
+	return 0
+}
+
+func (x *SaveRoomLayoutRequest) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *SaveRoomLayoutRequest) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SaveRoomLayoutRequest) GetBackgroundUrl() string {
+	if x != nil {
+		return x.BackgroundUrl
+	}
+	return ""
+}
+
+func (x *SaveRoomLayoutRequest) GetTables() []*TablePlacement {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type TablePlacement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	Width         float64                `protobuf:"fixed64,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height        float64                `protobuf:"fixed64,5,opt,name=height,proto3" json:"height,omitempty"`
+	Shape         string                 `protobuf:"bytes,6,opt,name=shape,proto3" json:"shape,omitempty"`
+	Rotation      float64                `protobuf:"fixed64,7,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TablePlacement) Reset() {
+	*x = TablePlacement{}
+	mi := &file_venue_venue_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TablePlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TablePlacement) ProtoMessage() {}
+
+func (x *TablePlacement) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TablePlacement.ProtoReflect.Descriptor instead.
+func (*TablePlacement) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *TablePlacement) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *TablePlacement) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *TablePlacement) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *TablePlacement) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *TablePlacement) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TablePlacement) GetShape() string {
+	if x != nil {
+		return x.Shape
+	}
+	return ""
+}
+
+func (x *TablePlacement) GetRotation() float64 {
+	if x != nil {
+		return x.Rotation
+	}
+	return 0
+}
+
+// Responses
+type ListVenuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Venues        []*Venue               `protobuf:"bytes,1,rep,name=venues,proto3" json:"venues,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVenuesResponse) Reset() {
+	*x = ListVenuesResponse{}
+	mi := &file_venue_venue_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVenuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVenuesResponse) ProtoMessage() {}
+
+func (x *ListVenuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVenuesResponse.ProtoReflect.Descriptor instead.
+func (*ListVenuesResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListVenuesResponse) GetVenues() []*Venue {
+	if x != nil {
+		return x.Venues
+	}
+	return nil
+}
+
+func (x *ListVenuesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListRoomsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []*Room                `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomsResponse) Reset() {
+	*x = ListRoomsResponse{}
+	mi := &file_venue_venue_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsResponse) ProtoMessage() {}
+
+func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListRoomsResponse) GetRooms() []*Room {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *ListRoomsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListTablesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tables        []*Table               `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTablesResponse) Reset() {
+	*x = ListTablesResponse{}
+	mi := &file_venue_venue_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTablesResponse) ProtoMessage() {}
+
+func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTablesResponse.ProtoReflect.Descriptor instead.
+func (*ListTablesResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListTablesResponse) GetTables() []*Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *ListTablesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SetOpeningHoursResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOpeningHoursResponse) Reset() {
+	*x = SetOpeningHoursResponse{}
+	mi := &file_venue_venue_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOpeningHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOpeningHoursResponse) ProtoMessage() {}
+
+func (x *SetOpeningHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOpeningHoursResponse.ProtoReflect.Descriptor instead.
+func (*SetOpeningHoursResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SetOpeningHoursResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// success = false - удаление не выполнено, мешают брони из blocking_booking_ids
+type DeleteVenueResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Success              bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	BlockingBookingIds   []string               `protobuf:"bytes,2,rep,name=blocking_booking_ids,json=blockingBookingIds,proto3" json:"blocking_booking_ids,omitempty"`
+	CancelledBookingIds  []string               `protobuf:"bytes,3,rep,name=cancelled_booking_ids,json=cancelledBookingIds,proto3" json:"cancelled_booking_ids,omitempty"`
+	BlockingRoomHireIds  []string               `protobuf:"bytes,4,rep,name=blocking_room_hire_ids,json=blockingRoomHireIds,proto3" json:"blocking_room_hire_ids,omitempty"` // активные аренды залов заведения
+	CancelledRoomHireIds []string               `protobuf:"bytes,5,rep,name=cancelled_room_hire_ids,json=cancelledRoomHireIds,proto3" json:"cancelled_room_hire_ids,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DeleteVenueResponse) Reset() {
+	*x = DeleteVenueResponse{}
+	mi := &file_venue_venue_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVenueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVenueResponse) ProtoMessage() {}
+
+func (x *DeleteVenueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVenueResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVenueResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteVenueResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteVenueResponse) GetBlockingBookingIds() []string {
+	if x != nil {
+		return x.BlockingBookingIds
+	}
+	return nil
+}
+
+func (x *DeleteVenueResponse) GetCancelledBookingIds() []string {
+	if x != nil {
+		return x.CancelledBookingIds
+	}
+	return nil
+}
+
+func (x *DeleteVenueResponse) GetBlockingRoomHireIds() []string {
+	if x != nil {
+		return x.BlockingRoomHireIds
+	}
+	return nil
+}
+
+func (x *DeleteVenueResponse) GetCancelledRoomHireIds() []string {
+	if x != nil {
+		return x.CancelledRoomHireIds
+	}
+	return nil
+}
+
+type DeleteRoomResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Success              bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	BlockingBookingIds   []string               `protobuf:"bytes,2,rep,name=blocking_booking_ids,json=blockingBookingIds,proto3" json:"blocking_booking_ids,omitempty"`
+	CancelledBookingIds  []string               `protobuf:"bytes,3,rep,name=cancelled_booking_ids,json=cancelledBookingIds,proto3" json:"cancelled_booking_ids,omitempty"`
+	MovedBookingIds      []string               `protobuf:"bytes,4,rep,name=moved_booking_ids,json=movedBookingIds,proto3" json:"moved_booking_ids,omitempty"`
+	BlockingRoomHireIds  []string               `protobuf:"bytes,5,rep,name=blocking_room_hire_ids,json=blockingRoomHireIds,proto3" json:"blocking_room_hire_ids,omitempty"` // активные аренды зала
+	CancelledRoomHireIds []string               `protobuf:"bytes,6,rep,name=cancelled_room_hire_ids,json=cancelledRoomHireIds,proto3" json:"cancelled_room_hire_ids,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DeleteRoomResponse) Reset() {
+	*x = DeleteRoomResponse{}
+	mi := &file_venue_venue_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomResponse) ProtoMessage() {}
+
+func (x *DeleteRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoomResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteRoomResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteRoomResponse) GetBlockingBookingIds() []string {
+	if x != nil {
+		return x.BlockingBookingIds
+	}
+	return nil
+}
+
+func (x *DeleteRoomResponse) GetCancelledBookingIds() []string {
+	if x != nil {
+		return x.CancelledBookingIds
+	}
+	return nil
+}
+
+func (x *DeleteRoomResponse) GetMovedBookingIds() []string {
+	if x != nil {
+		return x.MovedBookingIds
+	}
+	return nil
+}
+
+func (x *DeleteRoomResponse) GetBlockingRoomHireIds() []string {
+	if x != nil {
+		return x.BlockingRoomHireIds
+	}
+	return nil
+}
+
+func (x *DeleteRoomResponse) GetCancelledRoomHireIds() []string {
+	if x != nil {
+		return x.CancelledRoomHireIds
+	}
+	return nil
+}
+
+type DeleteTableResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Success             bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	BlockingBookingIds  []string               `protobuf:"bytes,2,rep,name=blocking_booking_ids,json=blockingBookingIds,proto3" json:"blocking_booking_ids,omitempty"`
+	CancelledBookingIds []string               `protobuf:"bytes,3,rep,name=cancelled_booking_ids,json=cancelledBookingIds,proto3" json:"cancelled_booking_ids,omitempty"`
+	MovedBookingIds     []string               `protobuf:"bytes,4,rep,name=moved_booking_ids,json=movedBookingIds,proto3" json:"moved_booking_ids,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DeleteTableResponse) Reset() {
+	*x = DeleteTableResponse{}
+	mi := &file_venue_venue_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTableResponse) ProtoMessage() {}
+
+func (x *DeleteTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTableResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTableResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteTableResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteTableResponse) GetBlockingBookingIds() []string {
+	if x != nil {
+		return x.BlockingBookingIds
+	}
+	return nil
+}
+
+func (x *DeleteTableResponse) GetCancelledBookingIds() []string {
+	if x != nil {
+		return x.CancelledBookingIds
+	}
+	return nil
+}
+
+func (x *DeleteTableResponse) GetMovedBookingIds() []string {
+	if x != nil {
+		return x.MovedBookingIds
+	}
+	return nil
+}
+
+type ListTableCombinationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Combinations  []*TableCombination    `protobuf:"bytes,1,rep,name=combinations,proto3" json:"combinations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTableCombinationsResponse) Reset() {
+	*x = ListTableCombinationsResponse{}
+	mi := &file_venue_venue_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTableCombinationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTableCombinationsResponse) ProtoMessage() {}
+
+func (x *ListTableCombinationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTableCombinationsResponse.ProtoReflect.Descriptor instead.
+func (*ListTableCombinationsResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListTableCombinationsResponse) GetCombinations() []*TableCombination {
+	if x != nil {
+		return x.Combinations
+	}
+	return nil
+}
+
+type DeleteTableCombinationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTableCombinationResponse) Reset() {
+	*x = DeleteTableCombinationResponse{}
+	mi := &file_venue_venue_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTableCombinationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTableCombinationResponse) ProtoMessage() {}
+
+func (x *DeleteTableCombinationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTableCombinationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTableCombinationResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DeleteTableCombinationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetSpecialHoursResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSpecialHoursResponse) Reset() {
+	*x = SetSpecialHoursResponse{}
+	mi := &file_venue_venue_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSpecialHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpecialHoursResponse) ProtoMessage() {}
+
+func (x *SetSpecialHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpecialHoursResponse.ProtoReflect.Descriptor instead.
+func (*SetSpecialHoursResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SetSpecialHoursResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Массовая запись праздников в особые часы нескольких заведений. Праздники
+// берутся из holidays (например, из .ics файла), а если он пуст - из встроенного
+// календаря страны country за год year
+type ImportHolidaysRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	VenueIds []string               `protobuf:"bytes,1,rep,name=venue_ids,json=venueIds,proto3" json:"venue_ids,omitempty"`
+	Holidays []*Holiday             `protobuf:"bytes,2,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	Country  string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"` // RU, KZ
+	Year     int32                  `protobuf:"varint,4,opt,name=year,proto3" json:"year,omitempty"`      // 0 - текущий год
+	// Правило для всех праздников: закрыто весь день или особые часы работы
+	IsClosed      bool   `protobuf:"varint,5,opt,name=is_closed,json=isClosed,proto3" json:"is_closed,omitempty"`
+	OpenTime      string `protobuf:"bytes,6,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime     string `protobuf:"bytes,7,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	DryRun        bool   `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // только показать изменения, ничего не сохраняя
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportHolidaysRequest) Reset() {
+	*x = ImportHolidaysRequest{}
+	mi := &file_venue_venue_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportHolidaysRequest) ProtoMessage() {}
+
+func (x *ImportHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*ImportHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ImportHolidaysRequest) GetVenueIds() []string {
+	if x != nil {
+		return x.VenueIds
+	}
+	return nil
+}
+
+func (x *ImportHolidaysRequest) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+func (x *ImportHolidaysRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ImportHolidaysRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *ImportHolidaysRequest) GetIsClosed() bool {
+	if x != nil {
+		return x.IsClosed
+	}
+	return false
+}
+
+func (x *ImportHolidaysRequest) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *ImportHolidaysRequest) GetCloseTime() string {
 	if x != nil {
-		return x.Venues
+		return x.CloseTime
 	}
-	return nil
+	return ""
 }
 
-func (x *ListVenuesResponse) GetTotal() int32 {
+func (x *ImportHolidaysRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Total
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-type ListRoomsResponse struct {
+type Holiday struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rooms         []*Room                `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRoomsResponse) Reset() {
-	*x = ListRoomsResponse{}
-	mi := &file_venue_venue_proto_msgTypes[30]
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_venue_venue_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRoomsResponse) String() string {
+func (x *Holiday) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRoomsResponse) ProtoMessage() {}
+func (*Holiday) ProtoMessage() {}
 
-func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_venue_venue_proto_msgTypes[30]
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1817,48 +5042,50 @@ func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRoomsResponse.ProtoReflect.Descriptor instead.
-func (*ListRoomsResponse) Descriptor() ([]byte, []int) {
-	return file_venue_venue_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ListRoomsResponse) GetRooms() []*Room {
+func (x *Holiday) GetDate() string {
 	if x != nil {
-		return x.Rooms
+		return x.Date
 	}
-	return nil
+	return ""
 }
 
-func (x *ListRoomsResponse) GetTotal() int32 {
+func (x *Holiday) GetName() string {
 	if x != nil {
-		return x.Total
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type ListTablesResponse struct {
+type ImportHolidaysResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tables        []*Table               `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Changes       []*HolidayChange       `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged     int32                  `protobuf:"varint,4,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTablesResponse) Reset() {
-	*x = ListTablesResponse{}
-	mi := &file_venue_venue_proto_msgTypes[31]
+func (x *ImportHolidaysResponse) Reset() {
+	*x = ImportHolidaysResponse{}
+	mi := &file_venue_venue_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTablesResponse) String() string {
+func (x *ImportHolidaysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTablesResponse) ProtoMessage() {}
+func (*ImportHolidaysResponse) ProtoMessage() {}
 
-func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_venue_venue_proto_msgTypes[31]
+func (x *ImportHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1869,47 +5096,69 @@ func (x *ListTablesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTablesResponse.ProtoReflect.Descriptor instead.
-func (*ListTablesResponse) Descriptor() ([]byte, []int) {
-	return file_venue_venue_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ImportHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*ImportHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ListTablesResponse) GetTables() []*Table {
+func (x *ImportHolidaysResponse) GetChanges() []*HolidayChange {
 	if x != nil {
-		return x.Tables
+		return x.Changes
 	}
 	return nil
 }
 
-func (x *ListTablesResponse) GetTotal() int32 {
+func (x *ImportHolidaysResponse) GetCreated() int32 {
 	if x != nil {
-		return x.Total
+		return x.Created
 	}
 	return 0
 }
 
-type SetOpeningHoursResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ImportHolidaysResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
 }
 
-func (x *SetOpeningHoursResponse) Reset() {
-	*x = SetOpeningHoursResponse{}
-	mi := &file_venue_venue_proto_msgTypes[32]
+func (x *ImportHolidaysResponse) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+type HolidayChange struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	VenueId string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Date    string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Name    string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Status  string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // created, would_create, updated, would_update, unchanged
+	// Особые часы, которые были на эту дату до импорта
+	HadSpecialHours   bool   `protobuf:"varint,5,opt,name=had_special_hours,json=hadSpecialHours,proto3" json:"had_special_hours,omitempty"`
+	PreviousIsClosed  bool   `protobuf:"varint,6,opt,name=previous_is_closed,json=previousIsClosed,proto3" json:"previous_is_closed,omitempty"`
+	PreviousOpenTime  string `protobuf:"bytes,7,opt,name=previous_open_time,json=previousOpenTime,proto3" json:"previous_open_time,omitempty"`
+	PreviousCloseTime string `protobuf:"bytes,8,opt,name=previous_close_time,json=previousCloseTime,proto3" json:"previous_close_time,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *HolidayChange) Reset() {
+	*x = HolidayChange{}
+	mi := &file_venue_venue_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetOpeningHoursResponse) String() string {
+func (x *HolidayChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetOpeningHoursResponse) ProtoMessage() {}
+func (*HolidayChange) ProtoMessage() {}
 
-func (x *SetOpeningHoursResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_venue_venue_proto_msgTypes[32]
+func (x *HolidayChange) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1920,84 +5169,90 @@ func (x *SetOpeningHoursResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetOpeningHoursResponse.ProtoReflect.Descriptor instead.
-func (*SetOpeningHoursResponse) Descriptor() ([]byte, []int) {
-	return file_venue_venue_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use HolidayChange.ProtoReflect.Descriptor instead.
+func (*HolidayChange) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *SetOpeningHoursResponse) GetSuccess() bool {
+func (x *HolidayChange) GetVenueId() string {
 	if x != nil {
-		return x.Success
+		return x.VenueId
 	}
-	return false
+	return ""
 }
 
-type DeleteVenueResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *HolidayChange) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
 }
 
-func (x *DeleteVenueResponse) Reset() {
-	*x = DeleteVenueResponse{}
-	mi := &file_venue_venue_proto_msgTypes[33]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *HolidayChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *DeleteVenueResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *HolidayChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (*DeleteVenueResponse) ProtoMessage() {}
+func (x *HolidayChange) GetHadSpecialHours() bool {
+	if x != nil {
+		return x.HadSpecialHours
+	}
+	return false
+}
 
-func (x *DeleteVenueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_venue_venue_proto_msgTypes[33]
+func (x *HolidayChange) GetPreviousIsClosed() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PreviousIsClosed
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use DeleteVenueResponse.ProtoReflect.Descriptor instead.
-func (*DeleteVenueResponse) Descriptor() ([]byte, []int) {
-	return file_venue_venue_proto_rawDescGZIP(), []int{33}
+func (x *HolidayChange) GetPreviousOpenTime() string {
+	if x != nil {
+		return x.PreviousOpenTime
+	}
+	return ""
 }
 
-func (x *DeleteVenueResponse) GetSuccess() bool {
+func (x *HolidayChange) GetPreviousCloseTime() string {
 	if x != nil {
-		return x.Success
+		return x.PreviousCloseTime
 	}
-	return false
+	return ""
 }
 
-type DeleteRoomResponse struct {
+type GetDayScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRoomResponse) Reset() {
-	*x = DeleteRoomResponse{}
-	mi := &file_venue_venue_proto_msgTypes[34]
+func (x *GetDayScheduleRequest) Reset() {
+	*x = GetDayScheduleRequest{}
+	mi := &file_venue_venue_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRoomResponse) String() string {
+func (x *GetDayScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRoomResponse) ProtoMessage() {}
+func (*GetDayScheduleRequest) ProtoMessage() {}
 
-func (x *DeleteRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_venue_venue_proto_msgTypes[34]
+func (x *GetDayScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,40 +5263,51 @@ func (x *DeleteRoomResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRoomResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRoomResponse) Descriptor() ([]byte, []int) {
-	return file_venue_venue_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use GetDayScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetDayScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *DeleteRoomResponse) GetSuccess() bool {
+func (x *GetDayScheduleRequest) GetVenueId() string {
 	if x != nil {
-		return x.Success
+		return x.VenueId
 	}
-	return false
+	return ""
 }
 
-type DeleteTableResponse struct {
+func (x *GetDayScheduleRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// Часы работы на конкретную дату с учетом особых дней
+type DaySchedule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Closed        bool                   `protobuf:"varint,2,opt,name=closed,proto3" json:"closed,omitempty"`
+	OpenTime      string                 `protobuf:"bytes,3,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`    // HH:MM
+	CloseTime     string                 `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"` // HH:MM, 24:00 - до полуночи
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTableResponse) Reset() {
-	*x = DeleteTableResponse{}
-	mi := &file_venue_venue_proto_msgTypes[35]
+func (x *DaySchedule) Reset() {
+	*x = DaySchedule{}
+	mi := &file_venue_venue_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTableResponse) String() string {
+func (x *DaySchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTableResponse) ProtoMessage() {}
+func (*DaySchedule) ProtoMessage() {}
 
-func (x *DeleteTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_venue_venue_proto_msgTypes[35]
+func (x *DaySchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2052,67 +5318,44 @@ func (x *DeleteTableResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTableResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTableResponse) Descriptor() ([]byte, []int) {
-	return file_venue_venue_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use DaySchedule.ProtoReflect.Descriptor instead.
+func (*DaySchedule) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *DeleteTableResponse) GetSuccess() bool {
+func (x *DaySchedule) GetDate() string {
 	if x != nil {
-		return x.Success
+		return x.Date
 	}
-	return false
-}
-
-type SetSpecialHoursResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SetSpecialHoursResponse) Reset() {
-	*x = SetSpecialHoursResponse{}
-	mi := &file_venue_venue_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SetSpecialHoursResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*SetSpecialHoursResponse) ProtoMessage() {}
-
-func (x *SetSpecialHoursResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_venue_venue_proto_msgTypes[36]
+func (x *DaySchedule) GetClosed() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Closed
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use SetSpecialHoursResponse.ProtoReflect.Descriptor instead.
-func (*SetSpecialHoursResponse) Descriptor() ([]byte, []int) {
-	return file_venue_venue_proto_rawDescGZIP(), []int{36}
+func (x *DaySchedule) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
 }
 
-func (x *SetSpecialHoursResponse) GetSuccess() bool {
+func (x *DaySchedule) GetCloseTime() string {
 	if x != nil {
-		return x.Success
+		return x.CloseTime
 	}
-	return false
+	return ""
 }
 
 var File_venue_venue_proto protoreflect.FileDescriptor
 
 const file_venue_venue_proto_rawDesc = "" +
 	"\n" +
-	"\x11venue/venue.proto\x12\x05venue\x1a\x13common/events.proto\"\x9f\x01\n" +
+	"\x11venue/venue.proto\x12\x05venue\x1a\x13common/events.proto\"\xe5\x01\n" +
 	"\x05Venue\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -2121,7 +5364,10 @@ const file_venue_venue_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\"\x83\x01\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\x12%\n" +
+	"\x0ebuffer_minutes\x18\a \x01(\x05R\rbufferMinutes\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\x03R\tdeletedAt\"\x9e\x02\n" +
 	"\x04Room\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bvenue_id\x18\x02 \x01(\tR\avenueId\x12\x12\n" +
@@ -2129,7 +5375,14 @@ const file_venue_venue_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\"\xcf\x01\n" +
+	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\x12\x14\n" +
+	"\x05width\x18\x06 \x01(\x01R\x05width\x12\x16\n" +
+	"\x06height\x18\a \x01(\x01R\x06height\x12%\n" +
+	"\x0ebackground_url\x18\b \x01(\tR\rbackgroundUrl\x12%\n" +
+	"\x0elayout_version\x18\t \x01(\x05R\rlayoutVersion\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\n" +
+	" \x01(\x03R\tdeletedAt\"\x91\x03\n" +
 	"\x05Table\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12\x12\n" +
@@ -2140,7 +5393,26 @@ const file_venue_venue_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\x03R\tupdatedAt\"N\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt\x12\f\n" +
+	"\x01x\x18\t \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\n" +
+	" \x01(\x01R\x01y\x12\x14\n" +
+	"\x05width\x18\v \x01(\x01R\x05width\x12\x16\n" +
+	"\x06height\x18\f \x01(\x01R\x06height\x12\x14\n" +
+	"\x05shape\x18\r \x01(\tR\x05shape\x12\x1a\n" +
+	"\brotation\x18\x0e \x01(\x01R\brotation\x12%\n" +
+	"\x0ebuffer_minutes\x18\x0f \x01(\x05R\rbufferMinutes\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x10 \x01(\x03R\tdeletedAt\"\xd1\x01\n" +
+	"\x10TableCombination\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\ttable_ids\x18\x04 \x03(\tR\btableIds\x12!\n" +
+	"\fmin_capacity\x18\x05 \x01(\x05R\vminCapacity\x12!\n" +
+	"\fmax_capacity\x18\x06 \x01(\x05R\vmaxCapacity\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\"N\n" +
 	"\fOpeningHours\x12\x19\n" +
 	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12#\n" +
 	"\x04days\x18\x02 \x03(\v2\x0f.venue.DayHoursR\x04days\"`\n" +
@@ -2148,63 +5420,140 @@ const file_venue_venue_proto_rawDesc = "" +
 	"\aweekday\x18\x01 \x01(\x05R\aweekday\x12\x1b\n" +
 	"\topen_time\x18\x02 \x01(\tR\bopenTime\x12\x1d\n" +
 	"\n" +
-	"close_time\x18\x03 \x01(\tR\tcloseTime\"\x96\x01\n" +
+	"close_time\x18\x03 \x01(\tR\tcloseTime\"\x88\x02\n" +
+	"\fTurnTimeRule\x12$\n" +
+	"\x0emin_party_size\x18\x01 \x01(\x05R\fminPartySize\x12$\n" +
+	"\x0emax_party_size\x18\x02 \x01(\x05R\fmaxPartySize\x12\x1a\n" +
+	"\bweekdays\x18\x03 \x03(\x05R\bweekdays\x12!\n" +
+	"\fperiod_start\x18\x04 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x05 \x01(\tR\tperiodEnd\x12)\n" +
+	"\x10duration_minutes\x18\x06 \x01(\x05R\x0fdurationMinutes\x12#\n" +
+	"\rextra_minutes\x18\a \x01(\x05R\fextraMinutes\"\x8f\x01\n" +
+	"\rTurnTimeRules\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12)\n" +
+	"\x05rules\x18\x02 \x03(\v2\x13.venue.TurnTimeRuleR\x05rules\x128\n" +
+	"\x18default_duration_minutes\x18\x03 \x01(\x05R\x16defaultDurationMinutes\"\xd7\x01\n" +
+	"\n" +
+	"PacingRule\x12)\n" +
+	"\x10interval_minutes\x18\x01 \x01(\x05R\x0fintervalMinutes\x12\x1a\n" +
+	"\bweekdays\x18\x02 \x03(\x05R\bweekdays\x12!\n" +
+	"\fperiod_start\x18\x03 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x04 \x01(\tR\tperiodEnd\x12\x1d\n" +
+	"\n" +
+	"max_covers\x18\x05 \x01(\x05R\tmaxCovers\x12!\n" +
+	"\fmax_bookings\x18\x06 \x01(\x05R\vmaxBookings\"Q\n" +
+	"\vPacingRules\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12'\n" +
+	"\x05rules\x18\x02 \x03(\v2\x11.venue.PacingRuleR\x05rules\"\xe9\x01\n" +
+	"\x0fPacingRejection\x12%\n" +
+	"\x0einterval_start\x18\x01 \x01(\tR\rintervalStart\x12!\n" +
+	"\finterval_end\x18\x02 \x01(\tR\vintervalEnd\x12\x16\n" +
+	"\x06covers\x18\x03 \x01(\x05R\x06covers\x12\x1d\n" +
+	"\n" +
+	"max_covers\x18\x04 \x01(\x05R\tmaxCovers\x12\x1a\n" +
+	"\bbookings\x18\x05 \x01(\x05R\bbookings\x12!\n" +
+	"\fmax_bookings\x18\x06 \x01(\x05R\vmaxBookings\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"\x99\x01\n" +
+	"\vPacingLimit\x12%\n" +
+	"\x0einterval_start\x18\x01 \x01(\tR\rintervalStart\x12!\n" +
+	"\finterval_end\x18\x02 \x01(\tR\vintervalEnd\x12\x1d\n" +
+	"\n" +
+	"max_covers\x18\x03 \x01(\x05R\tmaxCovers\x12!\n" +
+	"\fmax_bookings\x18\x04 \x01(\x05R\vmaxBookings\"\x96\x01\n" +
 	"\fSpecialHours\x12\x19\n" +
 	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x12\n" +
 	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1b\n" +
 	"\topen_time\x18\x03 \x01(\tR\bopenTime\x12\x1d\n" +
 	"\n" +
 	"close_time\x18\x04 \x01(\tR\tcloseTime\x12\x1b\n" +
-	"\tis_closed\x18\x05 \x01(\bR\bisClosed\"^\n" +
+	"\tis_closed\x18\x05 \x01(\bR\bisClosed\"\x85\x01\n" +
 	"\x12CreateVenueRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\btimezone\x18\x02 \x01(\tR\btimezone\x12\x18\n" +
-	"\aaddress\x18\x03 \x01(\tR\aaddress\"!\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12%\n" +
+	"\x0ebuffer_minutes\x18\x04 \x01(\x05R\rbufferMinutes\"!\n" +
 	"\x0fGetVenueRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"A\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"j\n" +
 	"\x11ListVenuesRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"R\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\"y\n" +
 	"\x12UpdateVenueRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
-	"\aaddress\x18\x03 \x01(\tR\aaddress\"$\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12%\n" +
+	"\x0ebuffer_minutes\x18\x04 \x01(\x05R\rbufferMinutes\"U\n" +
 	"\x12DeleteVenueRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\x12\x19\n" +
+	"\badmin_id\x18\x03 \x01(\tR\aadminId\"B\n" +
 	"\x11CreateRoomRequest\x12\x19\n" +
 	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\" \n" +
 	"\x0eGetRoomRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"[\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x84\x01\n" +
 	"\x10ListRoomsRequest\x12\x19\n" +
 	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"7\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12'\n" +
+	"\x0finclude_deleted\x18\x04 \x01(\bR\x0eincludeDeleted\"7\n" +
 	"\x11UpdateRoomRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"#\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x96\x01\n" +
 	"\x11DeleteRoomRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x8e\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\x12@\n" +
+	"\rreassignments\x18\x03 \x03(\v2\x1a.venue.BookingReassignmentR\rreassignments\x12\x19\n" +
+	"\badmin_id\x18\x04 \x01(\tR\aadminId\"\xb5\x01\n" +
 	"\x12CreateTableRequest\x12\x17\n" +
 	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
 	"\bcapacity\x18\x03 \x01(\x05R\bcapacity\x12\x1b\n" +
 	"\tcan_merge\x18\x04 \x01(\bR\bcanMerge\x12\x12\n" +
-	"\x04zone\x18\x05 \x01(\tR\x04zone\"!\n" +
+	"\x04zone\x18\x05 \x01(\tR\x04zone\x12%\n" +
+	"\x0ebuffer_minutes\x18\x06 \x01(\x05R\rbufferMinutes\"\xaf\x01\n" +
+	"\x1dCreateTableCombinationRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\ttable_ids\x18\x03 \x03(\tR\btableIds\x12!\n" +
+	"\fmin_capacity\x18\x04 \x01(\x05R\vminCapacity\x12!\n" +
+	"\fmax_capacity\x18\x05 \x01(\x05R\vmaxCapacity\"R\n" +
+	"\x1cListTableCombinationsRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x19\n" +
+	"\bvenue_id\x18\x02 \x01(\tR\avenueId\"/\n" +
+	"\x1dDeleteTableCombinationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"!\n" +
 	"\x0fGetTableRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"u\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9e\x01\n" +
 	"\x11ListTablesRequest\x12\x17\n" +
 	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x19\n" +
 	"\bvenue_id\x18\x02 \x01(\tR\avenueId\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offset\"h\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\"\x8f\x01\n" +
 	"\x12UpdateTableRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
 	"\bcapacity\x18\x03 \x01(\x05R\bcapacity\x12\x12\n" +
-	"\x04zone\x18\x04 \x01(\tR\x04zone\"$\n" +
+	"\x04zone\x18\x04 \x01(\tR\x04zone\x12%\n" +
+	"\x0ebuffer_minutes\x18\x05 \x01(\x05R\rbufferMinutes\"\x97\x01\n" +
 	"\x12DeleteTableRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"X\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\x12@\n" +
+	"\rreassignments\x18\x03 \x03(\v2\x1a.venue.BookingReassignmentR\rreassignments\x12\x19\n" +
+	"\badmin_id\x18\x04 \x01(\tR\aadminId\"%\n" +
+	"\x13RestoreVenueRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"$\n" +
+	"\x12RestoreRoomRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"%\n" +
+	"\x13RestoreTableRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Q\n" +
+	"\x13BookingReassignment\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x1b\n" +
+	"\ttable_ids\x18\x02 \x03(\tR\btableIds\"X\n" +
 	"\x16SetOpeningHoursRequest\x12\x19\n" +
 	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12#\n" +
 	"\x04days\x18\x02 \x03(\v2\x0f.venue.DayHoursR\x04days\"3\n" +
@@ -2216,25 +5565,162 @@ const file_venue_venue_proto_rawDesc = "" +
 	"\topen_time\x18\x03 \x01(\tR\bopenTime\x12\x1d\n" +
 	"\n" +
 	"close_time\x18\x04 \x01(\tR\tcloseTime\x12\x1b\n" +
-	"\tis_closed\x18\x05 \x01(\bR\bisClosed\"v\n" +
+	"\tis_closed\x18\x05 \x01(\bR\bisClosed\"_\n" +
+	"\x17SetTurnTimeRulesRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12)\n" +
+	"\x05rules\x18\x02 \x03(\v2\x13.venue.TurnTimeRuleR\x05rules\"4\n" +
+	"\x17GetTurnTimeRulesRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\"[\n" +
+	"\x15SetPacingRulesRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12'\n" +
+	"\x05rules\x18\x02 \x03(\v2\x11.venue.PacingRuleR\x05rules\"2\n" +
+	"\x15GetPacingRulesRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\"\x85\x01\n" +
+	"\x16ResolveTurnTimeRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tR\tstartTime\x12\x1d\n" +
+	"\n" +
+	"party_size\x18\x04 \x01(\x05R\tpartySize\"D\n" +
+	"\x17ResolveTurnTimeResponse\x12)\n" +
+	"\x10duration_minutes\x18\x01 \x01(\x05R\x0fdurationMinutes\"\x9d\x01\n" +
 	"\x18CheckAvailabilityRequest\x12\x19\n" +
 	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12 \n" +
 	"\x04slot\x18\x02 \x01(\v2\f.common.SlotR\x04slot\x12\x1d\n" +
 	"\n" +
-	"party_size\x18\x03 \x01(\x05R\tpartySize\"M\n" +
+	"party_size\x18\x03 \x01(\x05R\tpartySize\x12%\n" +
+	"\x0epreferred_zone\x18\x04 \x01(\tR\rpreferredZone\"\xc7\x03\n" +
 	"\x19CheckAvailabilityResponse\x120\n" +
-	"\x06tables\x18\x01 \x03(\v2\x18.venue.TableAvailabilityR\x06tables\"\xaf\x01\n" +
+	"\x06tables\x18\x01 \x03(\v2\x18.venue.TableAvailabilityR\x06tables\x12I\n" +
+	"\x14suggested_assignment\x18\x02 \x03(\v2\x16.venue.TableAssignmentR\x13suggestedAssignment\x12A\n" +
+	"\x10pacing_rejection\x18\x03 \x01(\v2\x16.venue.PacingRejectionR\x0fpacingRejection\x12j\n" +
+	"\x14table_buffer_minutes\x18\x04 \x03(\v28.venue.CheckAvailabilityResponse.TableBufferMinutesEntryR\x12tableBufferMinutes\x127\n" +
+	"\rpacing_limits\x18\x05 \x03(\v2\x12.venue.PacingLimitR\fpacingLimits\x1aE\n" +
+	"\x17TableBufferMinutesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xac\x01\n" +
+	"\x0fTableAssignment\x12(\n" +
+	"\x06tables\x18\x01 \x03(\v2\x10.common.TableRefR\x06tables\x12%\n" +
+	"\x0ecombination_id\x18\x02 \x01(\tR\rcombinationId\x12\x1a\n" +
+	"\bcapacity\x18\x03 \x01(\x05R\bcapacity\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xad\x02\n" +
 	"\x11TableAvailability\x12&\n" +
 	"\x05table\x18\x01 \x01(\v2\x10.common.TableRefR\x05table\x12\x1c\n" +
 	"\tavailable\x18\x02 \x01(\bR\tavailable\x12\x16\n" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\x12<\n" +
-	"\x11merged_with_table\x18\x04 \x01(\v2\x10.common.TableRefR\x0fmergedWithTable\"K\n" +
+	"\x11merged_with_table\x18\x04 \x01(\v2\x10.common.TableRefR\x0fmergedWithTable\x129\n" +
+	"\x0fcombined_tables\x18\x05 \x03(\v2\x10.common.TableRefR\x0ecombinedTables\x12%\n" +
+	"\x0ecombination_id\x18\x06 \x01(\tR\rcombinationId\x12\x1a\n" +
+	"\bcapacity\x18\a \x01(\x05R\bcapacity\"\xa1\x02\n" +
+	"\x12SearchSlotsRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"party_size\x18\x03 \x01(\x05R\tpartySize\x12!\n" +
+	"\fwindow_start\x18\x04 \x01(\tR\vwindowStart\x12\x1d\n" +
+	"\n" +
+	"window_end\x18\x05 \x01(\tR\twindowEnd\x12)\n" +
+	"\x10interval_minutes\x18\x06 \x01(\x05R\x0fintervalMinutes\x12)\n" +
+	"\x10duration_minutes\x18\a \x01(\x05R\x0fdurationMinutes\x12%\n" +
+	"\x0epreferred_zone\x18\b \x01(\tR\rpreferredZone\"}\n" +
+	"\x13SearchSlotsResponse\x12*\n" +
+	"\x05slots\x18\x01 \x03(\v2\x14.venue.AvailableSlotR\x05slots\x12\x1b\n" +
+	"\topen_time\x18\x02 \x01(\tR\bopenTime\x12\x1d\n" +
+	"\n" +
+	"close_time\x18\x03 \x01(\tR\tcloseTime\"\xa4\x01\n" +
+	"\rAvailableSlot\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\tR\tstartTime\x12)\n" +
+	"\x10duration_minutes\x18\x02 \x01(\x05R\x0fdurationMinutes\x12I\n" +
+	"\x14suggested_assignment\x18\x03 \x03(\v2\x16.venue.TableAssignmentR\x13suggestedAssignment\"_\n" +
 	"\x15GetTableLayoutRequest\x12\x19\n" +
 	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x17\n" +
-	"\aroom_id\x18\x02 \x01(\tR\x06roomId\"W\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\"\xaa\x01\n" +
 	"\x16GetTableLayoutResponse\x12\x17\n" +
 	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12$\n" +
-	"\x06tables\x18\x02 \x03(\v2\f.venue.TableR\x06tables\"P\n" +
+	"\x06tables\x18\x02 \x03(\v2\f.venue.TableR\x06tables\x12\x1f\n" +
+	"\x04room\x18\x03 \x01(\v2\v.venue.RoomR\x04room\x120\n" +
+	"\btimeline\x18\x04 \x03(\v2\x14.venue.TableTimelineR\btimeline\"\x7f\n" +
+	"\rTableTimeline\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12%\n" +
+	"\x0ebuffer_minutes\x18\x02 \x01(\x05R\rbufferMinutes\x12,\n" +
+	"\x06blocks\x18\x03 \x03(\v2\x14.venue.TimelineBlockR\x06blocks\"\x9e\x02\n" +
+	"\rTimelineBlock\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"party_size\x18\x03 \x01(\x05R\tpartySize\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x05 \x01(\tR\aendTime\x12\x1d\n" +
+	"\n" +
+	"buffer_end\x18\x06 \x01(\tR\tbufferEnd\x12$\n" +
+	"\x0etable_block_id\x18\a \x01(\tR\ftableBlockId\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12 \n" +
+	"\froom_hire_id\x18\t \x01(\tR\n" +
+	"roomHireId\"\xb5\x02\n" +
+	"\n" +
+	"TableBlock\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bvenue_id\x18\x02 \x01(\tR\avenueId\x12\x19\n" +
+	"\btable_id\x18\x03 \x01(\tR\atableId\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x06 \x01(\tR\aendTime\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\b \x01(\tR\n" +
+	"recurrence\x12\x1d\n" +
+	"\n" +
+	"until_date\x18\t \x01(\tR\tuntilDate\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\"\xf4\x01\n" +
+	"\x17CreateTableBlockRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\tR\aendTime\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\x06 \x01(\tR\n" +
+	"recurrence\x12\x1d\n" +
+	"\n" +
+	"until_date\x18\a \x01(\tR\tuntilDate\x12\x19\n" +
+	"\badmin_id\x18\b \x01(\tR\aadminId\"b\n" +
+	"\x16ListTableBlocksRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\"D\n" +
+	"\x17ListTableBlocksResponse\x12)\n" +
+	"\x06blocks\x18\x01 \x03(\v2\x11.venue.TableBlockR\x06blocks\")\n" +
+	"\x17DeleteTableBlockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x18DeleteTableBlockResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xdb\x01\n" +
+	"\x15SaveRoomLayoutRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12%\n" +
+	"\x0elayout_version\x18\x02 \x01(\x05R\rlayoutVersion\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x01R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x01R\x06height\x12%\n" +
+	"\x0ebackground_url\x18\x05 \x01(\tR\rbackgroundUrl\x12-\n" +
+	"\x06tables\x18\x06 \x03(\v2\x15.venue.TablePlacementR\x06tables\"\xa7\x01\n" +
+	"\x0eTablePlacement\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x01R\x01y\x12\x14\n" +
+	"\x05width\x18\x04 \x01(\x01R\x05width\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x01R\x06height\x12\x14\n" +
+	"\x05shape\x18\x06 \x01(\tR\x05shape\x12\x1a\n" +
+	"\brotation\x18\a \x01(\x01R\brotation\"P\n" +
 	"\x12ListVenuesResponse\x12$\n" +
 	"\x06venues\x18\x01 \x03(\v2\f.venue.VenueR\x06venues\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"L\n" +
@@ -2245,23 +5731,75 @@ const file_venue_venue_proto_rawDesc = "" +
 	"\x06tables\x18\x01 \x03(\v2\f.venue.TableR\x06tables\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"3\n" +
 	"\x17SetOpeningHoursResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"/\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x81\x02\n" +
 	"\x13DeleteVenueResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\".\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x120\n" +
+	"\x14blocking_booking_ids\x18\x02 \x03(\tR\x12blockingBookingIds\x122\n" +
+	"\x15cancelled_booking_ids\x18\x03 \x03(\tR\x13cancelledBookingIds\x123\n" +
+	"\x16blocking_room_hire_ids\x18\x04 \x03(\tR\x13blockingRoomHireIds\x125\n" +
+	"\x17cancelled_room_hire_ids\x18\x05 \x03(\tR\x14cancelledRoomHireIds\"\xac\x02\n" +
 	"\x12DeleteRoomResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"/\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x120\n" +
+	"\x14blocking_booking_ids\x18\x02 \x03(\tR\x12blockingBookingIds\x122\n" +
+	"\x15cancelled_booking_ids\x18\x03 \x03(\tR\x13cancelledBookingIds\x12*\n" +
+	"\x11moved_booking_ids\x18\x04 \x03(\tR\x0fmovedBookingIds\x123\n" +
+	"\x16blocking_room_hire_ids\x18\x05 \x03(\tR\x13blockingRoomHireIds\x125\n" +
+	"\x17cancelled_room_hire_ids\x18\x06 \x03(\tR\x14cancelledRoomHireIds\"\xc1\x01\n" +
 	"\x13DeleteTableResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x120\n" +
+	"\x14blocking_booking_ids\x18\x02 \x03(\tR\x12blockingBookingIds\x122\n" +
+	"\x15cancelled_booking_ids\x18\x03 \x03(\tR\x13cancelledBookingIds\x12*\n" +
+	"\x11moved_booking_ids\x18\x04 \x03(\tR\x0fmovedBookingIds\"\\\n" +
+	"\x1dListTableCombinationsResponse\x12;\n" +
+	"\fcombinations\x18\x01 \x03(\v2\x17.venue.TableCombinationR\fcombinations\":\n" +
+	"\x1eDeleteTableCombinationResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"3\n" +
 	"\x17SetSpecialHoursResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\x92\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x80\x02\n" +
+	"\x15ImportHolidaysRequest\x12\x1b\n" +
+	"\tvenue_ids\x18\x01 \x03(\tR\bvenueIds\x12*\n" +
+	"\bholidays\x18\x02 \x03(\v2\x0e.venue.HolidayR\bholidays\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x12\x12\n" +
+	"\x04year\x18\x04 \x01(\x05R\x04year\x12\x1b\n" +
+	"\tis_closed\x18\x05 \x01(\bR\bisClosed\x12\x1b\n" +
+	"\topen_time\x18\x06 \x01(\tR\bopenTime\x12\x1d\n" +
+	"\n" +
+	"close_time\x18\a \x01(\tR\tcloseTime\x12\x17\n" +
+	"\adry_run\x18\b \x01(\bR\x06dryRun\"1\n" +
+	"\aHoliday\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x9a\x01\n" +
+	"\x16ImportHolidaysResponse\x12.\n" +
+	"\achanges\x18\x01 \x03(\v2\x14.venue.HolidayChangeR\achanges\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x1c\n" +
+	"\tunchanged\x18\x04 \x01(\x05R\tunchanged\"\xa2\x02\n" +
+	"\rHolidayChange\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12*\n" +
+	"\x11had_special_hours\x18\x05 \x01(\bR\x0fhadSpecialHours\x12,\n" +
+	"\x12previous_is_closed\x18\x06 \x01(\bR\x10previousIsClosed\x12,\n" +
+	"\x12previous_open_time\x18\a \x01(\tR\x10previousOpenTime\x12.\n" +
+	"\x13previous_close_time\x18\b \x01(\tR\x11previousCloseTime\"F\n" +
+	"\x15GetDayScheduleRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"u\n" +
+	"\vDaySchedule\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06closed\x18\x02 \x01(\bR\x06closed\x12\x1b\n" +
+	"\topen_time\x18\x03 \x01(\tR\bopenTime\x12\x1d\n" +
 	"\n" +
+	"close_time\x18\x04 \x01(\tR\tcloseTime2\xe5\x14\n" +
 	"\fVenueService\x126\n" +
 	"\vCreateVenue\x12\x19.venue.CreateVenueRequest\x1a\f.venue.Venue\x120\n" +
 	"\bGetVenue\x12\x16.venue.GetVenueRequest\x1a\f.venue.Venue\x12A\n" +
 	"\n" +
 	"ListVenues\x12\x18.venue.ListVenuesRequest\x1a\x19.venue.ListVenuesResponse\x126\n" +
 	"\vUpdateVenue\x12\x19.venue.UpdateVenueRequest\x1a\f.venue.Venue\x12D\n" +
-	"\vDeleteVenue\x12\x19.venue.DeleteVenueRequest\x1a\x1a.venue.DeleteVenueResponse\x123\n" +
+	"\vDeleteVenue\x12\x19.venue.DeleteVenueRequest\x1a\x1a.venue.DeleteVenueResponse\x128\n" +
+	"\fRestoreVenue\x12\x1a.venue.RestoreVenueRequest\x1a\f.venue.Venue\x123\n" +
 	"\n" +
 	"CreateRoom\x12\x18.venue.CreateRoomRequest\x1a\v.venue.Room\x12-\n" +
 	"\aGetRoom\x12\x15.venue.GetRoomRequest\x1a\v.venue.Room\x12>\n" +
@@ -2269,18 +5807,35 @@ const file_venue_venue_proto_rawDesc = "" +
 	"\n" +
 	"UpdateRoom\x12\x18.venue.UpdateRoomRequest\x1a\v.venue.Room\x12A\n" +
 	"\n" +
-	"DeleteRoom\x12\x18.venue.DeleteRoomRequest\x1a\x19.venue.DeleteRoomResponse\x126\n" +
+	"DeleteRoom\x12\x18.venue.DeleteRoomRequest\x1a\x19.venue.DeleteRoomResponse\x125\n" +
+	"\vRestoreRoom\x12\x19.venue.RestoreRoomRequest\x1a\v.venue.Room\x126\n" +
 	"\vCreateTable\x12\x19.venue.CreateTableRequest\x1a\f.venue.Table\x120\n" +
 	"\bGetTable\x12\x16.venue.GetTableRequest\x1a\f.venue.Table\x12A\n" +
 	"\n" +
 	"ListTables\x12\x18.venue.ListTablesRequest\x1a\x19.venue.ListTablesResponse\x126\n" +
 	"\vUpdateTable\x12\x19.venue.UpdateTableRequest\x1a\f.venue.Table\x12D\n" +
-	"\vDeleteTable\x12\x19.venue.DeleteTableRequest\x1a\x1a.venue.DeleteTableResponse\x12P\n" +
+	"\vDeleteTable\x12\x19.venue.DeleteTableRequest\x1a\x1a.venue.DeleteTableResponse\x128\n" +
+	"\fRestoreTable\x12\x1a.venue.RestoreTableRequest\x1a\f.venue.Table\x12W\n" +
+	"\x16CreateTableCombination\x12$.venue.CreateTableCombinationRequest\x1a\x17.venue.TableCombination\x12b\n" +
+	"\x15ListTableCombinations\x12#.venue.ListTableCombinationsRequest\x1a$.venue.ListTableCombinationsResponse\x12e\n" +
+	"\x16DeleteTableCombination\x12$.venue.DeleteTableCombinationRequest\x1a%.venue.DeleteTableCombinationResponse\x12P\n" +
 	"\x0fSetOpeningHours\x12\x1d.venue.SetOpeningHoursRequest\x1a\x1e.venue.SetOpeningHoursResponse\x12E\n" +
 	"\x0fGetOpeningHours\x12\x1d.venue.GetOpeningHoursRequest\x1a\x13.venue.OpeningHours\x12P\n" +
-	"\x0fSetSpecialHours\x12\x1d.venue.SetSpecialHoursRequest\x1a\x1e.venue.SetSpecialHoursResponse\x12V\n" +
+	"\x0fSetSpecialHours\x12\x1d.venue.SetSpecialHoursRequest\x1a\x1e.venue.SetSpecialHoursResponse\x12B\n" +
+	"\x0eGetDaySchedule\x12\x1c.venue.GetDayScheduleRequest\x1a\x12.venue.DaySchedule\x12M\n" +
+	"\x0eImportHolidays\x12\x1c.venue.ImportHolidaysRequest\x1a\x1d.venue.ImportHolidaysResponse\x12H\n" +
+	"\x10SetTurnTimeRules\x12\x1e.venue.SetTurnTimeRulesRequest\x1a\x14.venue.TurnTimeRules\x12H\n" +
+	"\x10GetTurnTimeRules\x12\x1e.venue.GetTurnTimeRulesRequest\x1a\x14.venue.TurnTimeRules\x12P\n" +
+	"\x0fResolveTurnTime\x12\x1d.venue.ResolveTurnTimeRequest\x1a\x1e.venue.ResolveTurnTimeResponse\x12B\n" +
+	"\x0eSetPacingRules\x12\x1c.venue.SetPacingRulesRequest\x1a\x12.venue.PacingRules\x12B\n" +
+	"\x0eGetPacingRules\x12\x1c.venue.GetPacingRulesRequest\x1a\x12.venue.PacingRules\x12V\n" +
 	"\x11CheckAvailability\x12\x1f.venue.CheckAvailabilityRequest\x1a .venue.CheckAvailabilityResponse\x12M\n" +
-	"\x0eGetTableLayout\x12\x1c.venue.GetTableLayoutRequest\x1a\x1d.venue.GetTableLayoutResponseB\x18Z\x16booker/pkg/proto/venueb\x06proto3"
+	"\x0eGetTableLayout\x12\x1c.venue.GetTableLayoutRequest\x1a\x1d.venue.GetTableLayoutResponse\x12D\n" +
+	"\vSearchSlots\x12\x19.venue.SearchSlotsRequest\x1a\x1a.venue.SearchSlotsResponse\x12M\n" +
+	"\x0eSaveRoomLayout\x12\x1c.venue.SaveRoomLayoutRequest\x1a\x1d.venue.GetTableLayoutResponse\x12E\n" +
+	"\x10CreateTableBlock\x12\x1e.venue.CreateTableBlockRequest\x1a\x11.venue.TableBlock\x12P\n" +
+	"\x0fListTableBlocks\x12\x1d.venue.ListTableBlocksRequest\x1a\x1e.venue.ListTableBlocksResponse\x12S\n" +
+	"\x10DeleteTableBlock\x12\x1e.venue.DeleteTableBlockRequest\x1a\x1f.venue.DeleteTableBlockResponseB\x18Z\x16booker/pkg/proto/venueb\x06proto3"
 
 var (
 	file_venue_venue_proto_rawDescOnce sync.Once
@@ -2294,104 +5849,205 @@ func file_venue_venue_proto_rawDescGZIP() []byte {
 	return file_venue_venue_proto_rawDescData
 }
 
-var file_venue_venue_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_venue_venue_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
 var file_venue_venue_proto_goTypes = []any{
-	(*Venue)(nil),                     // 0: venue.Venue
-	(*Room)(nil),                      // 1: venue.Room
-	(*Table)(nil),                     // 2: venue.Table
-	(*OpeningHours)(nil),              // 3: venue.OpeningHours
-	(*DayHours)(nil),                  // 4: venue.DayHours
-	(*SpecialHours)(nil),              // 5: venue.SpecialHours
-	(*CreateVenueRequest)(nil),        // 6: venue.CreateVenueRequest
-	(*GetVenueRequest)(nil),           // 7: venue.GetVenueRequest
-	(*ListVenuesRequest)(nil),         // 8: venue.ListVenuesRequest
-	(*UpdateVenueRequest)(nil),        // 9: venue.UpdateVenueRequest
-	(*DeleteVenueRequest)(nil),        // 10: venue.DeleteVenueRequest
-	(*CreateRoomRequest)(nil),         // 11: venue.CreateRoomRequest
-	(*GetRoomRequest)(nil),            // 12: venue.GetRoomRequest
-	(*ListRoomsRequest)(nil),          // 13: venue.ListRoomsRequest
-	(*UpdateRoomRequest)(nil),         // 14: venue.UpdateRoomRequest
-	(*DeleteRoomRequest)(nil),         // 15: venue.DeleteRoomRequest
-	(*CreateTableRequest)(nil),        // 16: venue.CreateTableRequest
-	(*GetTableRequest)(nil),           // 17: venue.GetTableRequest
-	(*ListTablesRequest)(nil),         // 18: venue.ListTablesRequest
-	(*UpdateTableRequest)(nil),        // 19: venue.UpdateTableRequest
-	(*DeleteTableRequest)(nil),        // 20: venue.DeleteTableRequest
-	(*SetOpeningHoursRequest)(nil),    // 21: venue.SetOpeningHoursRequest
-	(*GetOpeningHoursRequest)(nil),    // 22: venue.GetOpeningHoursRequest
-	(*SetSpecialHoursRequest)(nil),    // 23: venue.SetSpecialHoursRequest
-	(*CheckAvailabilityRequest)(nil),  // 24: venue.CheckAvailabilityRequest
-	(*CheckAvailabilityResponse)(nil), // 25: venue.CheckAvailabilityResponse
-	(*TableAvailability)(nil),         // 26: venue.TableAvailability
-	(*GetTableLayoutRequest)(nil),     // 27: venue.GetTableLayoutRequest
-	(*GetTableLayoutResponse)(nil),    // 28: venue.GetTableLayoutResponse
-	(*ListVenuesResponse)(nil),        // 29: venue.ListVenuesResponse
-	(*ListRoomsResponse)(nil),         // 30: venue.ListRoomsResponse
-	(*ListTablesResponse)(nil),        // 31: venue.ListTablesResponse
-	(*SetOpeningHoursResponse)(nil),   // 32: venue.SetOpeningHoursResponse
-	(*DeleteVenueResponse)(nil),       // 33: venue.DeleteVenueResponse
-	(*DeleteRoomResponse)(nil),        // 34: venue.DeleteRoomResponse
-	(*DeleteTableResponse)(nil),       // 35: venue.DeleteTableResponse
-	(*SetSpecialHoursResponse)(nil),   // 36: venue.SetSpecialHoursResponse
-	(*common.Slot)(nil),               // 37: common.Slot
-	(*common.TableRef)(nil),           // 38: common.TableRef
+	(*Venue)(nil),                          // 0: venue.Venue
+	(*Room)(nil),                           // 1: venue.Room
+	(*Table)(nil),                          // 2: venue.Table
+	(*TableCombination)(nil),               // 3: venue.TableCombination
+	(*OpeningHours)(nil),                   // 4: venue.OpeningHours
+	(*DayHours)(nil),                       // 5: venue.DayHours
+	(*TurnTimeRule)(nil),                   // 6: venue.TurnTimeRule
+	(*TurnTimeRules)(nil),                  // 7: venue.TurnTimeRules
+	(*PacingRule)(nil),                     // 8: venue.PacingRule
+	(*PacingRules)(nil),                    // 9: venue.PacingRules
+	(*PacingRejection)(nil),                // 10: venue.PacingRejection
+	(*PacingLimit)(nil),                    // 11: venue.PacingLimit
+	(*SpecialHours)(nil),                   // 12: venue.SpecialHours
+	(*CreateVenueRequest)(nil),             // 13: venue.CreateVenueRequest
+	(*GetVenueRequest)(nil),                // 14: venue.GetVenueRequest
+	(*ListVenuesRequest)(nil),              // 15: venue.ListVenuesRequest
+	(*UpdateVenueRequest)(nil),             // 16: venue.UpdateVenueRequest
+	(*DeleteVenueRequest)(nil),             // 17: venue.DeleteVenueRequest
+	(*CreateRoomRequest)(nil),              // 18: venue.CreateRoomRequest
+	(*GetRoomRequest)(nil),                 // 19: venue.GetRoomRequest
+	(*ListRoomsRequest)(nil),               // 20: venue.ListRoomsRequest
+	(*UpdateRoomRequest)(nil),              // 21: venue.UpdateRoomRequest
+	(*DeleteRoomRequest)(nil),              // 22: venue.DeleteRoomRequest
+	(*CreateTableRequest)(nil),             // 23: venue.CreateTableRequest
+	(*CreateTableCombinationRequest)(nil),  // 24: venue.CreateTableCombinationRequest
+	(*ListTableCombinationsRequest)(nil),   // 25: venue.ListTableCombinationsRequest
+	(*DeleteTableCombinationRequest)(nil),  // 26: venue.DeleteTableCombinationRequest
+	(*GetTableRequest)(nil),                // 27: venue.GetTableRequest
+	(*ListTablesRequest)(nil),              // 28: venue.ListTablesRequest
+	(*UpdateTableRequest)(nil),             // 29: venue.UpdateTableRequest
+	(*DeleteTableRequest)(nil),             // 30: venue.DeleteTableRequest
+	(*RestoreVenueRequest)(nil),            // 31: venue.RestoreVenueRequest
+	(*RestoreRoomRequest)(nil),             // 32: venue.RestoreRoomRequest
+	(*RestoreTableRequest)(nil),            // 33: venue.RestoreTableRequest
+	(*BookingReassignment)(nil),            // 34: venue.BookingReassignment
+	(*SetOpeningHoursRequest)(nil),         // 35: venue.SetOpeningHoursRequest
+	(*GetOpeningHoursRequest)(nil),         // 36: venue.GetOpeningHoursRequest
+	(*SetSpecialHoursRequest)(nil),         // 37: venue.SetSpecialHoursRequest
+	(*SetTurnTimeRulesRequest)(nil),        // 38: venue.SetTurnTimeRulesRequest
+	(*GetTurnTimeRulesRequest)(nil),        // 39: venue.GetTurnTimeRulesRequest
+	(*SetPacingRulesRequest)(nil),          // 40: venue.SetPacingRulesRequest
+	(*GetPacingRulesRequest)(nil),          // 41: venue.GetPacingRulesRequest
+	(*ResolveTurnTimeRequest)(nil),         // 42: venue.ResolveTurnTimeRequest
+	(*ResolveTurnTimeResponse)(nil),        // 43: venue.ResolveTurnTimeResponse
+	(*CheckAvailabilityRequest)(nil),       // 44: venue.CheckAvailabilityRequest
+	(*CheckAvailabilityResponse)(nil),      // 45: venue.CheckAvailabilityResponse
+	(*TableAssignment)(nil),                // 46: venue.TableAssignment
+	(*TableAvailability)(nil),              // 47: venue.TableAvailability
+	(*SearchSlotsRequest)(nil),             // 48: venue.SearchSlotsRequest
+	(*SearchSlotsResponse)(nil),            // 49: venue.SearchSlotsResponse
+	(*AvailableSlot)(nil),                  // 50: venue.AvailableSlot
+	(*GetTableLayoutRequest)(nil),          // 51: venue.GetTableLayoutRequest
+	(*GetTableLayoutResponse)(nil),         // 52: venue.GetTableLayoutResponse
+	(*TableTimeline)(nil),                  // 53: venue.TableTimeline
+	(*TimelineBlock)(nil),                  // 54: venue.TimelineBlock
+	(*TableBlock)(nil),                     // 55: venue.TableBlock
+	(*CreateTableBlockRequest)(nil),        // 56: venue.CreateTableBlockRequest
+	(*ListTableBlocksRequest)(nil),         // 57: venue.ListTableBlocksRequest
+	(*ListTableBlocksResponse)(nil),        // 58: venue.ListTableBlocksResponse
+	(*DeleteTableBlockRequest)(nil),        // 59: venue.DeleteTableBlockRequest
+	(*DeleteTableBlockResponse)(nil),       // 60: venue.DeleteTableBlockResponse
+	(*SaveRoomLayoutRequest)(nil),          // 61: venue.SaveRoomLayoutRequest
+	(*TablePlacement)(nil),                 // 62: venue.TablePlacement
+	(*ListVenuesResponse)(nil),             // 63: venue.ListVenuesResponse
+	(*ListRoomsResponse)(nil),              // 64: venue.ListRoomsResponse
+	(*ListTablesResponse)(nil),             // 65: venue.ListTablesResponse
+	(*SetOpeningHoursResponse)(nil),        // 66: venue.SetOpeningHoursResponse
+	(*DeleteVenueResponse)(nil),            // 67: venue.DeleteVenueResponse
+	(*DeleteRoomResponse)(nil),             // 68: venue.DeleteRoomResponse
+	(*DeleteTableResponse)(nil),            // 69: venue.DeleteTableResponse
+	(*ListTableCombinationsResponse)(nil),  // 70: venue.ListTableCombinationsResponse
+	(*DeleteTableCombinationResponse)(nil), // 71: venue.DeleteTableCombinationResponse
+	(*SetSpecialHoursResponse)(nil),        // 72: venue.SetSpecialHoursResponse
+	(*ImportHolidaysRequest)(nil),          // 73: venue.ImportHolidaysRequest
+	(*Holiday)(nil),                        // 74: venue.Holiday
+	(*ImportHolidaysResponse)(nil),         // 75: venue.ImportHolidaysResponse
+	(*HolidayChange)(nil),                  // 76: venue.HolidayChange
+	(*GetDayScheduleRequest)(nil),          // 77: venue.GetDayScheduleRequest
+	(*DaySchedule)(nil),                    // 78: venue.DaySchedule
+	nil,                                    // 79: venue.CheckAvailabilityResponse.TableBufferMinutesEntry
+	(*common.Slot)(nil),                    // 80: common.Slot
+	(*common.TableRef)(nil),                // 81: common.TableRef
 }
 var file_venue_venue_proto_depIdxs = []int32{
-	4,  // 0: venue.OpeningHours.days:type_name -> venue.DayHours
-	4,  // 1: venue.SetOpeningHoursRequest.days:type_name -> venue.DayHours
-	37, // 2: venue.CheckAvailabilityRequest.slot:type_name -> common.Slot
-	26, // 3: venue.CheckAvailabilityResponse.tables:type_name -> venue.TableAvailability
-	38, // 4: venue.TableAvailability.table:type_name -> common.TableRef
-	38, // 5: venue.TableAvailability.merged_with_table:type_name -> common.TableRef
-	2,  // 6: venue.GetTableLayoutResponse.tables:type_name -> venue.Table
-	0,  // 7: venue.ListVenuesResponse.venues:type_name -> venue.Venue
-	1,  // 8: venue.ListRoomsResponse.rooms:type_name -> venue.Room
-	2,  // 9: venue.ListTablesResponse.tables:type_name -> venue.Table
-	6,  // 10: venue.VenueService.CreateVenue:input_type -> venue.CreateVenueRequest
-	7,  // 11: venue.VenueService.GetVenue:input_type -> venue.GetVenueRequest
-	8,  // 12: venue.VenueService.ListVenues:input_type -> venue.ListVenuesRequest
-	9,  // 13: venue.VenueService.UpdateVenue:input_type -> venue.UpdateVenueRequest
-	10, // 14: venue.VenueService.DeleteVenue:input_type -> venue.DeleteVenueRequest
-	11, // 15: venue.VenueService.CreateRoom:input_type -> venue.CreateRoomRequest
-	12, // 16: venue.VenueService.GetRoom:input_type -> venue.GetRoomRequest
-	13, // 17: venue.VenueService.ListRooms:input_type -> venue.ListRoomsRequest
-	14, // 18: venue.VenueService.UpdateRoom:input_type -> venue.UpdateRoomRequest
-	15, // 19: venue.VenueService.DeleteRoom:input_type -> venue.DeleteRoomRequest
-	16, // 20: venue.VenueService.CreateTable:input_type -> venue.CreateTableRequest
-	17, // 21: venue.VenueService.GetTable:input_type -> venue.GetTableRequest
-	18, // 22: venue.VenueService.ListTables:input_type -> venue.ListTablesRequest
-	19, // 23: venue.VenueService.UpdateTable:input_type -> venue.UpdateTableRequest
-	20, // 24: venue.VenueService.DeleteTable:input_type -> venue.DeleteTableRequest
-	21, // 25: venue.VenueService.SetOpeningHours:input_type -> venue.SetOpeningHoursRequest
-	22, // 26: venue.VenueService.GetOpeningHours:input_type -> venue.GetOpeningHoursRequest
-	23, // 27: venue.VenueService.SetSpecialHours:input_type -> venue.SetSpecialHoursRequest
-	24, // 28: venue.VenueService.CheckAvailability:input_type -> venue.CheckAvailabilityRequest
-	27, // 29: venue.VenueService.GetTableLayout:input_type -> venue.GetTableLayoutRequest
-	0,  // 30: venue.VenueService.CreateVenue:output_type -> venue.Venue
-	0,  // 31: venue.VenueService.GetVenue:output_type -> venue.Venue
-	29, // 32: venue.VenueService.ListVenues:output_type -> venue.ListVenuesResponse
-	0,  // 33: venue.VenueService.UpdateVenue:output_type -> venue.Venue
-	33, // 34: venue.VenueService.DeleteVenue:output_type -> venue.DeleteVenueResponse
-	1,  // 35: venue.VenueService.CreateRoom:output_type -> venue.Room
-	1,  // 36: venue.VenueService.GetRoom:output_type -> venue.Room
-	30, // 37: venue.VenueService.ListRooms:output_type -> venue.ListRoomsResponse
-	1,  // 38: venue.VenueService.UpdateRoom:output_type -> venue.Room
-	34, // 39: venue.VenueService.DeleteRoom:output_type -> venue.DeleteRoomResponse
-	2,  // 40: venue.VenueService.CreateTable:output_type -> venue.Table
-	2,  // 41: venue.VenueService.GetTable:output_type -> venue.Table
-	31, // 42: venue.VenueService.ListTables:output_type -> venue.ListTablesResponse
-	2,  // 43: venue.VenueService.UpdateTable:output_type -> venue.Table
-	35, // 44: venue.VenueService.DeleteTable:output_type -> venue.DeleteTableResponse
-	32, // 45: venue.VenueService.SetOpeningHours:output_type -> venue.SetOpeningHoursResponse
-	3,  // 46: venue.VenueService.GetOpeningHours:output_type -> venue.OpeningHours
-	36, // 47: venue.VenueService.SetSpecialHours:output_type -> venue.SetSpecialHoursResponse
-	25, // 48: venue.VenueService.CheckAvailability:output_type -> venue.CheckAvailabilityResponse
-	28, // 49: venue.VenueService.GetTableLayout:output_type -> venue.GetTableLayoutResponse
-	30, // [30:50] is the sub-list for method output_type
-	10, // [10:30] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	5,  // 0: venue.OpeningHours.days:type_name -> venue.DayHours
+	6,  // 1: venue.TurnTimeRules.rules:type_name -> venue.TurnTimeRule
+	8,  // 2: venue.PacingRules.rules:type_name -> venue.PacingRule
+	34, // 3: venue.DeleteRoomRequest.reassignments:type_name -> venue.BookingReassignment
+	34, // 4: venue.DeleteTableRequest.reassignments:type_name -> venue.BookingReassignment
+	5,  // 5: venue.SetOpeningHoursRequest.days:type_name -> venue.DayHours
+	6,  // 6: venue.SetTurnTimeRulesRequest.rules:type_name -> venue.TurnTimeRule
+	8,  // 7: venue.SetPacingRulesRequest.rules:type_name -> venue.PacingRule
+	80, // 8: venue.CheckAvailabilityRequest.slot:type_name -> common.Slot
+	47, // 9: venue.CheckAvailabilityResponse.tables:type_name -> venue.TableAvailability
+	46, // 10: venue.CheckAvailabilityResponse.suggested_assignment:type_name -> venue.TableAssignment
+	10, // 11: venue.CheckAvailabilityResponse.pacing_rejection:type_name -> venue.PacingRejection
+	79, // 12: venue.CheckAvailabilityResponse.table_buffer_minutes:type_name -> venue.CheckAvailabilityResponse.TableBufferMinutesEntry
+	11, // 13: venue.CheckAvailabilityResponse.pacing_limits:type_name -> venue.PacingLimit
+	81, // 14: venue.TableAssignment.tables:type_name -> common.TableRef
+	81, // 15: venue.TableAvailability.table:type_name -> common.TableRef
+	81, // 16: venue.TableAvailability.merged_with_table:type_name -> common.TableRef
+	81, // 17: venue.TableAvailability.combined_tables:type_name -> common.TableRef
+	50, // 18: venue.SearchSlotsResponse.slots:type_name -> venue.AvailableSlot
+	46, // 19: venue.AvailableSlot.suggested_assignment:type_name -> venue.TableAssignment
+	2,  // 20: venue.GetTableLayoutResponse.tables:type_name -> venue.Table
+	1,  // 21: venue.GetTableLayoutResponse.room:type_name -> venue.Room
+	53, // 22: venue.GetTableLayoutResponse.timeline:type_name -> venue.TableTimeline
+	54, // 23: venue.TableTimeline.blocks:type_name -> venue.TimelineBlock
+	55, // 24: venue.ListTableBlocksResponse.blocks:type_name -> venue.TableBlock
+	62, // 25: venue.SaveRoomLayoutRequest.tables:type_name -> venue.TablePlacement
+	0,  // 26: venue.ListVenuesResponse.venues:type_name -> venue.Venue
+	1,  // 27: venue.ListRoomsResponse.rooms:type_name -> venue.Room
+	2,  // 28: venue.ListTablesResponse.tables:type_name -> venue.Table
+	3,  // 29: venue.ListTableCombinationsResponse.combinations:type_name -> venue.TableCombination
+	74, // 30: venue.ImportHolidaysRequest.holidays:type_name -> venue.Holiday
+	76, // 31: venue.ImportHolidaysResponse.changes:type_name -> venue.HolidayChange
+	13, // 32: venue.VenueService.CreateVenue:input_type -> venue.CreateVenueRequest
+	14, // 33: venue.VenueService.GetVenue:input_type -> venue.GetVenueRequest
+	15, // 34: venue.VenueService.ListVenues:input_type -> venue.ListVenuesRequest
+	16, // 35: venue.VenueService.UpdateVenue:input_type -> venue.UpdateVenueRequest
+	17, // 36: venue.VenueService.DeleteVenue:input_type -> venue.DeleteVenueRequest
+	31, // 37: venue.VenueService.RestoreVenue:input_type -> venue.RestoreVenueRequest
+	18, // 38: venue.VenueService.CreateRoom:input_type -> venue.CreateRoomRequest
+	19, // 39: venue.VenueService.GetRoom:input_type -> venue.GetRoomRequest
+	20, // 40: venue.VenueService.ListRooms:input_type -> venue.ListRoomsRequest
+	21, // 41: venue.VenueService.UpdateRoom:input_type -> venue.UpdateRoomRequest
+	22, // 42: venue.VenueService.DeleteRoom:input_type -> venue.DeleteRoomRequest
+	32, // 43: venue.VenueService.RestoreRoom:input_type -> venue.RestoreRoomRequest
+	23, // 44: venue.VenueService.CreateTable:input_type -> venue.CreateTableRequest
+	27, // 45: venue.VenueService.GetTable:input_type -> venue.GetTableRequest
+	28, // 46: venue.VenueService.ListTables:input_type -> venue.ListTablesRequest
+	29, // 47: venue.VenueService.UpdateTable:input_type -> venue.UpdateTableRequest
+	30, // 48: venue.VenueService.DeleteTable:input_type -> venue.DeleteTableRequest
+	33, // 49: venue.VenueService.RestoreTable:input_type -> venue.RestoreTableRequest
+	24, // 50: venue.VenueService.CreateTableCombination:input_type -> venue.CreateTableCombinationRequest
+	25, // 51: venue.VenueService.ListTableCombinations:input_type -> venue.ListTableCombinationsRequest
+	26, // 52: venue.VenueService.DeleteTableCombination:input_type -> venue.DeleteTableCombinationRequest
+	35, // 53: venue.VenueService.SetOpeningHours:input_type -> venue.SetOpeningHoursRequest
+	36, // 54: venue.VenueService.GetOpeningHours:input_type -> venue.GetOpeningHoursRequest
+	37, // 55: venue.VenueService.SetSpecialHours:input_type -> venue.SetSpecialHoursRequest
+	77, // 56: venue.VenueService.GetDaySchedule:input_type -> venue.GetDayScheduleRequest
+	73, // 57: venue.VenueService.ImportHolidays:input_type -> venue.ImportHolidaysRequest
+	38, // 58: venue.VenueService.SetTurnTimeRules:input_type -> venue.SetTurnTimeRulesRequest
+	39, // 59: venue.VenueService.GetTurnTimeRules:input_type -> venue.GetTurnTimeRulesRequest
+	42, // 60: venue.VenueService.ResolveTurnTime:input_type -> venue.ResolveTurnTimeRequest
+	40, // 61: venue.VenueService.SetPacingRules:input_type -> venue.SetPacingRulesRequest
+	41, // 62: venue.VenueService.GetPacingRules:input_type -> venue.GetPacingRulesRequest
+	44, // 63: venue.VenueService.CheckAvailability:input_type -> venue.CheckAvailabilityRequest
+	51, // 64: venue.VenueService.GetTableLayout:input_type -> venue.GetTableLayoutRequest
+	48, // 65: venue.VenueService.SearchSlots:input_type -> venue.SearchSlotsRequest
+	61, // 66: venue.VenueService.SaveRoomLayout:input_type -> venue.SaveRoomLayoutRequest
+	56, // 67: venue.VenueService.CreateTableBlock:input_type -> venue.CreateTableBlockRequest
+	57, // 68: venue.VenueService.ListTableBlocks:input_type -> venue.ListTableBlocksRequest
+	59, // 69: venue.VenueService.DeleteTableBlock:input_type -> venue.DeleteTableBlockRequest
+	0,  // 70: venue.VenueService.CreateVenue:output_type -> venue.Venue
+	0,  // 71: venue.VenueService.GetVenue:output_type -> venue.Venue
+	63, // 72: venue.VenueService.ListVenues:output_type -> venue.ListVenuesResponse
+	0,  // 73: venue.VenueService.UpdateVenue:output_type -> venue.Venue
+	67, // 74: venue.VenueService.DeleteVenue:output_type -> venue.DeleteVenueResponse
+	0,  // 75: venue.VenueService.RestoreVenue:output_type -> venue.Venue
+	1,  // 76: venue.VenueService.CreateRoom:output_type -> venue.Room
+	1,  // 77: venue.VenueService.GetRoom:output_type -> venue.Room
+	64, // 78: venue.VenueService.ListRooms:output_type -> venue.ListRoomsResponse
+	1,  // 79: venue.VenueService.UpdateRoom:output_type -> venue.Room
+	68, // 80: venue.VenueService.DeleteRoom:output_type -> venue.DeleteRoomResponse
+	1,  // 81: venue.VenueService.RestoreRoom:output_type -> venue.Room
+	2,  // 82: venue.VenueService.CreateTable:output_type -> venue.Table
+	2,  // 83: venue.VenueService.GetTable:output_type -> venue.Table
+	65, // 84: venue.VenueService.ListTables:output_type -> venue.ListTablesResponse
+	2,  // 85: venue.VenueService.UpdateTable:output_type -> venue.Table
+	69, // 86: venue.VenueService.DeleteTable:output_type -> venue.DeleteTableResponse
+	2,  // 87: venue.VenueService.RestoreTable:output_type -> venue.Table
+	3,  // 88: venue.VenueService.CreateTableCombination:output_type -> venue.TableCombination
+	70, // 89: venue.VenueService.ListTableCombinations:output_type -> venue.ListTableCombinationsResponse
+	71, // 90: venue.VenueService.DeleteTableCombination:output_type -> venue.DeleteTableCombinationResponse
+	66, // 91: venue.VenueService.SetOpeningHours:output_type -> venue.SetOpeningHoursResponse
+	4,  // 92: venue.VenueService.GetOpeningHours:output_type -> venue.OpeningHours
+	72, // 93: venue.VenueService.SetSpecialHours:output_type -> venue.SetSpecialHoursResponse
+	78, // 94: venue.VenueService.GetDaySchedule:output_type -> venue.DaySchedule
+	75, // 95: venue.VenueService.ImportHolidays:output_type -> venue.ImportHolidaysResponse
+	7,  // 96: venue.VenueService.SetTurnTimeRules:output_type -> venue.TurnTimeRules
+	7,  // 97: venue.VenueService.GetTurnTimeRules:output_type -> venue.TurnTimeRules
+	43, // 98: venue.VenueService.ResolveTurnTime:output_type -> venue.ResolveTurnTimeResponse
+	9,  // 99: venue.VenueService.SetPacingRules:output_type -> venue.PacingRules
+	9,  // 100: venue.VenueService.GetPacingRules:output_type -> venue.PacingRules
+	45, // 101: venue.VenueService.CheckAvailability:output_type -> venue.CheckAvailabilityResponse
+	52, // 102: venue.VenueService.GetTableLayout:output_type -> venue.GetTableLayoutResponse
+	49, // 103: venue.VenueService.SearchSlots:output_type -> venue.SearchSlotsResponse
+	52, // 104: venue.VenueService.SaveRoomLayout:output_type -> venue.GetTableLayoutResponse
+	55, // 105: venue.VenueService.CreateTableBlock:output_type -> venue.TableBlock
+	58, // 106: venue.VenueService.ListTableBlocks:output_type -> venue.ListTableBlocksResponse
+	60, // 107: venue.VenueService.DeleteTableBlock:output_type -> venue.DeleteTableBlockResponse
+	70, // [70:108] is the sub-list for method output_type
+	32, // [32:70] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_venue_venue_proto_init() }
@@ -2405,7 +6061,7 @@ func file_venue_venue_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_venue_venue_proto_rawDesc), len(file_venue_venue_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   37,
+			NumMessages:   80,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,26 +19,44 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VenueService_CreateVenue_FullMethodName       = "/venue.VenueService/CreateVenue"
-	VenueService_GetVenue_FullMethodName          = "/venue.VenueService/GetVenue"
-	VenueService_ListVenues_FullMethodName        = "/venue.VenueService/ListVenues"
-	VenueService_UpdateVenue_FullMethodName       = "/venue.VenueService/UpdateVenue"
-	VenueService_DeleteVenue_FullMethodName       = "/venue.VenueService/DeleteVenue"
-	VenueService_CreateRoom_FullMethodName        = "/venue.VenueService/CreateRoom"
-	VenueService_GetRoom_FullMethodName           = "/venue.VenueService/GetRoom"
-	VenueService_ListRooms_FullMethodName         = "/venue.VenueService/ListRooms"
-	VenueService_UpdateRoom_FullMethodName        = "/venue.VenueService/UpdateRoom"
-	VenueService_DeleteRoom_FullMethodName        = "/venue.VenueService/DeleteRoom"
-	VenueService_CreateTable_FullMethodName       = "/venue.VenueService/CreateTable"
-	VenueService_GetTable_FullMethodName          = "/venue.VenueService/GetTable"
-	VenueService_ListTables_FullMethodName        = "/venue.VenueService/ListTables"
-	VenueService_UpdateTable_FullMethodName       = "/venue.VenueService/UpdateTable"
-	VenueService_DeleteTable_FullMethodName       = "/venue.VenueService/DeleteTable"
-	VenueService_SetOpeningHours_FullMethodName   = "/venue.VenueService/SetOpeningHours"
-	VenueService_GetOpeningHours_FullMethodName   = "/venue.VenueService/GetOpeningHours"
-	VenueService_SetSpecialHours_FullMethodName   = "/venue.VenueService/SetSpecialHours"
-	VenueService_CheckAvailability_FullMethodName = "/venue.VenueService/CheckAvailability"
-	VenueService_GetTableLayout_FullMethodName    = "/venue.VenueService/GetTableLayout"
+	VenueService_CreateVenue_FullMethodName            = "/venue.VenueService/CreateVenue"
+	VenueService_GetVenue_FullMethodName               = "/venue.VenueService/GetVenue"
+	VenueService_ListVenues_FullMethodName             = "/venue.VenueService/ListVenues"
+	VenueService_UpdateVenue_FullMethodName            = "/venue.VenueService/UpdateVenue"
+	VenueService_DeleteVenue_FullMethodName            = "/venue.VenueService/DeleteVenue"
+	VenueService_RestoreVenue_FullMethodName           = "/venue.VenueService/RestoreVenue"
+	VenueService_CreateRoom_FullMethodName             = "/venue.VenueService/CreateRoom"
+	VenueService_GetRoom_FullMethodName                = "/venue.VenueService/GetRoom"
+	VenueService_ListRooms_FullMethodName              = "/venue.VenueService/ListRooms"
+	VenueService_UpdateRoom_FullMethodName             = "/venue.VenueService/UpdateRoom"
+	VenueService_DeleteRoom_FullMethodName             = "/venue.VenueService/DeleteRoom"
+	VenueService_RestoreRoom_FullMethodName            = "/venue.VenueService/RestoreRoom"
+	VenueService_CreateTable_FullMethodName            = "/venue.VenueService/CreateTable"
+	VenueService_GetTable_FullMethodName               = "/venue.VenueService/GetTable"
+	VenueService_ListTables_FullMethodName             = "/venue.VenueService/ListTables"
+	VenueService_UpdateTable_FullMethodName            = "/venue.VenueService/UpdateTable"
+	VenueService_DeleteTable_FullMethodName            = "/venue.VenueService/DeleteTable"
+	VenueService_RestoreTable_FullMethodName           = "/venue.VenueService/RestoreTable"
+	VenueService_CreateTableCombination_FullMethodName = "/venue.VenueService/CreateTableCombination"
+	VenueService_ListTableCombinations_FullMethodName  = "/venue.VenueService/ListTableCombinations"
+	VenueService_DeleteTableCombination_FullMethodName = "/venue.VenueService/DeleteTableCombination"
+	VenueService_SetOpeningHours_FullMethodName        = "/venue.VenueService/SetOpeningHours"
+	VenueService_GetOpeningHours_FullMethodName        = "/venue.VenueService/GetOpeningHours"
+	VenueService_SetSpecialHours_FullMethodName        = "/venue.VenueService/SetSpecialHours"
+	VenueService_GetDaySchedule_FullMethodName         = "/venue.VenueService/GetDaySchedule"
+	VenueService_ImportHolidays_FullMethodName         = "/venue.VenueService/ImportHolidays"
+	VenueService_SetTurnTimeRules_FullMethodName       = "/venue.VenueService/SetTurnTimeRules"
+	VenueService_GetTurnTimeRules_FullMethodName       = "/venue.VenueService/GetTurnTimeRules"
+	VenueService_ResolveTurnTime_FullMethodName        = "/venue.VenueService/ResolveTurnTime"
+	VenueService_SetPacingRules_FullMethodName         = "/venue.VenueService/SetPacingRules"
+	VenueService_GetPacingRules_FullMethodName         = "/venue.VenueService/GetPacingRules"
+	VenueService_CheckAvailability_FullMethodName      = "/venue.VenueService/CheckAvailability"
+	VenueService_GetTableLayout_FullMethodName         = "/venue.VenueService/GetTableLayout"
+	VenueService_SearchSlots_FullMethodName            = "/venue.VenueService/SearchSlots"
+	VenueService_SaveRoomLayout_FullMethodName         = "/venue.VenueService/SaveRoomLayout"
+	VenueService_CreateTableBlock_FullMethodName       = "/venue.VenueService/CreateTableBlock"
+	VenueService_ListTableBlocks_FullMethodName        = "/venue.VenueService/ListTableBlocks"
+	VenueService_DeleteTableBlock_FullMethodName       = "/venue.VenueService/DeleteTableBlock"
 )
 
 // VenueServiceClient is the client API for VenueService service.
@@ -51,25 +69,46 @@ type VenueServiceClient interface {
 	ListVenues(ctx context.Context, in *ListVenuesRequest, opts ...grpc.CallOption) (*ListVenuesResponse, error)
 	UpdateVenue(ctx context.Context, in *UpdateVenueRequest, opts ...grpc.CallOption) (*Venue, error)
 	DeleteVenue(ctx context.Context, in *DeleteVenueRequest, opts ...grpc.CallOption) (*DeleteVenueResponse, error)
+	RestoreVenue(ctx context.Context, in *RestoreVenueRequest, opts ...grpc.CallOption) (*Venue, error)
 	// Залы
 	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*Room, error)
 	GetRoom(ctx context.Context, in *GetRoomRequest, opts ...grpc.CallOption) (*Room, error)
 	ListRooms(ctx context.Context, in *ListRoomsRequest, opts ...grpc.CallOption) (*ListRoomsResponse, error)
 	UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*Room, error)
 	DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*DeleteRoomResponse, error)
+	RestoreRoom(ctx context.Context, in *RestoreRoomRequest, opts ...grpc.CallOption) (*Room, error)
 	// Столы
 	CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*Table, error)
 	GetTable(ctx context.Context, in *GetTableRequest, opts ...grpc.CallOption) (*Table, error)
 	ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error)
 	UpdateTable(ctx context.Context, in *UpdateTableRequest, opts ...grpc.CallOption) (*Table, error)
 	DeleteTable(ctx context.Context, in *DeleteTableRequest, opts ...grpc.CallOption) (*DeleteTableResponse, error)
+	RestoreTable(ctx context.Context, in *RestoreTableRequest, opts ...grpc.CallOption) (*Table, error)
+	// Комбинации столов, которые можно физически составить вместе
+	CreateTableCombination(ctx context.Context, in *CreateTableCombinationRequest, opts ...grpc.CallOption) (*TableCombination, error)
+	ListTableCombinations(ctx context.Context, in *ListTableCombinationsRequest, opts ...grpc.CallOption) (*ListTableCombinationsResponse, error)
+	DeleteTableCombination(ctx context.Context, in *DeleteTableCombinationRequest, opts ...grpc.CallOption) (*DeleteTableCombinationResponse, error)
 	// Расписание
 	SetOpeningHours(ctx context.Context, in *SetOpeningHoursRequest, opts ...grpc.CallOption) (*SetOpeningHoursResponse, error)
 	GetOpeningHours(ctx context.Context, in *GetOpeningHoursRequest, opts ...grpc.CallOption) (*OpeningHours, error)
 	SetSpecialHours(ctx context.Context, in *SetSpecialHoursRequest, opts ...grpc.CallOption) (*SetSpecialHoursResponse, error)
+	GetDaySchedule(ctx context.Context, in *GetDayScheduleRequest, opts ...grpc.CallOption) (*DaySchedule, error)
+	ImportHolidays(ctx context.Context, in *ImportHolidaysRequest, opts ...grpc.CallOption) (*ImportHolidaysResponse, error)
+	// Длительность посадки
+	SetTurnTimeRules(ctx context.Context, in *SetTurnTimeRulesRequest, opts ...grpc.CallOption) (*TurnTimeRules, error)
+	GetTurnTimeRules(ctx context.Context, in *GetTurnTimeRulesRequest, opts ...grpc.CallOption) (*TurnTimeRules, error)
+	ResolveTurnTime(ctx context.Context, in *ResolveTurnTimeRequest, opts ...grpc.CallOption) (*ResolveTurnTimeResponse, error)
+	SetPacingRules(ctx context.Context, in *SetPacingRulesRequest, opts ...grpc.CallOption) (*PacingRules, error)
+	GetPacingRules(ctx context.Context, in *GetPacingRulesRequest, opts ...grpc.CallOption) (*PacingRules, error)
 	// Доступность
 	CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, opts ...grpc.CallOption) (*CheckAvailabilityResponse, error)
 	GetTableLayout(ctx context.Context, in *GetTableLayoutRequest, opts ...grpc.CallOption) (*GetTableLayoutResponse, error)
+	SearchSlots(ctx context.Context, in *SearchSlotsRequest, opts ...grpc.CallOption) (*SearchSlotsResponse, error)
+	SaveRoomLayout(ctx context.Context, in *SaveRoomLayoutRequest, opts ...grpc.CallOption) (*GetTableLayoutResponse, error)
+	// Блокировки столов (ремонт, стол для персонала) - отдельно от гостевых броней
+	CreateTableBlock(ctx context.Context, in *CreateTableBlockRequest, opts ...grpc.CallOption) (*TableBlock, error)
+	ListTableBlocks(ctx context.Context, in *ListTableBlocksRequest, opts ...grpc.CallOption) (*ListTableBlocksResponse, error)
+	DeleteTableBlock(ctx context.Context, in *DeleteTableBlockRequest, opts ...grpc.CallOption) (*DeleteTableBlockResponse, error)
 }
 
 type venueServiceClient struct {
@@ -130,6 +169,16 @@ func (c *venueServiceClient) DeleteVenue(ctx context.Context, in *DeleteVenueReq
 	return out, nil
 }
 
+func (c *venueServiceClient) RestoreVenue(ctx context.Context, in *RestoreVenueRequest, opts ...grpc.CallOption) (*Venue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Venue)
+	err := c.cc.Invoke(ctx, VenueService_RestoreVenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *venueServiceClient) CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*Room, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Room)
@@ -180,6 +229,16 @@ func (c *venueServiceClient) DeleteRoom(ctx context.Context, in *DeleteRoomReque
 	return out, nil
 }
 
+func (c *venueServiceClient) RestoreRoom(ctx context.Context, in *RestoreRoomRequest, opts ...grpc.CallOption) (*Room, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Room)
+	err := c.cc.Invoke(ctx, VenueService_RestoreRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *venueServiceClient) CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*Table, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Table)
@@ -230,6 +289,46 @@ func (c *venueServiceClient) DeleteTable(ctx context.Context, in *DeleteTableReq
 	return out, nil
 }
 
+func (c *venueServiceClient) RestoreTable(ctx context.Context, in *RestoreTableRequest, opts ...grpc.CallOption) (*Table, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Table)
+	err := c.cc.Invoke(ctx, VenueService_RestoreTable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) CreateTableCombination(ctx context.Context, in *CreateTableCombinationRequest, opts ...grpc.CallOption) (*TableCombination, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableCombination)
+	err := c.cc.Invoke(ctx, VenueService_CreateTableCombination_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) ListTableCombinations(ctx context.Context, in *ListTableCombinationsRequest, opts ...grpc.CallOption) (*ListTableCombinationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTableCombinationsResponse)
+	err := c.cc.Invoke(ctx, VenueService_ListTableCombinations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) DeleteTableCombination(ctx context.Context, in *DeleteTableCombinationRequest, opts ...grpc.CallOption) (*DeleteTableCombinationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTableCombinationResponse)
+	err := c.cc.Invoke(ctx, VenueService_DeleteTableCombination_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *venueServiceClient) SetOpeningHours(ctx context.Context, in *SetOpeningHoursRequest, opts ...grpc.CallOption) (*SetOpeningHoursResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetOpeningHoursResponse)
@@ -260,6 +359,76 @@ func (c *venueServiceClient) SetSpecialHours(ctx context.Context, in *SetSpecial
 	return out, nil
 }
 
+func (c *venueServiceClient) GetDaySchedule(ctx context.Context, in *GetDayScheduleRequest, opts ...grpc.CallOption) (*DaySchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaySchedule)
+	err := c.cc.Invoke(ctx, VenueService_GetDaySchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) ImportHolidays(ctx context.Context, in *ImportHolidaysRequest, opts ...grpc.CallOption) (*ImportHolidaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportHolidaysResponse)
+	err := c.cc.Invoke(ctx, VenueService_ImportHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) SetTurnTimeRules(ctx context.Context, in *SetTurnTimeRulesRequest, opts ...grpc.CallOption) (*TurnTimeRules, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TurnTimeRules)
+	err := c.cc.Invoke(ctx, VenueService_SetTurnTimeRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) GetTurnTimeRules(ctx context.Context, in *GetTurnTimeRulesRequest, opts ...grpc.CallOption) (*TurnTimeRules, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TurnTimeRules)
+	err := c.cc.Invoke(ctx, VenueService_GetTurnTimeRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) ResolveTurnTime(ctx context.Context, in *ResolveTurnTimeRequest, opts ...grpc.CallOption) (*ResolveTurnTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveTurnTimeResponse)
+	err := c.cc.Invoke(ctx, VenueService_ResolveTurnTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) SetPacingRules(ctx context.Context, in *SetPacingRulesRequest, opts ...grpc.CallOption) (*PacingRules, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PacingRules)
+	err := c.cc.Invoke(ctx, VenueService_SetPacingRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) GetPacingRules(ctx context.Context, in *GetPacingRulesRequest, opts ...grpc.CallOption) (*PacingRules, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PacingRules)
+	err := c.cc.Invoke(ctx, VenueService_GetPacingRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *venueServiceClient) CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, opts ...grpc.CallOption) (*CheckAvailabilityResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckAvailabilityResponse)
@@ -280,6 +449,56 @@ func (c *venueServiceClient) GetTableLayout(ctx context.Context, in *GetTableLay
 	return out, nil
 }
 
+func (c *venueServiceClient) SearchSlots(ctx context.Context, in *SearchSlotsRequest, opts ...grpc.CallOption) (*SearchSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchSlotsResponse)
+	err := c.cc.Invoke(ctx, VenueService_SearchSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) SaveRoomLayout(ctx context.Context, in *SaveRoomLayoutRequest, opts ...grpc.CallOption) (*GetTableLayoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTableLayoutResponse)
+	err := c.cc.Invoke(ctx, VenueService_SaveRoomLayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) CreateTableBlock(ctx context.Context, in *CreateTableBlockRequest, opts ...grpc.CallOption) (*TableBlock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableBlock)
+	err := c.cc.Invoke(ctx, VenueService_CreateTableBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) ListTableBlocks(ctx context.Context, in *ListTableBlocksRequest, opts ...grpc.CallOption) (*ListTableBlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTableBlocksResponse)
+	err := c.cc.Invoke(ctx, VenueService_ListTableBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) DeleteTableBlock(ctx context.Context, in *DeleteTableBlockRequest, opts ...grpc.CallOption) (*DeleteTableBlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTableBlockResponse)
+	err := c.cc.Invoke(ctx, VenueService_DeleteTableBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VenueServiceServer is the server API for VenueService service.
 // All implementations must embed UnimplementedVenueServiceServer
 // for forward compatibility.
@@ -290,25 +509,46 @@ type VenueServiceServer interface {
 	ListVenues(context.Context, *ListVenuesRequest) (*ListVenuesResponse, error)
 	UpdateVenue(context.Context, *UpdateVenueRequest) (*Venue, error)
 	DeleteVenue(context.Context, *DeleteVenueRequest) (*DeleteVenueResponse, error)
+	RestoreVenue(context.Context, *RestoreVenueRequest) (*Venue, error)
 	// Залы
 	CreateRoom(context.Context, *CreateRoomRequest) (*Room, error)
 	GetRoom(context.Context, *GetRoomRequest) (*Room, error)
 	ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error)
 	UpdateRoom(context.Context, *UpdateRoomRequest) (*Room, error)
 	DeleteRoom(context.Context, *DeleteRoomRequest) (*DeleteRoomResponse, error)
+	RestoreRoom(context.Context, *RestoreRoomRequest) (*Room, error)
 	// Столы
 	CreateTable(context.Context, *CreateTableRequest) (*Table, error)
 	GetTable(context.Context, *GetTableRequest) (*Table, error)
 	ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error)
 	UpdateTable(context.Context, *UpdateTableRequest) (*Table, error)
 	DeleteTable(context.Context, *DeleteTableRequest) (*DeleteTableResponse, error)
+	RestoreTable(context.Context, *RestoreTableRequest) (*Table, error)
+	// Комбинации столов, которые можно физически составить вместе
+	CreateTableCombination(context.Context, *CreateTableCombinationRequest) (*TableCombination, error)
+	ListTableCombinations(context.Context, *ListTableCombinationsRequest) (*ListTableCombinationsResponse, error)
+	DeleteTableCombination(context.Context, *DeleteTableCombinationRequest) (*DeleteTableCombinationResponse, error)
 	// Расписание
 	SetOpeningHours(context.Context, *SetOpeningHoursRequest) (*SetOpeningHoursResponse, error)
 	GetOpeningHours(context.Context, *GetOpeningHoursRequest) (*OpeningHours, error)
 	SetSpecialHours(context.Context, *SetSpecialHoursRequest) (*SetSpecialHoursResponse, error)
+	GetDaySchedule(context.Context, *GetDayScheduleRequest) (*DaySchedule, error)
+	ImportHolidays(context.Context, *ImportHolidaysRequest) (*ImportHolidaysResponse, error)
+	// Длительность посадки
+	SetTurnTimeRules(context.Context, *SetTurnTimeRulesRequest) (*TurnTimeRules, error)
+	GetTurnTimeRules(context.Context, *GetTurnTimeRulesRequest) (*TurnTimeRules, error)
+	ResolveTurnTime(context.Context, *ResolveTurnTimeRequest) (*ResolveTurnTimeResponse, error)
+	SetPacingRules(context.Context, *SetPacingRulesRequest) (*PacingRules, error)
+	GetPacingRules(context.Context, *GetPacingRulesRequest) (*PacingRules, error)
 	// Доступность
 	CheckAvailability(context.Context, *CheckAvailabilityRequest) (*CheckAvailabilityResponse, error)
 	GetTableLayout(context.Context, *GetTableLayoutRequest) (*GetTableLayoutResponse, error)
+	SearchSlots(context.Context, *SearchSlotsRequest) (*SearchSlotsResponse, error)
+	SaveRoomLayout(context.Context, *SaveRoomLayoutRequest) (*GetTableLayoutResponse, error)
+	// Блокировки столов (ремонт, стол для персонала) - отдельно от гостевых броней
+	CreateTableBlock(context.Context, *CreateTableBlockRequest) (*TableBlock, error)
+	ListTableBlocks(context.Context, *ListTableBlocksRequest) (*ListTableBlocksResponse, error)
+	DeleteTableBlock(context.Context, *DeleteTableBlockRequest) (*DeleteTableBlockResponse, error)
 	mustEmbedUnimplementedVenueServiceServer()
 }
 
@@ -334,6 +574,9 @@ func (UnimplementedVenueServiceServer) UpdateVenue(context.Context, *UpdateVenue
 func (UnimplementedVenueServiceServer) DeleteVenue(context.Context, *DeleteVenueRequest) (*DeleteVenueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteVenue not implemented")
 }
+func (UnimplementedVenueServiceServer) RestoreVenue(context.Context, *RestoreVenueRequest) (*Venue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVenue not implemented")
+}
 func (UnimplementedVenueServiceServer) CreateRoom(context.Context, *CreateRoomRequest) (*Room, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
 }
@@ -349,6 +592,9 @@ func (UnimplementedVenueServiceServer) UpdateRoom(context.Context, *UpdateRoomRe
 func (UnimplementedVenueServiceServer) DeleteRoom(context.Context, *DeleteRoomRequest) (*DeleteRoomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoom not implemented")
 }
+func (UnimplementedVenueServiceServer) RestoreRoom(context.Context, *RestoreRoomRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRoom not implemented")
+}
 func (UnimplementedVenueServiceServer) CreateTable(context.Context, *CreateTableRequest) (*Table, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTable not implemented")
 }
@@ -364,6 +610,18 @@ func (UnimplementedVenueServiceServer) UpdateTable(context.Context, *UpdateTable
 func (UnimplementedVenueServiceServer) DeleteTable(context.Context, *DeleteTableRequest) (*DeleteTableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTable not implemented")
 }
+func (UnimplementedVenueServiceServer) RestoreTable(context.Context, *RestoreTableRequest) (*Table, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTable not implemented")
+}
+func (UnimplementedVenueServiceServer) CreateTableCombination(context.Context, *CreateTableCombinationRequest) (*TableCombination, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTableCombination not implemented")
+}
+func (UnimplementedVenueServiceServer) ListTableCombinations(context.Context, *ListTableCombinationsRequest) (*ListTableCombinationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTableCombinations not implemented")
+}
+func (UnimplementedVenueServiceServer) DeleteTableCombination(context.Context, *DeleteTableCombinationRequest) (*DeleteTableCombinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTableCombination not implemented")
+}
 func (UnimplementedVenueServiceServer) SetOpeningHours(context.Context, *SetOpeningHoursRequest) (*SetOpeningHoursResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetOpeningHours not implemented")
 }
@@ -373,12 +631,48 @@ func (UnimplementedVenueServiceServer) GetOpeningHours(context.Context, *GetOpen
 func (UnimplementedVenueServiceServer) SetSpecialHours(context.Context, *SetSpecialHoursRequest) (*SetSpecialHoursResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSpecialHours not implemented")
 }
+func (UnimplementedVenueServiceServer) GetDaySchedule(context.Context, *GetDayScheduleRequest) (*DaySchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDaySchedule not implemented")
+}
+func (UnimplementedVenueServiceServer) ImportHolidays(context.Context, *ImportHolidaysRequest) (*ImportHolidaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportHolidays not implemented")
+}
+func (UnimplementedVenueServiceServer) SetTurnTimeRules(context.Context, *SetTurnTimeRulesRequest) (*TurnTimeRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTurnTimeRules not implemented")
+}
+func (UnimplementedVenueServiceServer) GetTurnTimeRules(context.Context, *GetTurnTimeRulesRequest) (*TurnTimeRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTurnTimeRules not implemented")
+}
+func (UnimplementedVenueServiceServer) ResolveTurnTime(context.Context, *ResolveTurnTimeRequest) (*ResolveTurnTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveTurnTime not implemented")
+}
+func (UnimplementedVenueServiceServer) SetPacingRules(context.Context, *SetPacingRulesRequest) (*PacingRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPacingRules not implemented")
+}
+func (UnimplementedVenueServiceServer) GetPacingRules(context.Context, *GetPacingRulesRequest) (*PacingRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPacingRules not implemented")
+}
 func (UnimplementedVenueServiceServer) CheckAvailability(context.Context, *CheckAvailabilityRequest) (*CheckAvailabilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckAvailability not implemented")
 }
 func (UnimplementedVenueServiceServer) GetTableLayout(context.Context, *GetTableLayoutRequest) (*GetTableLayoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTableLayout not implemented")
 }
+func (UnimplementedVenueServiceServer) SearchSlots(context.Context, *SearchSlotsRequest) (*SearchSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSlots not implemented")
+}
+func (UnimplementedVenueServiceServer) SaveRoomLayout(context.Context, *SaveRoomLayoutRequest) (*GetTableLayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveRoomLayout not implemented")
+}
+func (UnimplementedVenueServiceServer) CreateTableBlock(context.Context, *CreateTableBlockRequest) (*TableBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTableBlock not implemented")
+}
+func (UnimplementedVenueServiceServer) ListTableBlocks(context.Context, *ListTableBlocksRequest) (*ListTableBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTableBlocks not implemented")
+}
+func (UnimplementedVenueServiceServer) DeleteTableBlock(context.Context, *DeleteTableBlockRequest) (*DeleteTableBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTableBlock not implemented")
+}
 func (UnimplementedVenueServiceServer) mustEmbedUnimplementedVenueServiceServer() {}
 func (UnimplementedVenueServiceServer) testEmbeddedByValue()                      {}
 
@@ -490,6 +784,24 @@ func _VenueService_DeleteVenue_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VenueService_RestoreVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).RestoreVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_RestoreVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).RestoreVenue(ctx, req.(*RestoreVenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VenueService_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRoomRequest)
 	if err := dec(in); err != nil {
@@ -580,6 +892,24 @@ func _VenueService_DeleteRoom_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VenueService_RestoreRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).RestoreRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_RestoreRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).RestoreRoom(ctx, req.(*RestoreRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VenueService_CreateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTableRequest)
 	if err := dec(in); err != nil {
@@ -670,6 +1000,78 @@ func _VenueService_DeleteTable_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VenueService_RestoreTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).RestoreTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_RestoreTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).RestoreTable(ctx, req.(*RestoreTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_CreateTableCombination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableCombinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).CreateTableCombination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_CreateTableCombination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).CreateTableCombination(ctx, req.(*CreateTableCombinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_ListTableCombinations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTableCombinationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).ListTableCombinations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_ListTableCombinations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).ListTableCombinations(ctx, req.(*ListTableCombinationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_DeleteTableCombination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTableCombinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).DeleteTableCombination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_DeleteTableCombination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).DeleteTableCombination(ctx, req.(*DeleteTableCombinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VenueService_SetOpeningHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetOpeningHoursRequest)
 	if err := dec(in); err != nil {
@@ -724,6 +1126,132 @@ func _VenueService_SetSpecialHours_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VenueService_GetDaySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDayScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).GetDaySchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_GetDaySchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).GetDaySchedule(ctx, req.(*GetDayScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_ImportHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).ImportHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_ImportHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).ImportHolidays(ctx, req.(*ImportHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_SetTurnTimeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTurnTimeRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).SetTurnTimeRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_SetTurnTimeRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).SetTurnTimeRules(ctx, req.(*SetTurnTimeRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_GetTurnTimeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTurnTimeRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).GetTurnTimeRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_GetTurnTimeRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).GetTurnTimeRules(ctx, req.(*GetTurnTimeRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_ResolveTurnTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveTurnTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).ResolveTurnTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_ResolveTurnTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).ResolveTurnTime(ctx, req.(*ResolveTurnTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_SetPacingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPacingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).SetPacingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_SetPacingRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).SetPacingRules(ctx, req.(*SetPacingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_GetPacingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPacingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).GetPacingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_GetPacingRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).GetPacingRules(ctx, req.(*GetPacingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VenueService_CheckAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckAvailabilityRequest)
 	if err := dec(in); err != nil {
@@ -760,6 +1288,96 @@ func _VenueService_GetTableLayout_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VenueService_SearchSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).SearchSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_SearchSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).SearchSlots(ctx, req.(*SearchSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_SaveRoomLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveRoomLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).SaveRoomLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_SaveRoomLayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).SaveRoomLayout(ctx, req.(*SaveRoomLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_CreateTableBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).CreateTableBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_CreateTableBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).CreateTableBlock(ctx, req.(*CreateTableBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_ListTableBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTableBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).ListTableBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_ListTableBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).ListTableBlocks(ctx, req.(*ListTableBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_DeleteTableBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTableBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).DeleteTableBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_DeleteTableBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).DeleteTableBlock(ctx, req.(*DeleteTableBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VenueService_ServiceDesc is the grpc.ServiceDesc for VenueService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -787,6 +1405,10 @@ var VenueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVenue",
 			Handler:    _VenueService_DeleteVenue_Handler,
 		},
+		{
+			MethodName: "RestoreVenue",
+			Handler:    _VenueService_RestoreVenue_Handler,
+		},
 		{
 			MethodName: "CreateRoom",
 			Handler:    _VenueService_CreateRoom_Handler,
@@ -807,6 +1429,10 @@ var VenueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRoom",
 			Handler:    _VenueService_DeleteRoom_Handler,
 		},
+		{
+			MethodName: "RestoreRoom",
+			Handler:    _VenueService_RestoreRoom_Handler,
+		},
 		{
 			MethodName: "CreateTable",
 			Handler:    _VenueService_CreateTable_Handler,
@@ -827,6 +1453,22 @@ var VenueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTable",
 			Handler:    _VenueService_DeleteTable_Handler,
 		},
+		{
+			MethodName: "RestoreTable",
+			Handler:    _VenueService_RestoreTable_Handler,
+		},
+		{
+			MethodName: "CreateTableCombination",
+			Handler:    _VenueService_CreateTableCombination_Handler,
+		},
+		{
+			MethodName: "ListTableCombinations",
+			Handler:    _VenueService_ListTableCombinations_Handler,
+		},
+		{
+			MethodName: "DeleteTableCombination",
+			Handler:    _VenueService_DeleteTableCombination_Handler,
+		},
 		{
 			MethodName: "SetOpeningHours",
 			Handler:    _VenueService_SetOpeningHours_Handler,
@@ -839,6 +1481,34 @@ var VenueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSpecialHours",
 			Handler:    _VenueService_SetSpecialHours_Handler,
 		},
+		{
+			MethodName: "GetDaySchedule",
+			Handler:    _VenueService_GetDaySchedule_Handler,
+		},
+		{
+			MethodName: "ImportHolidays",
+			Handler:    _VenueService_ImportHolidays_Handler,
+		},
+		{
+			MethodName: "SetTurnTimeRules",
+			Handler:    _VenueService_SetTurnTimeRules_Handler,
+		},
+		{
+			MethodName: "GetTurnTimeRules",
+			Handler:    _VenueService_GetTurnTimeRules_Handler,
+		},
+		{
+			MethodName: "ResolveTurnTime",
+			Handler:    _VenueService_ResolveTurnTime_Handler,
+		},
+		{
+			MethodName: "SetPacingRules",
+			Handler:    _VenueService_SetPacingRules_Handler,
+		},
+		{
+			MethodName: "GetPacingRules",
+			Handler:    _VenueService_GetPacingRules_Handler,
+		},
 		{
 			MethodName: "CheckAvailability",
 			Handler:    _VenueService_CheckAvailability_Handler,
@@ -847,6 +1517,26 @@ var VenueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTableLayout",
 			Handler:    _VenueService_GetTableLayout_Handler,
 		},
+		{
+			MethodName: "SearchSlots",
+			Handler:    _VenueService_SearchSlots_Handler,
+		},
+		{
+			MethodName: "SaveRoomLayout",
+			Handler:    _VenueService_SaveRoomLayout_Handler,
+		},
+		{
+			MethodName: "CreateTableBlock",
+			Handler:    _VenueService_CreateTableBlock_Handler,
+		},
+		{
+			MethodName: "ListTableBlocks",
+			Handler:    _VenueService_ListTableBlocks_Handler,
+		},
+		{
+			MethodName: "DeleteTableBlock",
+			Handler:    _VenueService_DeleteTableBlock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "venue/venue.proto",